@@ -15,6 +15,7 @@ import (
 	semconv "go.opentelemetry.io/otel/semconv/v1.19.0"
 	"go.opentelemetry.io/otel/trace"
 
+	"github.com/ogen-go/ogen/conv"
 	ht "github.com/ogen-go/ogen/http"
 	"github.com/ogen-go/ogen/ogenerrors"
 	"github.com/ogen-go/ogen/otelogen"
@@ -29,27 +30,176 @@ type Invoker interface {
 	//
 	// POST /add-organization
 	AddOrganization(ctx context.Context, request *Organization) (*Organization, error)
+	// AddOrganizations invokes AddOrganizations operation.
+	//
+	// Insert/update multiple organization records, sent as a JSON array or as a stream of newline
+	// delimited JSON records.
+	// Each record is matched to a stored record on id (default) or on the identifier namespace in
+	// match_on.
+	// Records without a match are created, matched records are replaced.
+	// A result is returned for every record.
+	// Without transactional, every record is stored on its own and failing records do not affect the
+	// others.
+	// With transactional, either all records are stored or none at all.
+	//
+	// POST /add-organizations
+	AddOrganizations(ctx context.Context, request AddOrganizationsReq, params AddOrganizationsParams) (*UpsertResponse, error)
+	// AddPeople invokes AddPeople operation.
+	//
+	// Insert/update multiple person records, sent as a JSON array or as a stream of newline delimited
+	// JSON records.
+	// Each record is matched to a stored record on id (default) or on the identifier namespace in
+	// match_on.
+	// Records without a match are created, matched records are replaced.
+	// A result is returned for every record.
+	// Without transactional, every record is stored on its own and failing records do not affect the
+	// others.
+	// With transactional, either all records are stored or none at all.
+	//
+	// POST /add-people
+	AddPeople(ctx context.Context, request AddPeopleReq, params AddPeopleParams) (*UpsertResponse, error)
 	// AddPerson invokes AddPerson operation.
 	//
 	// Insert/update a single person record.
 	//
 	// POST /add-person
 	AddPerson(ctx context.Context, request *Person) (*Person, error)
+	// AddWebhookSubscription invokes AddWebhookSubscription operation.
+	//
+	// Register a callback url that receives a POST request for every change event of one of event_type
+	// (or of any type when event_type is empty).
+	// The request body is the event as JSON. Every request carries the headers
+	// X-People-Event (event type), X-People-Delivery (event id, identical for retries),
+	// X-People-Timestamp (unix time) and X-People-Signature.
+	// The signature is "sha256=" followed by the hex encoded HMAC-SHA256 of the timestamp, a dot and the
+	// body,
+	// keyed with secret.
+	// Any response status other than 2xx counts as failure. Failed deliveries are retried with
+	// exponential backoff.
+	//
+	// POST /add-webhook-subscription
+	AddWebhookSubscription(ctx context.Context, request *AddWebhookSubscriptionRequest) (*WebhookSubscription, error)
+	// DeleteOrganization invokes DeleteOrganization operation.
+	//
+	// Delete a single organization record. Organizations that still have members or child organizations
+	// are only deleted when cascade is set.
+	//
+	// POST /delete-organization
+	DeleteOrganization(ctx context.Context, request *DeleteOrganizationRequest) error
+	// DeletePerson invokes DeletePerson operation.
+	//
+	// Delete a single person record.
+	//
+	// POST /delete-person
+	DeletePerson(ctx context.Context, request *DeletePersonRequest) error
+	// DeleteWebhookSubscription invokes DeleteWebhookSubscription operation.
+	//
+	// Delete a webhook subscription and its delivery log.
+	//
+	// POST /delete-webhook-subscription
+	DeleteWebhookSubscription(ctx context.Context, request *DeleteWebhookSubscriptionRequest) error
+	// FindPersonDuplicates invokes FindPersonDuplicates operation.
+	//
+	// Report pairs of person records that probably describe the same person, highest score first.
+	// Candidate pairs share an identifier, an email address or a similar name.
+	// Every pair is scored (0-1) on name similarity (unaccented and case insensitive), birth date,
+	// email and shared identifiers; `reasons` explains the score.
+	// `survivor_id` is the record to keep when the pair is passed to `/merge-people`:
+	// the active record, or else the oldest.
+	//
+	// POST /find-person-duplicates
+	FindPersonDuplicates(ctx context.Context, request *FindPersonDuplicatesRequest) (*PersonDuplicatesResponse, error)
 	// GetOrganization invokes GetOrganization operation.
 	//
 	// Get single organization record.
+	// When the record was deleted, status 410 is returned
+	// with attribute tombstone describing what happened.
 	//
 	// POST /get-organization
 	GetOrganization(ctx context.Context, request *GetOrganizationRequest) (*Organization, error)
+	// GetOrganizationAncestors invokes GetOrganizationAncestors operation.
+	//
+	// Get the organizations above an organization, nearest first.
+	// Every node holds the path of organization ids from the given organization up to the node.
+	// An organization with several parents is returned once per path.
+	// Only parent relations that are valid at `as_of` (`from` <= `as_of` < `until`) are followed,
+	// `as_of` defaults to now. `max_depth` limits the number of levels.
+	//
+	// POST /get-organization-ancestors
+	GetOrganizationAncestors(ctx context.Context, request *GetOrganizationTreeRequest) (*OrganizationTreeResponse, error)
+	// GetOrganizationDescendants invokes GetOrganizationDescendants operation.
+	//
+	// Get the organizations below an organization, nearest first.
+	// Every node holds its parent and the path of organization ids from the given organization down to
+	// the node.
+	// An organization with several parents is returned once per path.
+	// Only parent relations that are valid at `as_of` (`from` <= `as_of` < `until`) are followed,
+	// `as_of` defaults to now. `max_depth` limits the number of levels.
+	//
+	// POST /get-organization-descendants
+	GetOrganizationDescendants(ctx context.Context, request *GetOrganizationTreeRequest) (*OrganizationTreeResponse, error)
+	// GetOrganizationHistory invokes GetOrganizationHistory operation.
+	//
+	// Retrieve all revisions of a single organization record, oldest first.
+	// Every revision holds the record as it was after the change (except for deletions),
+	// who made the change (actor), and the attributes that changed since the previous revision.
+	// The history also covers records that were deleted since.
+	//
+	// POST /get-organization-history
+	GetOrganizationHistory(ctx context.Context, request *GetOrganizationHistoryRequest) (*OrganizationHistoryResponse, error)
+	// GetOrganizationLineage invokes GetOrganizationLineage operation.
+	//
+	// Follow the successor relations of an organization, or its predecessor relations with `direction`
+	// predecessors.
+	// The given organization is returned first with depth 0.
+	// Every node holds the path of organization ids from the given organization to the node.
+	// An organization reached along several paths is returned once per path.
+	// Only relations that took effect at `as_of` (`date` <= `as_of`) are followed,
+	// `as_of` defaults to now. `max_depth` limits the number of steps.
+	// A node is `current` if its organization has no successor at `as_of`:
+	// following successors, the current nodes are the organizations that replace the given organization.
+	//
+	// POST /get-organization-lineage
+	GetOrganizationLineage(ctx context.Context, request *GetOrganizationLineageRequest) (*OrganizationLineageResponse, error)
+	// GetOrganizationMembers invokes GetOrganizationMembers operation.
+	//
+	// Get the person records that are members of an organization, one page at a time.
+	// Send the same request with the cursor of the previous page to get the next page.
+	// With `include_descendants`, members of all organizations below it are returned too,
+	// following parent relations valid at `as_of` (default now) only.
+	// Only people with a membership valid at `as_of` (default now) are returned,
+	// with `historic` people with a past or future membership are returned too. With `ids_only`, only
+	// person ids are returned in attribute `ids`.
+	//
+	// POST /get-organization-members
+	GetOrganizationMembers(ctx context.Context, request *GetOrganizationMembersRequest) (*OrganizationMembersResponse, error)
+	// GetOrganizationTree invokes GetOrganizationTree operation.
+	//
+	// Get the roots above an organization (depth 0), with all organizations below them.
+	// Every node holds its parent and the path of organization ids from its root down to the node.
+	// Only parent relations that are valid at `as_of` (`from` <= `as_of` < `until`) are followed,
+	// `as_of` defaults to now. `max_depth` limits the number of levels.
+	//
+	// POST /get-organization-tree
+	GetOrganizationTree(ctx context.Context, request *GetOrganizationTreeRequest) (*OrganizationTreeResponse, error)
 	// GetOrganizations invokes GetOrganizations operation.
 	//
-	// Get all organization records.
+	// Get all organization records, one page at a time.
+	// With updated_since, only organization records that changed since then are returned, in the order
+	// in which they were committed,
+	// and deleted organization records are returned as tombstones in attribute gone.
+	// Changes are held back while an older transaction is still in progress, so that no change is ever
+	// missed.
+	// Every page with changes has a cursor; the feed ends with an empty page without cursor.
+	// To continue a finished feed later, send the cursor of the last page with changes, together with
+	// the same updated_since.
 	//
 	// POST /get-organizations
 	GetOrganizations(ctx context.Context, request *GetOrganizationsRequest) (*OrganizationPagedListResponse, error)
 	// GetOrganizationsById invokes GetOrganizationsById operation.
 	//
 	// Get organization records by their ids.
+	// Requested records that were deleted are listed in attribute gone.
 	//
 	// POST /get-organizations-by-id
 	GetOrganizationsById(ctx context.Context, request *GetOrganizationsByIdRequest) (*OrganizationListResponse, error)
@@ -61,13 +211,22 @@ type Invoker interface {
 	GetOrganizationsByIdentifier(ctx context.Context, request *GetOrganizationsByIdentifierRequest) (*OrganizationListResponse, error)
 	// GetPeople invokes GetPeople operation.
 	//
-	// Get all person records.
+	// Get all person records, one page at a time.
+	// With updated_since, only person records that changed since then are returned, in the order in
+	// which they were committed,
+	// and deleted person records are returned as tombstones in attribute gone.
+	// Changes are held back while an older transaction is still in progress, so that no change is ever
+	// missed.
+	// Every page with changes has a cursor; the feed ends with an empty page without cursor.
+	// To continue a finished feed later, send the cursor of the last page with changes, together with
+	// the same updated_since.
 	//
 	// POST /get-people
 	GetPeople(ctx context.Context, request *GetPeopleRequest) (*PersonPagedListResponse, error)
 	// GetPeopleById invokes GetPeopleById operation.
 	//
 	// Retrieve person records by their ids.
+	// Requested records that were deleted or merged into another record are listed in attribute gone.
 	//
 	// POST /get-people-by-id
 	GetPeopleById(ctx context.Context, request *GetPeopleByIdRequest) (*PersonListResponse, error)
@@ -80,9 +239,103 @@ type Invoker interface {
 	// GetPerson invokes GetPerson operation.
 	//
 	// Retrieve a single person record.
+	// When the record was deleted or merged into another record, status 410 is returned
+	// with attribute tombstone describing what happened.
 	//
 	// POST /get-person
 	GetPerson(ctx context.Context, request *GetPersonRequest) (*Person, error)
+	// GetPersonHistory invokes GetPersonHistory operation.
+	//
+	// Retrieve all revisions of a single person record, oldest first.
+	// Every revision holds the record as it was after the change (except for deletions),
+	// who made the change (actor), and the attributes that changed since the previous revision.
+	// The history also covers records that were deleted since.
+	//
+	// POST /get-person-history
+	GetPersonHistory(ctx context.Context, request *GetPersonHistoryRequest) (*PersonHistoryResponse, error)
+	// GetWebhookDeliveries invokes GetWebhookDeliveries operation.
+	//
+	// Retrieve the most recent deliveries of a webhook subscription, newest first, with every attempt.
+	//
+	// POST /get-webhook-deliveries
+	GetWebhookDeliveries(ctx context.Context, request *GetWebhookDeliveriesRequest) (*WebhookDeliveryListResponse, error)
+	// GetWebhookSubscriptions invokes GetWebhookSubscriptions operation.
+	//
+	// Retrieve all webhook subscriptions.
+	//
+	// POST /get-webhook-subscriptions
+	GetWebhookSubscriptions(ctx context.Context, request *GetWebhookSubscriptionsRequest) (*WebhookSubscriptionListResponse, error)
+	// MergePeople invokes MergePeople operation.
+	//
+	// Merge the person records in merge_id into the person record with id, and delete them afterwards.
+	// Identifiers, organization memberships and roles of all records are combined.
+	// Other attributes are taken from the surviving record.
+	// When records hold a different orcid, token or setting, on_conflict decides the outcome:
+	// keep the value of the surviving record (survivor), of the most recently updated record (newest),
+	// or fail with status 409 (fail).
+	//
+	// POST /merge-people
+	MergePeople(ctx context.Context, request *MergePeopleRequest) (*Person, error)
+	// RevertOrganization invokes RevertOrganization operation.
+	//
+	// Revert organization records to an earlier revision.
+	// Either pass `id` and `version` to restore a single record to the state of that revision,
+	// or pass `actor_source` (and optionally `actor_id`), `from` and `until` to restore every record
+	// that this actor changed within [from, until) to the state before its first change in that window.
+	// Records that were created by the actor are deleted again, deleted records are recreated under
+	// their old id.
+	// Records that were changed by others after the window are skipped unless `force` is set.
+	// Pass `dry_run` to see what would change without changing anything.
+	// Every record is reverted in its own transaction; the revert itself shows up in the history as a
+	// new revision.
+	//
+	// POST /revert-organization
+	RevertOrganization(ctx context.Context, request *RevertRequest) (*RevertResponse, error)
+	// RevertPerson invokes RevertPerson operation.
+	//
+	// Revert person records to an earlier revision.
+	// Either pass `id` and `version` to restore a single record to the state of that revision,
+	// or pass `actor_source` (and optionally `actor_id`), `from` and `until` to restore every record
+	// that this actor changed within [from, until) to the state before its first change in that window.
+	// Records that were created by the actor are deleted again, deleted records are recreated under
+	// their old id.
+	// Records that were changed by others after the window are skipped unless `force` is set.
+	// Records that were merged into another record are only recreated with `force`, as the surviving
+	// record keeps their identifiers.
+	// Tokens are not kept in revisions: recreated records have no tokens, and `warnings` reports tokens
+	// that a revert deletes.
+	// Pass `dry_run` to see what would change without changing anything.
+	// Every record is reverted in its own transaction; the revert itself shows up in the history as a
+	// new revision.
+	//
+	// POST /revert-person
+	RevertPerson(ctx context.Context, request *RevertRequest) (*RevertResponse, error)
+	// SearchOrganizations invokes SearchOrganizations operation.
+	//
+	// Search organization records. All filters are optional: values within a filter are combined with OR,
+	// filters are combined with AND.
+	// `ancestor` matches the whole subtree below the given organizations (the organizations themselves
+	// excluded).
+	// `current` only matches organizations without parent or with a parent relation that is valid now
+	// (`from` <= now < `until`), and follows only current parent relations for `ancestor`.
+	// The response holds one page of matching records, the total number of matching records,
+	// and the number of matching records per type.
+	// Records are ranked by relevance if a `query` is given, and sorted by `name` otherwise.
+	//
+	// POST /search-organizations
+	SearchOrganizations(ctx context.Context, request *SearchOrganizationsRequest) (*OrganizationSearchResponse, error)
+	// SearchPeople invokes SearchPeople operation.
+	//
+	// Search person records. All filters are optional: values within a filter are combined with OR,
+	// filters are combined with AND.
+	// The response holds one page of matching records, the total number of matching records,
+	// and the value counts of every facet over all matching records (most frequent values first).
+	// Sort by `relevance` requires a `query`; without `query` records are sorted by `name` by default.
+	// The `organization` filter and facet only look at memberships valid at `as_of` (default now),
+	// unless `historic` is set.
+	//
+	// POST /search-people
+	SearchPeople(ctx context.Context, request *SearchPeopleRequest) (*PersonSearchResponse, error)
 	// SetPersonOrcid invokes SetPersonOrcid operation.
 	//
 	// Update person ORCID.
@@ -119,6 +372,27 @@ type Invoker interface {
 	//
 	// POST /suggest-people
 	SuggestPeople(ctx context.Context, request *SuggestPeopleRequest) (*PersonListResponse, error)
+	// UpdateOrganization invokes UpdateOrganization operation.
+	//
+	// Change only some attributes of a single organization record.
+	// Without update_mask, attribute organization is applied as a JSON Merge Patch (RFC 7396):
+	// omitted attributes are left untouched and null removes an attribute.
+	// With update_mask, only the listed attributes are copied from attribute organization.
+	// Listed attributes that are omitted or null are cleared.
+	//
+	// POST /update-organization
+	UpdateOrganization(ctx context.Context, request *UpdateOrganizationRequest) (*Organization, error)
+	// UpdatePerson invokes UpdatePerson operation.
+	//
+	// Change only some attributes of a single person record.
+	// Without update_mask, attribute person is applied as a JSON Merge Patch (RFC 7396):
+	// omitted attributes are left untouched, null removes an attribute and the maps token and settings
+	// are merged key by key.
+	// With update_mask, only the listed attributes are copied from attribute person.
+	// Listed attributes that are omitted or null are cleared.
+	//
+	// POST /update-person
+	UpdatePerson(ctx context.Context, request *UpdatePersonRequest) (*Person, error)
 }
 
 // Client implements OAS client.
@@ -283,21 +557,29 @@ func (c *Client) sendAddOrganization(ctx context.Context, request *Organization)
 	return result, nil
 }
 
-// AddPerson invokes AddPerson operation.
+// AddOrganizations invokes AddOrganizations operation.
 //
-// Insert/update a single person record.
+// Insert/update multiple organization records, sent as a JSON array or as a stream of newline
+// delimited JSON records.
+// Each record is matched to a stored record on id (default) or on the identifier namespace in
+// match_on.
+// Records without a match are created, matched records are replaced.
+// A result is returned for every record.
+// Without transactional, every record is stored on its own and failing records do not affect the
+// others.
+// With transactional, either all records are stored or none at all.
 //
-// POST /add-person
-func (c *Client) AddPerson(ctx context.Context, request *Person) (*Person, error) {
-	res, err := c.sendAddPerson(ctx, request)
+// POST /add-organizations
+func (c *Client) AddOrganizations(ctx context.Context, request AddOrganizationsReq, params AddOrganizationsParams) (*UpsertResponse, error) {
+	res, err := c.sendAddOrganizations(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendAddPerson(ctx context.Context, request *Person) (res *Person, err error) {
+func (c *Client) sendAddOrganizations(ctx context.Context, request AddOrganizationsReq, params AddOrganizationsParams) (res *UpsertResponse, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("AddPerson"),
+		otelogen.OperationID("AddOrganizations"),
 		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/add-person"),
+		semconv.HTTPRouteKey.String("/add-organizations"),
 	}
 
 	// Run stopwatch.
@@ -312,7 +594,7 @@ func (c *Client) sendAddPerson(ctx context.Context, request *Person) (res *Perso
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, "AddPerson",
+	ctx, span := c.cfg.Tracer.Start(ctx, "AddOrganizations",
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -330,15 +612,53 @@ func (c *Client) sendAddPerson(ctx context.Context, request *Person) (res *Perso
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/add-person"
+	pathParts[0] = "/add-organizations"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "match_on" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "match_on",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.MatchOn.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "transactional" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "transactional",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Transactional.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeAddPersonRequest(request, r); err != nil {
+	if err := encodeAddOrganizationsRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -347,7 +667,7 @@ func (c *Client) sendAddPerson(ctx context.Context, request *Person) (res *Perso
 		var satisfied bitset
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, "AddPerson", r); {
+			switch err := c.securityApiKey(ctx, "AddOrganizations", r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -383,7 +703,7 @@ func (c *Client) sendAddPerson(ctx context.Context, request *Person) (res *Perso
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeAddPersonResponse(resp)
+	result, err := decodeAddOrganizationsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -391,21 +711,29 @@ func (c *Client) sendAddPerson(ctx context.Context, request *Person) (res *Perso
 	return result, nil
 }
 
-// GetOrganization invokes GetOrganization operation.
+// AddPeople invokes AddPeople operation.
 //
-// Get single organization record.
+// Insert/update multiple person records, sent as a JSON array or as a stream of newline delimited
+// JSON records.
+// Each record is matched to a stored record on id (default) or on the identifier namespace in
+// match_on.
+// Records without a match are created, matched records are replaced.
+// A result is returned for every record.
+// Without transactional, every record is stored on its own and failing records do not affect the
+// others.
+// With transactional, either all records are stored or none at all.
 //
-// POST /get-organization
-func (c *Client) GetOrganization(ctx context.Context, request *GetOrganizationRequest) (*Organization, error) {
-	res, err := c.sendGetOrganization(ctx, request)
+// POST /add-people
+func (c *Client) AddPeople(ctx context.Context, request AddPeopleReq, params AddPeopleParams) (*UpsertResponse, error) {
+	res, err := c.sendAddPeople(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendGetOrganization(ctx context.Context, request *GetOrganizationRequest) (res *Organization, err error) {
+func (c *Client) sendAddPeople(ctx context.Context, request AddPeopleReq, params AddPeopleParams) (res *UpsertResponse, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetOrganization"),
+		otelogen.OperationID("AddPeople"),
 		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/get-organization"),
+		semconv.HTTPRouteKey.String("/add-people"),
 	}
 
 	// Run stopwatch.
@@ -420,7 +748,7 @@ func (c *Client) sendGetOrganization(ctx context.Context, request *GetOrganizati
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, "GetOrganization",
+	ctx, span := c.cfg.Tracer.Start(ctx, "AddPeople",
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -438,15 +766,53 @@ func (c *Client) sendGetOrganization(ctx context.Context, request *GetOrganizati
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/get-organization"
+	pathParts[0] = "/add-people"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "match_on" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "match_on",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.MatchOn.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "transactional" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "transactional",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Transactional.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeGetOrganizationRequest(request, r); err != nil {
+	if err := encodeAddPeopleRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -455,7 +821,7 @@ func (c *Client) sendGetOrganization(ctx context.Context, request *GetOrganizati
 		var satisfied bitset
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, "GetOrganization", r); {
+			switch err := c.securityApiKey(ctx, "AddPeople", r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -491,7 +857,7 @@ func (c *Client) sendGetOrganization(ctx context.Context, request *GetOrganizati
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetOrganizationResponse(resp)
+	result, err := decodeAddPeopleResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -499,21 +865,21 @@ func (c *Client) sendGetOrganization(ctx context.Context, request *GetOrganizati
 	return result, nil
 }
 
-// GetOrganizations invokes GetOrganizations operation.
+// AddPerson invokes AddPerson operation.
 //
-// Get all organization records.
+// Insert/update a single person record.
 //
-// POST /get-organizations
-func (c *Client) GetOrganizations(ctx context.Context, request *GetOrganizationsRequest) (*OrganizationPagedListResponse, error) {
-	res, err := c.sendGetOrganizations(ctx, request)
+// POST /add-person
+func (c *Client) AddPerson(ctx context.Context, request *Person) (*Person, error) {
+	res, err := c.sendAddPerson(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendGetOrganizations(ctx context.Context, request *GetOrganizationsRequest) (res *OrganizationPagedListResponse, err error) {
+func (c *Client) sendAddPerson(ctx context.Context, request *Person) (res *Person, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetOrganizations"),
+		otelogen.OperationID("AddPerson"),
 		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/get-organizations"),
+		semconv.HTTPRouteKey.String("/add-person"),
 	}
 
 	// Run stopwatch.
@@ -528,7 +894,7 @@ func (c *Client) sendGetOrganizations(ctx context.Context, request *GetOrganizat
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, "GetOrganizations",
+	ctx, span := c.cfg.Tracer.Start(ctx, "AddPerson",
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -546,7 +912,7 @@ func (c *Client) sendGetOrganizations(ctx context.Context, request *GetOrganizat
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/get-organizations"
+	pathParts[0] = "/add-person"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -554,7 +920,7 @@ func (c *Client) sendGetOrganizations(ctx context.Context, request *GetOrganizat
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeGetOrganizationsRequest(request, r); err != nil {
+	if err := encodeAddPersonRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -563,7 +929,7 @@ func (c *Client) sendGetOrganizations(ctx context.Context, request *GetOrganizat
 		var satisfied bitset
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, "GetOrganizations", r); {
+			switch err := c.securityApiKey(ctx, "AddPerson", r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -599,7 +965,7 @@ func (c *Client) sendGetOrganizations(ctx context.Context, request *GetOrganizat
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetOrganizationsResponse(resp)
+	result, err := decodeAddPersonResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -607,21 +973,30 @@ func (c *Client) sendGetOrganizations(ctx context.Context, request *GetOrganizat
 	return result, nil
 }
 
-// GetOrganizationsById invokes GetOrganizationsById operation.
+// AddWebhookSubscription invokes AddWebhookSubscription operation.
 //
-// Get organization records by their ids.
+// Register a callback url that receives a POST request for every change event of one of event_type
+// (or of any type when event_type is empty).
+// The request body is the event as JSON. Every request carries the headers
+// X-People-Event (event type), X-People-Delivery (event id, identical for retries),
+// X-People-Timestamp (unix time) and X-People-Signature.
+// The signature is "sha256=" followed by the hex encoded HMAC-SHA256 of the timestamp, a dot and the
+// body,
+// keyed with secret.
+// Any response status other than 2xx counts as failure. Failed deliveries are retried with
+// exponential backoff.
 //
-// POST /get-organizations-by-id
-func (c *Client) GetOrganizationsById(ctx context.Context, request *GetOrganizationsByIdRequest) (*OrganizationListResponse, error) {
-	res, err := c.sendGetOrganizationsById(ctx, request)
+// POST /add-webhook-subscription
+func (c *Client) AddWebhookSubscription(ctx context.Context, request *AddWebhookSubscriptionRequest) (*WebhookSubscription, error) {
+	res, err := c.sendAddWebhookSubscription(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendGetOrganizationsById(ctx context.Context, request *GetOrganizationsByIdRequest) (res *OrganizationListResponse, err error) {
+func (c *Client) sendAddWebhookSubscription(ctx context.Context, request *AddWebhookSubscriptionRequest) (res *WebhookSubscription, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetOrganizationsById"),
+		otelogen.OperationID("AddWebhookSubscription"),
 		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/get-organizations-by-id"),
+		semconv.HTTPRouteKey.String("/add-webhook-subscription"),
 	}
 
 	// Run stopwatch.
@@ -636,7 +1011,7 @@ func (c *Client) sendGetOrganizationsById(ctx context.Context, request *GetOrgan
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, "GetOrganizationsById",
+	ctx, span := c.cfg.Tracer.Start(ctx, "AddWebhookSubscription",
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -654,7 +1029,7 @@ func (c *Client) sendGetOrganizationsById(ctx context.Context, request *GetOrgan
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/get-organizations-by-id"
+	pathParts[0] = "/add-webhook-subscription"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -662,7 +1037,7 @@ func (c *Client) sendGetOrganizationsById(ctx context.Context, request *GetOrgan
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeGetOrganizationsByIdRequest(request, r); err != nil {
+	if err := encodeAddWebhookSubscriptionRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -671,7 +1046,7 @@ func (c *Client) sendGetOrganizationsById(ctx context.Context, request *GetOrgan
 		var satisfied bitset
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, "GetOrganizationsById", r); {
+			switch err := c.securityApiKey(ctx, "AddWebhookSubscription", r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -707,7 +1082,7 @@ func (c *Client) sendGetOrganizationsById(ctx context.Context, request *GetOrgan
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetOrganizationsByIdResponse(resp)
+	result, err := decodeAddWebhookSubscriptionResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -715,21 +1090,22 @@ func (c *Client) sendGetOrganizationsById(ctx context.Context, request *GetOrgan
 	return result, nil
 }
 
-// GetOrganizationsByIdentifier invokes GetOrganizationsByIdentifier operation.
+// DeleteOrganization invokes DeleteOrganization operation.
 //
-// Get organization records by one of the extra identifiers.
+// Delete a single organization record. Organizations that still have members or child organizations
+// are only deleted when cascade is set.
 //
-// POST /get-organizations-by-identifier
-func (c *Client) GetOrganizationsByIdentifier(ctx context.Context, request *GetOrganizationsByIdentifierRequest) (*OrganizationListResponse, error) {
-	res, err := c.sendGetOrganizationsByIdentifier(ctx, request)
-	return res, err
+// POST /delete-organization
+func (c *Client) DeleteOrganization(ctx context.Context, request *DeleteOrganizationRequest) error {
+	_, err := c.sendDeleteOrganization(ctx, request)
+	return err
 }
 
-func (c *Client) sendGetOrganizationsByIdentifier(ctx context.Context, request *GetOrganizationsByIdentifierRequest) (res *OrganizationListResponse, err error) {
+func (c *Client) sendDeleteOrganization(ctx context.Context, request *DeleteOrganizationRequest) (res *DeleteOrganizationOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetOrganizationsByIdentifier"),
+		otelogen.OperationID("DeleteOrganization"),
 		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/get-organizations-by-identifier"),
+		semconv.HTTPRouteKey.String("/delete-organization"),
 	}
 
 	// Run stopwatch.
@@ -744,7 +1120,7 @@ func (c *Client) sendGetOrganizationsByIdentifier(ctx context.Context, request *
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, "GetOrganizationsByIdentifier",
+	ctx, span := c.cfg.Tracer.Start(ctx, "DeleteOrganization",
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -762,7 +1138,7 @@ func (c *Client) sendGetOrganizationsByIdentifier(ctx context.Context, request *
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/get-organizations-by-identifier"
+	pathParts[0] = "/delete-organization"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -770,7 +1146,7 @@ func (c *Client) sendGetOrganizationsByIdentifier(ctx context.Context, request *
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeGetOrganizationsByIdentifierRequest(request, r); err != nil {
+	if err := encodeDeleteOrganizationRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -779,7 +1155,7 @@ func (c *Client) sendGetOrganizationsByIdentifier(ctx context.Context, request *
 		var satisfied bitset
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, "GetOrganizationsByIdentifier", r); {
+			switch err := c.securityApiKey(ctx, "DeleteOrganization", r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -815,7 +1191,7 @@ func (c *Client) sendGetOrganizationsByIdentifier(ctx context.Context, request *
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetOrganizationsByIdentifierResponse(resp)
+	result, err := decodeDeleteOrganizationResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -823,21 +1199,21 @@ func (c *Client) sendGetOrganizationsByIdentifier(ctx context.Context, request *
 	return result, nil
 }
 
-// GetPeople invokes GetPeople operation.
+// DeletePerson invokes DeletePerson operation.
 //
-// Get all person records.
+// Delete a single person record.
 //
-// POST /get-people
-func (c *Client) GetPeople(ctx context.Context, request *GetPeopleRequest) (*PersonPagedListResponse, error) {
-	res, err := c.sendGetPeople(ctx, request)
-	return res, err
+// POST /delete-person
+func (c *Client) DeletePerson(ctx context.Context, request *DeletePersonRequest) error {
+	_, err := c.sendDeletePerson(ctx, request)
+	return err
 }
 
-func (c *Client) sendGetPeople(ctx context.Context, request *GetPeopleRequest) (res *PersonPagedListResponse, err error) {
+func (c *Client) sendDeletePerson(ctx context.Context, request *DeletePersonRequest) (res *DeletePersonOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetPeople"),
+		otelogen.OperationID("DeletePerson"),
 		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/get-people"),
+		semconv.HTTPRouteKey.String("/delete-person"),
 	}
 
 	// Run stopwatch.
@@ -852,7 +1228,7 @@ func (c *Client) sendGetPeople(ctx context.Context, request *GetPeopleRequest) (
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, "GetPeople",
+	ctx, span := c.cfg.Tracer.Start(ctx, "DeletePerson",
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -870,7 +1246,7 @@ func (c *Client) sendGetPeople(ctx context.Context, request *GetPeopleRequest) (
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/get-people"
+	pathParts[0] = "/delete-person"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -878,7 +1254,7 @@ func (c *Client) sendGetPeople(ctx context.Context, request *GetPeopleRequest) (
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeGetPeopleRequest(request, r); err != nil {
+	if err := encodeDeletePersonRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -887,7 +1263,7 @@ func (c *Client) sendGetPeople(ctx context.Context, request *GetPeopleRequest) (
 		var satisfied bitset
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, "GetPeople", r); {
+			switch err := c.securityApiKey(ctx, "DeletePerson", r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -923,7 +1299,7 @@ func (c *Client) sendGetPeople(ctx context.Context, request *GetPeopleRequest) (
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetPeopleResponse(resp)
+	result, err := decodeDeletePersonResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -931,21 +1307,21 @@ func (c *Client) sendGetPeople(ctx context.Context, request *GetPeopleRequest) (
 	return result, nil
 }
 
-// GetPeopleById invokes GetPeopleById operation.
+// DeleteWebhookSubscription invokes DeleteWebhookSubscription operation.
 //
-// Retrieve person records by their ids.
+// Delete a webhook subscription and its delivery log.
 //
-// POST /get-people-by-id
-func (c *Client) GetPeopleById(ctx context.Context, request *GetPeopleByIdRequest) (*PersonListResponse, error) {
-	res, err := c.sendGetPeopleById(ctx, request)
-	return res, err
+// POST /delete-webhook-subscription
+func (c *Client) DeleteWebhookSubscription(ctx context.Context, request *DeleteWebhookSubscriptionRequest) error {
+	_, err := c.sendDeleteWebhookSubscription(ctx, request)
+	return err
 }
 
-func (c *Client) sendGetPeopleById(ctx context.Context, request *GetPeopleByIdRequest) (res *PersonListResponse, err error) {
+func (c *Client) sendDeleteWebhookSubscription(ctx context.Context, request *DeleteWebhookSubscriptionRequest) (res *DeleteWebhookSubscriptionOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetPeopleById"),
+		otelogen.OperationID("DeleteWebhookSubscription"),
 		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/get-people-by-id"),
+		semconv.HTTPRouteKey.String("/delete-webhook-subscription"),
 	}
 
 	// Run stopwatch.
@@ -960,7 +1336,7 @@ func (c *Client) sendGetPeopleById(ctx context.Context, request *GetPeopleByIdRe
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, "GetPeopleById",
+	ctx, span := c.cfg.Tracer.Start(ctx, "DeleteWebhookSubscription",
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -978,7 +1354,7 @@ func (c *Client) sendGetPeopleById(ctx context.Context, request *GetPeopleByIdRe
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/get-people-by-id"
+	pathParts[0] = "/delete-webhook-subscription"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -986,7 +1362,7 @@ func (c *Client) sendGetPeopleById(ctx context.Context, request *GetPeopleByIdRe
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeGetPeopleByIdRequest(request, r); err != nil {
+	if err := encodeDeleteWebhookSubscriptionRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -995,7 +1371,7 @@ func (c *Client) sendGetPeopleById(ctx context.Context, request *GetPeopleByIdRe
 		var satisfied bitset
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, "GetPeopleById", r); {
+			switch err := c.securityApiKey(ctx, "DeleteWebhookSubscription", r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1031,7 +1407,7 @@ func (c *Client) sendGetPeopleById(ctx context.Context, request *GetPeopleByIdRe
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetPeopleByIdResponse(resp)
+	result, err := decodeDeleteWebhookSubscriptionResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1039,21 +1415,26 @@ func (c *Client) sendGetPeopleById(ctx context.Context, request *GetPeopleByIdRe
 	return result, nil
 }
 
-// GetPeopleByIdentifier invokes GetPeopleByIdentifier operation.
+// FindPersonDuplicates invokes FindPersonDuplicates operation.
 //
-// Retrieve person records by one of the extra identifiers.
+// Report pairs of person records that probably describe the same person, highest score first.
+// Candidate pairs share an identifier, an email address or a similar name.
+// Every pair is scored (0-1) on name similarity (unaccented and case insensitive), birth date,
+// email and shared identifiers; `reasons` explains the score.
+// `survivor_id` is the record to keep when the pair is passed to `/merge-people`:
+// the active record, or else the oldest.
 //
-// POST /get-people-by-identifier
-func (c *Client) GetPeopleByIdentifier(ctx context.Context, request *GetPeopleByIdentifierRequest) (*PersonListResponse, error) {
-	res, err := c.sendGetPeopleByIdentifier(ctx, request)
+// POST /find-person-duplicates
+func (c *Client) FindPersonDuplicates(ctx context.Context, request *FindPersonDuplicatesRequest) (*PersonDuplicatesResponse, error) {
+	res, err := c.sendFindPersonDuplicates(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendGetPeopleByIdentifier(ctx context.Context, request *GetPeopleByIdentifierRequest) (res *PersonListResponse, err error) {
+func (c *Client) sendFindPersonDuplicates(ctx context.Context, request *FindPersonDuplicatesRequest) (res *PersonDuplicatesResponse, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetPeopleByIdentifier"),
+		otelogen.OperationID("FindPersonDuplicates"),
 		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/get-people-by-identifier"),
+		semconv.HTTPRouteKey.String("/find-person-duplicates"),
 	}
 
 	// Run stopwatch.
@@ -1068,7 +1449,7 @@ func (c *Client) sendGetPeopleByIdentifier(ctx context.Context, request *GetPeop
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, "GetPeopleByIdentifier",
+	ctx, span := c.cfg.Tracer.Start(ctx, "FindPersonDuplicates",
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1086,7 +1467,7 @@ func (c *Client) sendGetPeopleByIdentifier(ctx context.Context, request *GetPeop
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/get-people-by-identifier"
+	pathParts[0] = "/find-person-duplicates"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -1094,7 +1475,7 @@ func (c *Client) sendGetPeopleByIdentifier(ctx context.Context, request *GetPeop
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeGetPeopleByIdentifierRequest(request, r); err != nil {
+	if err := encodeFindPersonDuplicatesRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -1103,7 +1484,7 @@ func (c *Client) sendGetPeopleByIdentifier(ctx context.Context, request *GetPeop
 		var satisfied bitset
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, "GetPeopleByIdentifier", r); {
+			switch err := c.securityApiKey(ctx, "FindPersonDuplicates", r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1139,7 +1520,7 @@ func (c *Client) sendGetPeopleByIdentifier(ctx context.Context, request *GetPeop
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetPeopleByIdentifierResponse(resp)
+	result, err := decodeFindPersonDuplicatesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1147,21 +1528,23 @@ func (c *Client) sendGetPeopleByIdentifier(ctx context.Context, request *GetPeop
 	return result, nil
 }
 
-// GetPerson invokes GetPerson operation.
+// GetOrganization invokes GetOrganization operation.
 //
-// Retrieve a single person record.
+// Get single organization record.
+// When the record was deleted, status 410 is returned
+// with attribute tombstone describing what happened.
 //
-// POST /get-person
-func (c *Client) GetPerson(ctx context.Context, request *GetPersonRequest) (*Person, error) {
-	res, err := c.sendGetPerson(ctx, request)
+// POST /get-organization
+func (c *Client) GetOrganization(ctx context.Context, request *GetOrganizationRequest) (*Organization, error) {
+	res, err := c.sendGetOrganization(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendGetPerson(ctx context.Context, request *GetPersonRequest) (res *Person, err error) {
+func (c *Client) sendGetOrganization(ctx context.Context, request *GetOrganizationRequest) (res *Organization, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetPerson"),
+		otelogen.OperationID("GetOrganization"),
 		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/get-person"),
+		semconv.HTTPRouteKey.String("/get-organization"),
 	}
 
 	// Run stopwatch.
@@ -1176,7 +1559,7 @@ func (c *Client) sendGetPerson(ctx context.Context, request *GetPersonRequest) (
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, "GetPerson",
+	ctx, span := c.cfg.Tracer.Start(ctx, "GetOrganization",
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1194,7 +1577,7 @@ func (c *Client) sendGetPerson(ctx context.Context, request *GetPersonRequest) (
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/get-person"
+	pathParts[0] = "/get-organization"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -1202,7 +1585,7 @@ func (c *Client) sendGetPerson(ctx context.Context, request *GetPersonRequest) (
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeGetPersonRequest(request, r); err != nil {
+	if err := encodeGetOrganizationRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -1211,7 +1594,7 @@ func (c *Client) sendGetPerson(ctx context.Context, request *GetPersonRequest) (
 		var satisfied bitset
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, "GetPerson", r); {
+			switch err := c.securityApiKey(ctx, "GetOrganization", r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1247,7 +1630,7 @@ func (c *Client) sendGetPerson(ctx context.Context, request *GetPersonRequest) (
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetPersonResponse(resp)
+	result, err := decodeGetOrganizationResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1255,21 +1638,25 @@ func (c *Client) sendGetPerson(ctx context.Context, request *GetPersonRequest) (
 	return result, nil
 }
 
-// SetPersonOrcid invokes SetPersonOrcid operation.
+// GetOrganizationAncestors invokes GetOrganizationAncestors operation.
 //
-// Update person ORCID.
+// Get the organizations above an organization, nearest first.
+// Every node holds the path of organization ids from the given organization up to the node.
+// An organization with several parents is returned once per path.
+// Only parent relations that are valid at `as_of` (`from` <= `as_of` < `until`) are followed,
+// `as_of` defaults to now. `max_depth` limits the number of levels.
 //
-// POST /set-person-orcid
-func (c *Client) SetPersonOrcid(ctx context.Context, request *SetPersonOrcidRequest) (*Person, error) {
-	res, err := c.sendSetPersonOrcid(ctx, request)
+// POST /get-organization-ancestors
+func (c *Client) GetOrganizationAncestors(ctx context.Context, request *GetOrganizationTreeRequest) (*OrganizationTreeResponse, error) {
+	res, err := c.sendGetOrganizationAncestors(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendSetPersonOrcid(ctx context.Context, request *SetPersonOrcidRequest) (res *Person, err error) {
+func (c *Client) sendGetOrganizationAncestors(ctx context.Context, request *GetOrganizationTreeRequest) (res *OrganizationTreeResponse, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("SetPersonOrcid"),
+		otelogen.OperationID("GetOrganizationAncestors"),
 		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/set-person-orcid"),
+		semconv.HTTPRouteKey.String("/get-organization-ancestors"),
 	}
 
 	// Run stopwatch.
@@ -1284,7 +1671,7 @@ func (c *Client) sendSetPersonOrcid(ctx context.Context, request *SetPersonOrcid
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, "SetPersonOrcid",
+	ctx, span := c.cfg.Tracer.Start(ctx, "GetOrganizationAncestors",
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1302,7 +1689,7 @@ func (c *Client) sendSetPersonOrcid(ctx context.Context, request *SetPersonOrcid
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/set-person-orcid"
+	pathParts[0] = "/get-organization-ancestors"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -1310,7 +1697,7 @@ func (c *Client) sendSetPersonOrcid(ctx context.Context, request *SetPersonOrcid
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeSetPersonOrcidRequest(request, r); err != nil {
+	if err := encodeGetOrganizationAncestorsRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -1319,7 +1706,7 @@ func (c *Client) sendSetPersonOrcid(ctx context.Context, request *SetPersonOrcid
 		var satisfied bitset
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, "SetPersonOrcid", r); {
+			switch err := c.securityApiKey(ctx, "GetOrganizationAncestors", r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1355,7 +1742,7 @@ func (c *Client) sendSetPersonOrcid(ctx context.Context, request *SetPersonOrcid
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeSetPersonOrcidResponse(resp)
+	result, err := decodeGetOrganizationAncestorsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1363,21 +1750,26 @@ func (c *Client) sendSetPersonOrcid(ctx context.Context, request *SetPersonOrcid
 	return result, nil
 }
 
-// SetPersonRole invokes SetPersonRole operation.
+// GetOrganizationDescendants invokes GetOrganizationDescendants operation.
 //
-// Update person role.
+// Get the organizations below an organization, nearest first.
+// Every node holds its parent and the path of organization ids from the given organization down to
+// the node.
+// An organization with several parents is returned once per path.
+// Only parent relations that are valid at `as_of` (`from` <= `as_of` < `until`) are followed,
+// `as_of` defaults to now. `max_depth` limits the number of levels.
 //
-// POST /set-person-role
-func (c *Client) SetPersonRole(ctx context.Context, request *SetPersonRoleRequest) (*Person, error) {
-	res, err := c.sendSetPersonRole(ctx, request)
+// POST /get-organization-descendants
+func (c *Client) GetOrganizationDescendants(ctx context.Context, request *GetOrganizationTreeRequest) (*OrganizationTreeResponse, error) {
+	res, err := c.sendGetOrganizationDescendants(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendSetPersonRole(ctx context.Context, request *SetPersonRoleRequest) (res *Person, err error) {
+func (c *Client) sendGetOrganizationDescendants(ctx context.Context, request *GetOrganizationTreeRequest) (res *OrganizationTreeResponse, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("SetPersonRole"),
+		otelogen.OperationID("GetOrganizationDescendants"),
 		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/set-person-role"),
+		semconv.HTTPRouteKey.String("/get-organization-descendants"),
 	}
 
 	// Run stopwatch.
@@ -1392,7 +1784,7 @@ func (c *Client) sendSetPersonRole(ctx context.Context, request *SetPersonRoleRe
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, "SetPersonRole",
+	ctx, span := c.cfg.Tracer.Start(ctx, "GetOrganizationDescendants",
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1410,7 +1802,7 @@ func (c *Client) sendSetPersonRole(ctx context.Context, request *SetPersonRoleRe
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/set-person-role"
+	pathParts[0] = "/get-organization-descendants"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -1418,7 +1810,7 @@ func (c *Client) sendSetPersonRole(ctx context.Context, request *SetPersonRoleRe
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeSetPersonRoleRequest(request, r); err != nil {
+	if err := encodeGetOrganizationDescendantsRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -1427,7 +1819,7 @@ func (c *Client) sendSetPersonRole(ctx context.Context, request *SetPersonRoleRe
 		var satisfied bitset
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, "SetPersonRole", r); {
+			switch err := c.securityApiKey(ctx, "GetOrganizationDescendants", r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1463,7 +1855,7 @@ func (c *Client) sendSetPersonRole(ctx context.Context, request *SetPersonRoleRe
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeSetPersonRoleResponse(resp)
+	result, err := decodeGetOrganizationDescendantsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1471,21 +1863,24 @@ func (c *Client) sendSetPersonRole(ctx context.Context, request *SetPersonRoleRe
 	return result, nil
 }
 
-// SetPersonSettings invokes SetPersonSettings operation.
+// GetOrganizationHistory invokes GetOrganizationHistory operation.
 //
-// Update person settings.
+// Retrieve all revisions of a single organization record, oldest first.
+// Every revision holds the record as it was after the change (except for deletions),
+// who made the change (actor), and the attributes that changed since the previous revision.
+// The history also covers records that were deleted since.
 //
-// POST /set-person-settings
-func (c *Client) SetPersonSettings(ctx context.Context, request *SetPersonSettingsRequest) (*Person, error) {
-	res, err := c.sendSetPersonSettings(ctx, request)
+// POST /get-organization-history
+func (c *Client) GetOrganizationHistory(ctx context.Context, request *GetOrganizationHistoryRequest) (*OrganizationHistoryResponse, error) {
+	res, err := c.sendGetOrganizationHistory(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendSetPersonSettings(ctx context.Context, request *SetPersonSettingsRequest) (res *Person, err error) {
+func (c *Client) sendGetOrganizationHistory(ctx context.Context, request *GetOrganizationHistoryRequest) (res *OrganizationHistoryResponse, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("SetPersonSettings"),
+		otelogen.OperationID("GetOrganizationHistory"),
 		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/set-person-settings"),
+		semconv.HTTPRouteKey.String("/get-organization-history"),
 	}
 
 	// Run stopwatch.
@@ -1500,7 +1895,7 @@ func (c *Client) sendSetPersonSettings(ctx context.Context, request *SetPersonSe
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, "SetPersonSettings",
+	ctx, span := c.cfg.Tracer.Start(ctx, "GetOrganizationHistory",
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1518,7 +1913,7 @@ func (c *Client) sendSetPersonSettings(ctx context.Context, request *SetPersonSe
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/set-person-settings"
+	pathParts[0] = "/get-organization-history"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -1526,7 +1921,7 @@ func (c *Client) sendSetPersonSettings(ctx context.Context, request *SetPersonSe
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeSetPersonSettingsRequest(request, r); err != nil {
+	if err := encodeGetOrganizationHistoryRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -1535,7 +1930,7 @@ func (c *Client) sendSetPersonSettings(ctx context.Context, request *SetPersonSe
 		var satisfied bitset
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, "SetPersonSettings", r); {
+			switch err := c.securityApiKey(ctx, "GetOrganizationHistory", r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1571,7 +1966,7 @@ func (c *Client) sendSetPersonSettings(ctx context.Context, request *SetPersonSe
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeSetPersonSettingsResponse(resp)
+	result, err := decodeGetOrganizationHistoryResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1579,7 +1974,2356 @@ func (c *Client) sendSetPersonSettings(ctx context.Context, request *SetPersonSe
 	return result, nil
 }
 
-// SetPersonToken invokes SetPersonToken operation.
+// GetOrganizationLineage invokes GetOrganizationLineage operation.
+//
+// Follow the successor relations of an organization, or its predecessor relations with `direction`
+// predecessors.
+// The given organization is returned first with depth 0.
+// Every node holds the path of organization ids from the given organization to the node.
+// An organization reached along several paths is returned once per path.
+// Only relations that took effect at `as_of` (`date` <= `as_of`) are followed,
+// `as_of` defaults to now. `max_depth` limits the number of steps.
+// A node is `current` if its organization has no successor at `as_of`:
+// following successors, the current nodes are the organizations that replace the given organization.
+//
+// POST /get-organization-lineage
+func (c *Client) GetOrganizationLineage(ctx context.Context, request *GetOrganizationLineageRequest) (*OrganizationLineageResponse, error) {
+	res, err := c.sendGetOrganizationLineage(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendGetOrganizationLineage(ctx context.Context, request *GetOrganizationLineageRequest) (res *OrganizationLineageResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetOrganizationLineage"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/get-organization-lineage"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "GetOrganizationLineage",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/get-organization-lineage"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeGetOrganizationLineageRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, "GetOrganizationLineage", r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetOrganizationLineageResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetOrganizationMembers invokes GetOrganizationMembers operation.
+//
+// Get the person records that are members of an organization, one page at a time.
+// Send the same request with the cursor of the previous page to get the next page.
+// With `include_descendants`, members of all organizations below it are returned too,
+// following parent relations valid at `as_of` (default now) only.
+// Only people with a membership valid at `as_of` (default now) are returned,
+// with `historic` people with a past or future membership are returned too. With `ids_only`, only
+// person ids are returned in attribute `ids`.
+//
+// POST /get-organization-members
+func (c *Client) GetOrganizationMembers(ctx context.Context, request *GetOrganizationMembersRequest) (*OrganizationMembersResponse, error) {
+	res, err := c.sendGetOrganizationMembers(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendGetOrganizationMembers(ctx context.Context, request *GetOrganizationMembersRequest) (res *OrganizationMembersResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetOrganizationMembers"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/get-organization-members"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "GetOrganizationMembers",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/get-organization-members"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeGetOrganizationMembersRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, "GetOrganizationMembers", r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetOrganizationMembersResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetOrganizationTree invokes GetOrganizationTree operation.
+//
+// Get the roots above an organization (depth 0), with all organizations below them.
+// Every node holds its parent and the path of organization ids from its root down to the node.
+// Only parent relations that are valid at `as_of` (`from` <= `as_of` < `until`) are followed,
+// `as_of` defaults to now. `max_depth` limits the number of levels.
+//
+// POST /get-organization-tree
+func (c *Client) GetOrganizationTree(ctx context.Context, request *GetOrganizationTreeRequest) (*OrganizationTreeResponse, error) {
+	res, err := c.sendGetOrganizationTree(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendGetOrganizationTree(ctx context.Context, request *GetOrganizationTreeRequest) (res *OrganizationTreeResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetOrganizationTree"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/get-organization-tree"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "GetOrganizationTree",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/get-organization-tree"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeGetOrganizationTreeRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, "GetOrganizationTree", r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetOrganizationTreeResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetOrganizations invokes GetOrganizations operation.
+//
+// Get all organization records, one page at a time.
+// With updated_since, only organization records that changed since then are returned, in the order
+// in which they were committed,
+// and deleted organization records are returned as tombstones in attribute gone.
+// Changes are held back while an older transaction is still in progress, so that no change is ever
+// missed.
+// Every page with changes has a cursor; the feed ends with an empty page without cursor.
+// To continue a finished feed later, send the cursor of the last page with changes, together with
+// the same updated_since.
+//
+// POST /get-organizations
+func (c *Client) GetOrganizations(ctx context.Context, request *GetOrganizationsRequest) (*OrganizationPagedListResponse, error) {
+	res, err := c.sendGetOrganizations(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendGetOrganizations(ctx context.Context, request *GetOrganizationsRequest) (res *OrganizationPagedListResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetOrganizations"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/get-organizations"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "GetOrganizations",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/get-organizations"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeGetOrganizationsRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, "GetOrganizations", r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetOrganizationsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetOrganizationsById invokes GetOrganizationsById operation.
+//
+// Get organization records by their ids.
+// Requested records that were deleted are listed in attribute gone.
+//
+// POST /get-organizations-by-id
+func (c *Client) GetOrganizationsById(ctx context.Context, request *GetOrganizationsByIdRequest) (*OrganizationListResponse, error) {
+	res, err := c.sendGetOrganizationsById(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendGetOrganizationsById(ctx context.Context, request *GetOrganizationsByIdRequest) (res *OrganizationListResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetOrganizationsById"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/get-organizations-by-id"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "GetOrganizationsById",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/get-organizations-by-id"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeGetOrganizationsByIdRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, "GetOrganizationsById", r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetOrganizationsByIdResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetOrganizationsByIdentifier invokes GetOrganizationsByIdentifier operation.
+//
+// Get organization records by one of the extra identifiers.
+//
+// POST /get-organizations-by-identifier
+func (c *Client) GetOrganizationsByIdentifier(ctx context.Context, request *GetOrganizationsByIdentifierRequest) (*OrganizationListResponse, error) {
+	res, err := c.sendGetOrganizationsByIdentifier(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendGetOrganizationsByIdentifier(ctx context.Context, request *GetOrganizationsByIdentifierRequest) (res *OrganizationListResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetOrganizationsByIdentifier"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/get-organizations-by-identifier"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "GetOrganizationsByIdentifier",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/get-organizations-by-identifier"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeGetOrganizationsByIdentifierRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, "GetOrganizationsByIdentifier", r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetOrganizationsByIdentifierResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetPeople invokes GetPeople operation.
+//
+// Get all person records, one page at a time.
+// With updated_since, only person records that changed since then are returned, in the order in
+// which they were committed,
+// and deleted person records are returned as tombstones in attribute gone.
+// Changes are held back while an older transaction is still in progress, so that no change is ever
+// missed.
+// Every page with changes has a cursor; the feed ends with an empty page without cursor.
+// To continue a finished feed later, send the cursor of the last page with changes, together with
+// the same updated_since.
+//
+// POST /get-people
+func (c *Client) GetPeople(ctx context.Context, request *GetPeopleRequest) (*PersonPagedListResponse, error) {
+	res, err := c.sendGetPeople(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendGetPeople(ctx context.Context, request *GetPeopleRequest) (res *PersonPagedListResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetPeople"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/get-people"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "GetPeople",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/get-people"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeGetPeopleRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, "GetPeople", r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetPeopleResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetPeopleById invokes GetPeopleById operation.
+//
+// Retrieve person records by their ids.
+// Requested records that were deleted or merged into another record are listed in attribute gone.
+//
+// POST /get-people-by-id
+func (c *Client) GetPeopleById(ctx context.Context, request *GetPeopleByIdRequest) (*PersonListResponse, error) {
+	res, err := c.sendGetPeopleById(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendGetPeopleById(ctx context.Context, request *GetPeopleByIdRequest) (res *PersonListResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetPeopleById"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/get-people-by-id"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "GetPeopleById",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/get-people-by-id"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeGetPeopleByIdRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, "GetPeopleById", r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetPeopleByIdResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetPeopleByIdentifier invokes GetPeopleByIdentifier operation.
+//
+// Retrieve person records by one of the extra identifiers.
+//
+// POST /get-people-by-identifier
+func (c *Client) GetPeopleByIdentifier(ctx context.Context, request *GetPeopleByIdentifierRequest) (*PersonListResponse, error) {
+	res, err := c.sendGetPeopleByIdentifier(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendGetPeopleByIdentifier(ctx context.Context, request *GetPeopleByIdentifierRequest) (res *PersonListResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetPeopleByIdentifier"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/get-people-by-identifier"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "GetPeopleByIdentifier",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/get-people-by-identifier"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeGetPeopleByIdentifierRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, "GetPeopleByIdentifier", r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetPeopleByIdentifierResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetPerson invokes GetPerson operation.
+//
+// Retrieve a single person record.
+// When the record was deleted or merged into another record, status 410 is returned
+// with attribute tombstone describing what happened.
+//
+// POST /get-person
+func (c *Client) GetPerson(ctx context.Context, request *GetPersonRequest) (*Person, error) {
+	res, err := c.sendGetPerson(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendGetPerson(ctx context.Context, request *GetPersonRequest) (res *Person, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetPerson"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/get-person"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "GetPerson",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/get-person"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeGetPersonRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, "GetPerson", r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetPersonResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetPersonHistory invokes GetPersonHistory operation.
+//
+// Retrieve all revisions of a single person record, oldest first.
+// Every revision holds the record as it was after the change (except for deletions),
+// who made the change (actor), and the attributes that changed since the previous revision.
+// The history also covers records that were deleted since.
+//
+// POST /get-person-history
+func (c *Client) GetPersonHistory(ctx context.Context, request *GetPersonHistoryRequest) (*PersonHistoryResponse, error) {
+	res, err := c.sendGetPersonHistory(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendGetPersonHistory(ctx context.Context, request *GetPersonHistoryRequest) (res *PersonHistoryResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetPersonHistory"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/get-person-history"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "GetPersonHistory",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/get-person-history"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeGetPersonHistoryRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, "GetPersonHistory", r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetPersonHistoryResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetWebhookDeliveries invokes GetWebhookDeliveries operation.
+//
+// Retrieve the most recent deliveries of a webhook subscription, newest first, with every attempt.
+//
+// POST /get-webhook-deliveries
+func (c *Client) GetWebhookDeliveries(ctx context.Context, request *GetWebhookDeliveriesRequest) (*WebhookDeliveryListResponse, error) {
+	res, err := c.sendGetWebhookDeliveries(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendGetWebhookDeliveries(ctx context.Context, request *GetWebhookDeliveriesRequest) (res *WebhookDeliveryListResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetWebhookDeliveries"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/get-webhook-deliveries"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "GetWebhookDeliveries",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/get-webhook-deliveries"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeGetWebhookDeliveriesRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, "GetWebhookDeliveries", r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetWebhookDeliveriesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetWebhookSubscriptions invokes GetWebhookSubscriptions operation.
+//
+// Retrieve all webhook subscriptions.
+//
+// POST /get-webhook-subscriptions
+func (c *Client) GetWebhookSubscriptions(ctx context.Context, request *GetWebhookSubscriptionsRequest) (*WebhookSubscriptionListResponse, error) {
+	res, err := c.sendGetWebhookSubscriptions(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendGetWebhookSubscriptions(ctx context.Context, request *GetWebhookSubscriptionsRequest) (res *WebhookSubscriptionListResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetWebhookSubscriptions"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/get-webhook-subscriptions"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "GetWebhookSubscriptions",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/get-webhook-subscriptions"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeGetWebhookSubscriptionsRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, "GetWebhookSubscriptions", r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetWebhookSubscriptionsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// MergePeople invokes MergePeople operation.
+//
+// Merge the person records in merge_id into the person record with id, and delete them afterwards.
+// Identifiers, organization memberships and roles of all records are combined.
+// Other attributes are taken from the surviving record.
+// When records hold a different orcid, token or setting, on_conflict decides the outcome:
+// keep the value of the surviving record (survivor), of the most recently updated record (newest),
+// or fail with status 409 (fail).
+//
+// POST /merge-people
+func (c *Client) MergePeople(ctx context.Context, request *MergePeopleRequest) (*Person, error) {
+	res, err := c.sendMergePeople(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendMergePeople(ctx context.Context, request *MergePeopleRequest) (res *Person, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("MergePeople"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/merge-people"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "MergePeople",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/merge-people"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeMergePeopleRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, "MergePeople", r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeMergePeopleResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RevertOrganization invokes RevertOrganization operation.
+//
+// Revert organization records to an earlier revision.
+// Either pass `id` and `version` to restore a single record to the state of that revision,
+// or pass `actor_source` (and optionally `actor_id`), `from` and `until` to restore every record
+// that this actor changed within [from, until) to the state before its first change in that window.
+// Records that were created by the actor are deleted again, deleted records are recreated under
+// their old id.
+// Records that were changed by others after the window are skipped unless `force` is set.
+// Pass `dry_run` to see what would change without changing anything.
+// Every record is reverted in its own transaction; the revert itself shows up in the history as a
+// new revision.
+//
+// POST /revert-organization
+func (c *Client) RevertOrganization(ctx context.Context, request *RevertRequest) (*RevertResponse, error) {
+	res, err := c.sendRevertOrganization(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendRevertOrganization(ctx context.Context, request *RevertRequest) (res *RevertResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("RevertOrganization"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/revert-organization"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "RevertOrganization",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/revert-organization"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeRevertOrganizationRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, "RevertOrganization", r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeRevertOrganizationResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RevertPerson invokes RevertPerson operation.
+//
+// Revert person records to an earlier revision.
+// Either pass `id` and `version` to restore a single record to the state of that revision,
+// or pass `actor_source` (and optionally `actor_id`), `from` and `until` to restore every record
+// that this actor changed within [from, until) to the state before its first change in that window.
+// Records that were created by the actor are deleted again, deleted records are recreated under
+// their old id.
+// Records that were changed by others after the window are skipped unless `force` is set.
+// Records that were merged into another record are only recreated with `force`, as the surviving
+// record keeps their identifiers.
+// Tokens are not kept in revisions: recreated records have no tokens, and `warnings` reports tokens
+// that a revert deletes.
+// Pass `dry_run` to see what would change without changing anything.
+// Every record is reverted in its own transaction; the revert itself shows up in the history as a
+// new revision.
+//
+// POST /revert-person
+func (c *Client) RevertPerson(ctx context.Context, request *RevertRequest) (*RevertResponse, error) {
+	res, err := c.sendRevertPerson(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendRevertPerson(ctx context.Context, request *RevertRequest) (res *RevertResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("RevertPerson"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/revert-person"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "RevertPerson",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/revert-person"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeRevertPersonRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, "RevertPerson", r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeRevertPersonResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// SearchOrganizations invokes SearchOrganizations operation.
+//
+// Search organization records. All filters are optional: values within a filter are combined with OR,
+// filters are combined with AND.
+// `ancestor` matches the whole subtree below the given organizations (the organizations themselves
+// excluded).
+// `current` only matches organizations without parent or with a parent relation that is valid now
+// (`from` <= now < `until`), and follows only current parent relations for `ancestor`.
+// The response holds one page of matching records, the total number of matching records,
+// and the number of matching records per type.
+// Records are ranked by relevance if a `query` is given, and sorted by `name` otherwise.
+//
+// POST /search-organizations
+func (c *Client) SearchOrganizations(ctx context.Context, request *SearchOrganizationsRequest) (*OrganizationSearchResponse, error) {
+	res, err := c.sendSearchOrganizations(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendSearchOrganizations(ctx context.Context, request *SearchOrganizationsRequest) (res *OrganizationSearchResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("SearchOrganizations"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/search-organizations"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "SearchOrganizations",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/search-organizations"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeSearchOrganizationsRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, "SearchOrganizations", r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeSearchOrganizationsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// SearchPeople invokes SearchPeople operation.
+//
+// Search person records. All filters are optional: values within a filter are combined with OR,
+// filters are combined with AND.
+// The response holds one page of matching records, the total number of matching records,
+// and the value counts of every facet over all matching records (most frequent values first).
+// Sort by `relevance` requires a `query`; without `query` records are sorted by `name` by default.
+// The `organization` filter and facet only look at memberships valid at `as_of` (default now),
+// unless `historic` is set.
+//
+// POST /search-people
+func (c *Client) SearchPeople(ctx context.Context, request *SearchPeopleRequest) (*PersonSearchResponse, error) {
+	res, err := c.sendSearchPeople(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendSearchPeople(ctx context.Context, request *SearchPeopleRequest) (res *PersonSearchResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("SearchPeople"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/search-people"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "SearchPeople",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/search-people"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeSearchPeopleRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, "SearchPeople", r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeSearchPeopleResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// SetPersonOrcid invokes SetPersonOrcid operation.
+//
+// Update person ORCID.
+//
+// POST /set-person-orcid
+func (c *Client) SetPersonOrcid(ctx context.Context, request *SetPersonOrcidRequest) (*Person, error) {
+	res, err := c.sendSetPersonOrcid(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendSetPersonOrcid(ctx context.Context, request *SetPersonOrcidRequest) (res *Person, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("SetPersonOrcid"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/set-person-orcid"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "SetPersonOrcid",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/set-person-orcid"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeSetPersonOrcidRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, "SetPersonOrcid", r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeSetPersonOrcidResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// SetPersonRole invokes SetPersonRole operation.
+//
+// Update person role.
+//
+// POST /set-person-role
+func (c *Client) SetPersonRole(ctx context.Context, request *SetPersonRoleRequest) (*Person, error) {
+	res, err := c.sendSetPersonRole(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendSetPersonRole(ctx context.Context, request *SetPersonRoleRequest) (res *Person, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("SetPersonRole"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/set-person-role"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "SetPersonRole",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/set-person-role"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeSetPersonRoleRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, "SetPersonRole", r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeSetPersonRoleResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// SetPersonSettings invokes SetPersonSettings operation.
+//
+// Update person settings.
+//
+// POST /set-person-settings
+func (c *Client) SetPersonSettings(ctx context.Context, request *SetPersonSettingsRequest) (*Person, error) {
+	res, err := c.sendSetPersonSettings(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendSetPersonSettings(ctx context.Context, request *SetPersonSettingsRequest) (res *Person, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("SetPersonSettings"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/set-person-settings"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "SetPersonSettings",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/set-person-settings"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeSetPersonSettingsRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, "SetPersonSettings", r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeSetPersonSettingsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// SetPersonToken invokes SetPersonToken operation.
 //
 // Update person tokens.
 //
@@ -1902,3 +4646,228 @@ func (c *Client) sendSuggestPeople(ctx context.Context, request *SuggestPeopleRe
 
 	return result, nil
 }
+
+// UpdateOrganization invokes UpdateOrganization operation.
+//
+// Change only some attributes of a single organization record.
+// Without update_mask, attribute organization is applied as a JSON Merge Patch (RFC 7396):
+// omitted attributes are left untouched and null removes an attribute.
+// With update_mask, only the listed attributes are copied from attribute organization.
+// Listed attributes that are omitted or null are cleared.
+//
+// POST /update-organization
+func (c *Client) UpdateOrganization(ctx context.Context, request *UpdateOrganizationRequest) (*Organization, error) {
+	res, err := c.sendUpdateOrganization(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendUpdateOrganization(ctx context.Context, request *UpdateOrganizationRequest) (res *Organization, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("UpdateOrganization"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/update-organization"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "UpdateOrganization",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/update-organization"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeUpdateOrganizationRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, "UpdateOrganization", r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeUpdateOrganizationResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// UpdatePerson invokes UpdatePerson operation.
+//
+// Change only some attributes of a single person record.
+// Without update_mask, attribute person is applied as a JSON Merge Patch (RFC 7396):
+// omitted attributes are left untouched, null removes an attribute and the maps token and settings
+// are merged key by key.
+// With update_mask, only the listed attributes are copied from attribute person.
+// Listed attributes that are omitted or null are cleared.
+//
+// POST /update-person
+func (c *Client) UpdatePerson(ctx context.Context, request *UpdatePersonRequest) (*Person, error) {
+	res, err := c.sendUpdatePerson(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendUpdatePerson(ctx context.Context, request *UpdatePersonRequest) (res *Person, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("UpdatePerson"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/update-person"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "UpdatePerson",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/update-person"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeUpdatePersonRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, "UpdatePerson", r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeUpdatePersonResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
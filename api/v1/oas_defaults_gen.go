@@ -0,0 +1,99 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+// setDefaults set default value of fields.
+func (s *GetOrganizationLineageRequest) setDefaults() {
+	{
+		val := GetOrganizationLineageRequestDirection("successors")
+		s.Direction.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *GetOrganizationMembersRequest) setDefaults() {
+	{
+		val := bool(false)
+		s.IncludeDescendants.SetTo(val)
+	}
+	{
+		val := bool(false)
+		s.Historic.SetTo(val)
+	}
+	{
+		val := bool(false)
+		s.IdsOnly.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *GetWebhookDeliveriesRequest) setDefaults() {
+	{
+		val := int(50)
+		s.Limit.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *MergePeopleRequest) setDefaults() {
+	{
+		val := MergePeopleRequestOnConflict("survivor")
+		s.OnConflict.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *RevertRequest) setDefaults() {
+	{
+		val := bool(false)
+		s.Force.SetTo(val)
+	}
+	{
+		val := bool(false)
+		s.DryRun.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *SearchOrganizationsRequest) setDefaults() {
+	{
+		val := bool(false)
+		s.Current.SetTo(val)
+	}
+	{
+		val := bool(false)
+		s.IncludeDissolved.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *SearchPeopleRequest) setDefaults() {
+	{
+		val := bool(false)
+		s.IncludeSubOrganizations.SetTo(val)
+	}
+	{
+		val := bool(false)
+		s.Historic.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *SuggestOrganizationsRequest) setDefaults() {
+	{
+		val := bool(false)
+		s.Highlight.SetTo(val)
+	}
+	{
+		val := bool(false)
+		s.IncludeDissolved.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *SuggestPeopleRequest) setDefaults() {
+	{
+		val := bool(false)
+		s.Highlight.SetTo(val)
+	}
+}
@@ -183,20 +183,28 @@ func (s *Server) handleAddOrganizationRequest(args [0]string, argsEscaped bool,
 	}
 }
 
-// handleAddPersonRequest handles AddPerson operation.
+// handleAddOrganizationsRequest handles AddOrganizations operation.
 //
-// Insert/update a single person record.
+// Insert/update multiple organization records, sent as a JSON array or as a stream of newline
+// delimited JSON records.
+// Each record is matched to a stored record on id (default) or on the identifier namespace in
+// match_on.
+// Records without a match are created, matched records are replaced.
+// A result is returned for every record.
+// Without transactional, every record is stored on its own and failing records do not affect the
+// others.
+// With transactional, either all records are stored or none at all.
 //
-// POST /add-person
-func (s *Server) handleAddPersonRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /add-organizations
+func (s *Server) handleAddOrganizationsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("AddPerson"),
+		otelogen.OperationID("AddOrganizations"),
 		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/add-person"),
+		semconv.HTTPRouteKey.String("/add-organizations"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "AddPerson",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "AddOrganizations",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -221,15 +229,15 @@ func (s *Server) handleAddPersonRequest(args [0]string, argsEscaped bool, w http
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "AddPerson",
-			ID:   "AddPerson",
+			Name: "AddOrganizations",
+			ID:   "AddOrganizations",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityApiKey(ctx, "AddPerson", r)
+			sctx, ok, err := s.securityApiKey(ctx, "AddOrganizations", r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -271,7 +279,17 @@ func (s *Server) handleAddPersonRequest(args [0]string, argsEscaped bool, w http
 			return
 		}
 	}
-	request, close, err := s.decodeAddPersonRequest(r)
+	params, err := decodeAddOrganizationsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeAddOrganizationsRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -287,22 +305,31 @@ func (s *Server) handleAddPersonRequest(args [0]string, argsEscaped bool, w http
 		}
 	}()
 
-	var response *Person
+	var response *UpsertResponse
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "AddPerson",
-			OperationSummary: "Insert/update a single person record",
-			OperationID:      "AddPerson",
+			OperationName:    "AddOrganizations",
+			OperationSummary: "Insert/update multiple organization records",
+			OperationID:      "AddOrganizations",
 			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "match_on",
+					In:   "query",
+				}: params.MatchOn,
+				{
+					Name: "transactional",
+					In:   "query",
+				}: params.Transactional,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *Person
-			Params   = struct{}
-			Response = *Person
+			Request  = AddOrganizationsReq
+			Params   = AddOrganizationsParams
+			Response = *UpsertResponse
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -311,14 +338,14 @@ func (s *Server) handleAddPersonRequest(args [0]string, argsEscaped bool, w http
 		](
 			m,
 			mreq,
-			nil,
+			unpackAddOrganizationsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.AddPerson(ctx, request)
+				response, err = s.h.AddOrganizations(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.AddPerson(ctx, request)
+		response, err = s.h.AddOrganizations(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -337,7 +364,7 @@ func (s *Server) handleAddPersonRequest(args [0]string, argsEscaped bool, w http
 		return
 	}
 
-	if err := encodeAddPersonResponse(response, w, span); err != nil {
+	if err := encodeAddOrganizationsResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -346,20 +373,28 @@ func (s *Server) handleAddPersonRequest(args [0]string, argsEscaped bool, w http
 	}
 }
 
-// handleGetOrganizationRequest handles GetOrganization operation.
+// handleAddPeopleRequest handles AddPeople operation.
 //
-// Get single organization record.
+// Insert/update multiple person records, sent as a JSON array or as a stream of newline delimited
+// JSON records.
+// Each record is matched to a stored record on id (default) or on the identifier namespace in
+// match_on.
+// Records without a match are created, matched records are replaced.
+// A result is returned for every record.
+// Without transactional, every record is stored on its own and failing records do not affect the
+// others.
+// With transactional, either all records are stored or none at all.
 //
-// POST /get-organization
-func (s *Server) handleGetOrganizationRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /add-people
+func (s *Server) handleAddPeopleRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetOrganization"),
+		otelogen.OperationID("AddPeople"),
 		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/get-organization"),
+		semconv.HTTPRouteKey.String("/add-people"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetOrganization",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "AddPeople",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -384,15 +419,15 @@ func (s *Server) handleGetOrganizationRequest(args [0]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetOrganization",
-			ID:   "GetOrganization",
+			Name: "AddPeople",
+			ID:   "AddPeople",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityApiKey(ctx, "GetOrganization", r)
+			sctx, ok, err := s.securityApiKey(ctx, "AddPeople", r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -434,7 +469,17 @@ func (s *Server) handleGetOrganizationRequest(args [0]string, argsEscaped bool,
 			return
 		}
 	}
-	request, close, err := s.decodeGetOrganizationRequest(r)
+	params, err := decodeAddPeopleParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeAddPeopleRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -450,22 +495,31 @@ func (s *Server) handleGetOrganizationRequest(args [0]string, argsEscaped bool,
 		}
 	}()
 
-	var response *Organization
+	var response *UpsertResponse
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetOrganization",
-			OperationSummary: "Get single organization record",
-			OperationID:      "GetOrganization",
+			OperationName:    "AddPeople",
+			OperationSummary: "Insert/update multiple person records",
+			OperationID:      "AddPeople",
 			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "match_on",
+					In:   "query",
+				}: params.MatchOn,
+				{
+					Name: "transactional",
+					In:   "query",
+				}: params.Transactional,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *GetOrganizationRequest
-			Params   = struct{}
-			Response = *Organization
+			Request  = AddPeopleReq
+			Params   = AddPeopleParams
+			Response = *UpsertResponse
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -474,14 +528,14 @@ func (s *Server) handleGetOrganizationRequest(args [0]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			nil,
+			unpackAddPeopleParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetOrganization(ctx, request)
+				response, err = s.h.AddPeople(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetOrganization(ctx, request)
+		response, err = s.h.AddPeople(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -500,7 +554,7 @@ func (s *Server) handleGetOrganizationRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeGetOrganizationResponse(response, w, span); err != nil {
+	if err := encodeAddPeopleResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -509,20 +563,20 @@ func (s *Server) handleGetOrganizationRequest(args [0]string, argsEscaped bool,
 	}
 }
 
-// handleGetOrganizationsRequest handles GetOrganizations operation.
+// handleAddPersonRequest handles AddPerson operation.
 //
-// Get all organization records.
+// Insert/update a single person record.
 //
-// POST /get-organizations
-func (s *Server) handleGetOrganizationsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /add-person
+func (s *Server) handleAddPersonRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetOrganizations"),
+		otelogen.OperationID("AddPerson"),
 		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/get-organizations"),
+		semconv.HTTPRouteKey.String("/add-person"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetOrganizations",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "AddPerson",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -547,15 +601,15 @@ func (s *Server) handleGetOrganizationsRequest(args [0]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetOrganizations",
-			ID:   "GetOrganizations",
+			Name: "AddPerson",
+			ID:   "AddPerson",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityApiKey(ctx, "GetOrganizations", r)
+			sctx, ok, err := s.securityApiKey(ctx, "AddPerson", r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -597,7 +651,7 @@ func (s *Server) handleGetOrganizationsRequest(args [0]string, argsEscaped bool,
 			return
 		}
 	}
-	request, close, err := s.decodeGetOrganizationsRequest(r)
+	request, close, err := s.decodeAddPersonRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -613,22 +667,22 @@ func (s *Server) handleGetOrganizationsRequest(args [0]string, argsEscaped bool,
 		}
 	}()
 
-	var response *OrganizationPagedListResponse
+	var response *Person
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetOrganizations",
-			OperationSummary: "Get all organization records",
-			OperationID:      "GetOrganizations",
+			OperationName:    "AddPerson",
+			OperationSummary: "Insert/update a single person record",
+			OperationID:      "AddPerson",
 			Body:             request,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = *GetOrganizationsRequest
+			Request  = *Person
 			Params   = struct{}
-			Response = *OrganizationPagedListResponse
+			Response = *Person
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -639,12 +693,12 @@ func (s *Server) handleGetOrganizationsRequest(args [0]string, argsEscaped bool,
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetOrganizations(ctx, request)
+				response, err = s.h.AddPerson(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetOrganizations(ctx, request)
+		response, err = s.h.AddPerson(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -663,7 +717,7 @@ func (s *Server) handleGetOrganizationsRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeGetOrganizationsResponse(response, w, span); err != nil {
+	if err := encodeAddPersonResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -672,20 +726,29 @@ func (s *Server) handleGetOrganizationsRequest(args [0]string, argsEscaped bool,
 	}
 }
 
-// handleGetOrganizationsByIdRequest handles GetOrganizationsById operation.
+// handleAddWebhookSubscriptionRequest handles AddWebhookSubscription operation.
 //
-// Get organization records by their ids.
+// Register a callback url that receives a POST request for every change event of one of event_type
+// (or of any type when event_type is empty).
+// The request body is the event as JSON. Every request carries the headers
+// X-People-Event (event type), X-People-Delivery (event id, identical for retries),
+// X-People-Timestamp (unix time) and X-People-Signature.
+// The signature is "sha256=" followed by the hex encoded HMAC-SHA256 of the timestamp, a dot and the
+// body,
+// keyed with secret.
+// Any response status other than 2xx counts as failure. Failed deliveries are retried with
+// exponential backoff.
 //
-// POST /get-organizations-by-id
-func (s *Server) handleGetOrganizationsByIdRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /add-webhook-subscription
+func (s *Server) handleAddWebhookSubscriptionRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetOrganizationsById"),
+		otelogen.OperationID("AddWebhookSubscription"),
 		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/get-organizations-by-id"),
+		semconv.HTTPRouteKey.String("/add-webhook-subscription"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetOrganizationsById",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "AddWebhookSubscription",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -710,15 +773,15 @@ func (s *Server) handleGetOrganizationsByIdRequest(args [0]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetOrganizationsById",
-			ID:   "GetOrganizationsById",
+			Name: "AddWebhookSubscription",
+			ID:   "AddWebhookSubscription",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityApiKey(ctx, "GetOrganizationsById", r)
+			sctx, ok, err := s.securityApiKey(ctx, "AddWebhookSubscription", r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -760,7 +823,7 @@ func (s *Server) handleGetOrganizationsByIdRequest(args [0]string, argsEscaped b
 			return
 		}
 	}
-	request, close, err := s.decodeGetOrganizationsByIdRequest(r)
+	request, close, err := s.decodeAddWebhookSubscriptionRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -776,22 +839,22 @@ func (s *Server) handleGetOrganizationsByIdRequest(args [0]string, argsEscaped b
 		}
 	}()
 
-	var response *OrganizationListResponse
+	var response *WebhookSubscription
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetOrganizationsById",
-			OperationSummary: "Get organization records by their ids",
-			OperationID:      "GetOrganizationsById",
+			OperationName:    "AddWebhookSubscription",
+			OperationSummary: "Register a webhook subscription",
+			OperationID:      "AddWebhookSubscription",
 			Body:             request,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = *GetOrganizationsByIdRequest
+			Request  = *AddWebhookSubscriptionRequest
 			Params   = struct{}
-			Response = *OrganizationListResponse
+			Response = *WebhookSubscription
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -802,12 +865,12 @@ func (s *Server) handleGetOrganizationsByIdRequest(args [0]string, argsEscaped b
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetOrganizationsById(ctx, request)
+				response, err = s.h.AddWebhookSubscription(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetOrganizationsById(ctx, request)
+		response, err = s.h.AddWebhookSubscription(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -826,7 +889,7 @@ func (s *Server) handleGetOrganizationsByIdRequest(args [0]string, argsEscaped b
 		return
 	}
 
-	if err := encodeGetOrganizationsByIdResponse(response, w, span); err != nil {
+	if err := encodeAddWebhookSubscriptionResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -835,20 +898,21 @@ func (s *Server) handleGetOrganizationsByIdRequest(args [0]string, argsEscaped b
 	}
 }
 
-// handleGetOrganizationsByIdentifierRequest handles GetOrganizationsByIdentifier operation.
+// handleDeleteOrganizationRequest handles DeleteOrganization operation.
 //
-// Get organization records by one of the extra identifiers.
+// Delete a single organization record. Organizations that still have members or child organizations
+// are only deleted when cascade is set.
 //
-// POST /get-organizations-by-identifier
-func (s *Server) handleGetOrganizationsByIdentifierRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /delete-organization
+func (s *Server) handleDeleteOrganizationRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetOrganizationsByIdentifier"),
+		otelogen.OperationID("DeleteOrganization"),
 		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/get-organizations-by-identifier"),
+		semconv.HTTPRouteKey.String("/delete-organization"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetOrganizationsByIdentifier",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "DeleteOrganization",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -873,15 +937,15 @@ func (s *Server) handleGetOrganizationsByIdentifierRequest(args [0]string, argsE
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetOrganizationsByIdentifier",
-			ID:   "GetOrganizationsByIdentifier",
+			Name: "DeleteOrganization",
+			ID:   "DeleteOrganization",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityApiKey(ctx, "GetOrganizationsByIdentifier", r)
+			sctx, ok, err := s.securityApiKey(ctx, "DeleteOrganization", r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -923,7 +987,7 @@ func (s *Server) handleGetOrganizationsByIdentifierRequest(args [0]string, argsE
 			return
 		}
 	}
-	request, close, err := s.decodeGetOrganizationsByIdentifierRequest(r)
+	request, close, err := s.decodeDeleteOrganizationRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -939,22 +1003,22 @@ func (s *Server) handleGetOrganizationsByIdentifierRequest(args [0]string, argsE
 		}
 	}()
 
-	var response *OrganizationListResponse
+	var response *DeleteOrganizationOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetOrganizationsByIdentifier",
-			OperationSummary: "Get organization records by one of the extra identifiers",
-			OperationID:      "GetOrganizationsByIdentifier",
+			OperationName:    "DeleteOrganization",
+			OperationSummary: "Delete a single organization record",
+			OperationID:      "DeleteOrganization",
 			Body:             request,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = *GetOrganizationsByIdentifierRequest
+			Request  = *DeleteOrganizationRequest
 			Params   = struct{}
-			Response = *OrganizationListResponse
+			Response = *DeleteOrganizationOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -965,12 +1029,12 @@ func (s *Server) handleGetOrganizationsByIdentifierRequest(args [0]string, argsE
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetOrganizationsByIdentifier(ctx, request)
+				err = s.h.DeleteOrganization(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetOrganizationsByIdentifier(ctx, request)
+		err = s.h.DeleteOrganization(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -989,7 +1053,7 @@ func (s *Server) handleGetOrganizationsByIdentifierRequest(args [0]string, argsE
 		return
 	}
 
-	if err := encodeGetOrganizationsByIdentifierResponse(response, w, span); err != nil {
+	if err := encodeDeleteOrganizationResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -998,20 +1062,20 @@ func (s *Server) handleGetOrganizationsByIdentifierRequest(args [0]string, argsE
 	}
 }
 
-// handleGetPeopleRequest handles GetPeople operation.
+// handleDeletePersonRequest handles DeletePerson operation.
 //
-// Get all person records.
+// Delete a single person record.
 //
-// POST /get-people
-func (s *Server) handleGetPeopleRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /delete-person
+func (s *Server) handleDeletePersonRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetPeople"),
+		otelogen.OperationID("DeletePerson"),
 		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/get-people"),
+		semconv.HTTPRouteKey.String("/delete-person"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetPeople",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "DeletePerson",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1036,15 +1100,15 @@ func (s *Server) handleGetPeopleRequest(args [0]string, argsEscaped bool, w http
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetPeople",
-			ID:   "GetPeople",
+			Name: "DeletePerson",
+			ID:   "DeletePerson",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityApiKey(ctx, "GetPeople", r)
+			sctx, ok, err := s.securityApiKey(ctx, "DeletePerson", r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1086,7 +1150,7 @@ func (s *Server) handleGetPeopleRequest(args [0]string, argsEscaped bool, w http
 			return
 		}
 	}
-	request, close, err := s.decodeGetPeopleRequest(r)
+	request, close, err := s.decodeDeletePersonRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -1102,22 +1166,22 @@ func (s *Server) handleGetPeopleRequest(args [0]string, argsEscaped bool, w http
 		}
 	}()
 
-	var response *PersonPagedListResponse
+	var response *DeletePersonOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetPeople",
-			OperationSummary: "Get all person records",
-			OperationID:      "GetPeople",
+			OperationName:    "DeletePerson",
+			OperationSummary: "Delete a single person record",
+			OperationID:      "DeletePerson",
 			Body:             request,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = *GetPeopleRequest
+			Request  = *DeletePersonRequest
 			Params   = struct{}
-			Response = *PersonPagedListResponse
+			Response = *DeletePersonOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1128,12 +1192,12 @@ func (s *Server) handleGetPeopleRequest(args [0]string, argsEscaped bool, w http
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetPeople(ctx, request)
+				err = s.h.DeletePerson(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetPeople(ctx, request)
+		err = s.h.DeletePerson(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1152,7 +1216,7 @@ func (s *Server) handleGetPeopleRequest(args [0]string, argsEscaped bool, w http
 		return
 	}
 
-	if err := encodeGetPeopleResponse(response, w, span); err != nil {
+	if err := encodeDeletePersonResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1161,20 +1225,20 @@ func (s *Server) handleGetPeopleRequest(args [0]string, argsEscaped bool, w http
 	}
 }
 
-// handleGetPeopleByIdRequest handles GetPeopleById operation.
+// handleDeleteWebhookSubscriptionRequest handles DeleteWebhookSubscription operation.
 //
-// Retrieve person records by their ids.
+// Delete a webhook subscription and its delivery log.
 //
-// POST /get-people-by-id
-func (s *Server) handleGetPeopleByIdRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /delete-webhook-subscription
+func (s *Server) handleDeleteWebhookSubscriptionRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetPeopleById"),
+		otelogen.OperationID("DeleteWebhookSubscription"),
 		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/get-people-by-id"),
+		semconv.HTTPRouteKey.String("/delete-webhook-subscription"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetPeopleById",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "DeleteWebhookSubscription",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1199,15 +1263,15 @@ func (s *Server) handleGetPeopleByIdRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetPeopleById",
-			ID:   "GetPeopleById",
+			Name: "DeleteWebhookSubscription",
+			ID:   "DeleteWebhookSubscription",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityApiKey(ctx, "GetPeopleById", r)
+			sctx, ok, err := s.securityApiKey(ctx, "DeleteWebhookSubscription", r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1249,7 +1313,7 @@ func (s *Server) handleGetPeopleByIdRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
-	request, close, err := s.decodeGetPeopleByIdRequest(r)
+	request, close, err := s.decodeDeleteWebhookSubscriptionRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -1265,22 +1329,22 @@ func (s *Server) handleGetPeopleByIdRequest(args [0]string, argsEscaped bool, w
 		}
 	}()
 
-	var response *PersonListResponse
+	var response *DeleteWebhookSubscriptionOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetPeopleById",
-			OperationSummary: "Retrieve person records by their ids",
-			OperationID:      "GetPeopleById",
+			OperationName:    "DeleteWebhookSubscription",
+			OperationSummary: "Delete a webhook subscription",
+			OperationID:      "DeleteWebhookSubscription",
 			Body:             request,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = *GetPeopleByIdRequest
+			Request  = *DeleteWebhookSubscriptionRequest
 			Params   = struct{}
-			Response = *PersonListResponse
+			Response = *DeleteWebhookSubscriptionOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1291,12 +1355,12 @@ func (s *Server) handleGetPeopleByIdRequest(args [0]string, argsEscaped bool, w
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetPeopleById(ctx, request)
+				err = s.h.DeleteWebhookSubscription(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetPeopleById(ctx, request)
+		err = s.h.DeleteWebhookSubscription(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1315,7 +1379,7 @@ func (s *Server) handleGetPeopleByIdRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetPeopleByIdResponse(response, w, span); err != nil {
+	if err := encodeDeleteWebhookSubscriptionResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1324,20 +1388,25 @@ func (s *Server) handleGetPeopleByIdRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleGetPeopleByIdentifierRequest handles GetPeopleByIdentifier operation.
+// handleFindPersonDuplicatesRequest handles FindPersonDuplicates operation.
 //
-// Retrieve person records by one of the extra identifiers.
+// Report pairs of person records that probably describe the same person, highest score first.
+// Candidate pairs share an identifier, an email address or a similar name.
+// Every pair is scored (0-1) on name similarity (unaccented and case insensitive), birth date,
+// email and shared identifiers; `reasons` explains the score.
+// `survivor_id` is the record to keep when the pair is passed to `/merge-people`:
+// the active record, or else the oldest.
 //
-// POST /get-people-by-identifier
-func (s *Server) handleGetPeopleByIdentifierRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /find-person-duplicates
+func (s *Server) handleFindPersonDuplicatesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetPeopleByIdentifier"),
+		otelogen.OperationID("FindPersonDuplicates"),
 		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/get-people-by-identifier"),
+		semconv.HTTPRouteKey.String("/find-person-duplicates"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetPeopleByIdentifier",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "FindPersonDuplicates",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1362,15 +1431,15 @@ func (s *Server) handleGetPeopleByIdentifierRequest(args [0]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetPeopleByIdentifier",
-			ID:   "GetPeopleByIdentifier",
+			Name: "FindPersonDuplicates",
+			ID:   "FindPersonDuplicates",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityApiKey(ctx, "GetPeopleByIdentifier", r)
+			sctx, ok, err := s.securityApiKey(ctx, "FindPersonDuplicates", r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1412,7 +1481,7 @@ func (s *Server) handleGetPeopleByIdentifierRequest(args [0]string, argsEscaped
 			return
 		}
 	}
-	request, close, err := s.decodeGetPeopleByIdentifierRequest(r)
+	request, close, err := s.decodeFindPersonDuplicatesRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -1428,22 +1497,22 @@ func (s *Server) handleGetPeopleByIdentifierRequest(args [0]string, argsEscaped
 		}
 	}()
 
-	var response *PersonListResponse
+	var response *PersonDuplicatesResponse
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetPeopleByIdentifier",
-			OperationSummary: "Retrieve person records by one of the extra identifiers",
-			OperationID:      "GetPeopleByIdentifier",
+			OperationName:    "FindPersonDuplicates",
+			OperationSummary: "Report person records that probably describe the same person",
+			OperationID:      "FindPersonDuplicates",
 			Body:             request,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = *GetPeopleByIdentifierRequest
+			Request  = *FindPersonDuplicatesRequest
 			Params   = struct{}
-			Response = *PersonListResponse
+			Response = *PersonDuplicatesResponse
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1454,12 +1523,12 @@ func (s *Server) handleGetPeopleByIdentifierRequest(args [0]string, argsEscaped
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetPeopleByIdentifier(ctx, request)
+				response, err = s.h.FindPersonDuplicates(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetPeopleByIdentifier(ctx, request)
+		response, err = s.h.FindPersonDuplicates(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1478,7 +1547,7 @@ func (s *Server) handleGetPeopleByIdentifierRequest(args [0]string, argsEscaped
 		return
 	}
 
-	if err := encodeGetPeopleByIdentifierResponse(response, w, span); err != nil {
+	if err := encodeFindPersonDuplicatesResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1487,20 +1556,22 @@ func (s *Server) handleGetPeopleByIdentifierRequest(args [0]string, argsEscaped
 	}
 }
 
-// handleGetPersonRequest handles GetPerson operation.
+// handleGetOrganizationRequest handles GetOrganization operation.
 //
-// Retrieve a single person record.
+// Get single organization record.
+// When the record was deleted, status 410 is returned
+// with attribute tombstone describing what happened.
 //
-// POST /get-person
-func (s *Server) handleGetPersonRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /get-organization
+func (s *Server) handleGetOrganizationRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetPerson"),
+		otelogen.OperationID("GetOrganization"),
 		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/get-person"),
+		semconv.HTTPRouteKey.String("/get-organization"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetPerson",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetOrganization",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1525,15 +1596,15 @@ func (s *Server) handleGetPersonRequest(args [0]string, argsEscaped bool, w http
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetPerson",
-			ID:   "GetPerson",
+			Name: "GetOrganization",
+			ID:   "GetOrganization",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityApiKey(ctx, "GetPerson", r)
+			sctx, ok, err := s.securityApiKey(ctx, "GetOrganization", r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1575,7 +1646,7 @@ func (s *Server) handleGetPersonRequest(args [0]string, argsEscaped bool, w http
 			return
 		}
 	}
-	request, close, err := s.decodeGetPersonRequest(r)
+	request, close, err := s.decodeGetOrganizationRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -1591,22 +1662,22 @@ func (s *Server) handleGetPersonRequest(args [0]string, argsEscaped bool, w http
 		}
 	}()
 
-	var response *Person
+	var response *Organization
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetPerson",
-			OperationSummary: "Retrieve a single person record",
-			OperationID:      "GetPerson",
+			OperationName:    "GetOrganization",
+			OperationSummary: "Get single organization record",
+			OperationID:      "GetOrganization",
 			Body:             request,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = *GetPersonRequest
+			Request  = *GetOrganizationRequest
 			Params   = struct{}
-			Response = *Person
+			Response = *Organization
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1617,12 +1688,12 @@ func (s *Server) handleGetPersonRequest(args [0]string, argsEscaped bool, w http
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetPerson(ctx, request)
+				response, err = s.h.GetOrganization(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetPerson(ctx, request)
+		response, err = s.h.GetOrganization(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1641,7 +1712,7 @@ func (s *Server) handleGetPersonRequest(args [0]string, argsEscaped bool, w http
 		return
 	}
 
-	if err := encodeGetPersonResponse(response, w, span); err != nil {
+	if err := encodeGetOrganizationResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1650,20 +1721,24 @@ func (s *Server) handleGetPersonRequest(args [0]string, argsEscaped bool, w http
 	}
 }
 
-// handleSetPersonOrcidRequest handles SetPersonOrcid operation.
+// handleGetOrganizationAncestorsRequest handles GetOrganizationAncestors operation.
 //
-// Update person ORCID.
+// Get the organizations above an organization, nearest first.
+// Every node holds the path of organization ids from the given organization up to the node.
+// An organization with several parents is returned once per path.
+// Only parent relations that are valid at `as_of` (`from` <= `as_of` < `until`) are followed,
+// `as_of` defaults to now. `max_depth` limits the number of levels.
 //
-// POST /set-person-orcid
-func (s *Server) handleSetPersonOrcidRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /get-organization-ancestors
+func (s *Server) handleGetOrganizationAncestorsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("SetPersonOrcid"),
+		otelogen.OperationID("GetOrganizationAncestors"),
 		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/set-person-orcid"),
+		semconv.HTTPRouteKey.String("/get-organization-ancestors"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "SetPersonOrcid",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetOrganizationAncestors",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1688,15 +1763,15 @@ func (s *Server) handleSetPersonOrcidRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "SetPersonOrcid",
-			ID:   "SetPersonOrcid",
+			Name: "GetOrganizationAncestors",
+			ID:   "GetOrganizationAncestors",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityApiKey(ctx, "SetPersonOrcid", r)
+			sctx, ok, err := s.securityApiKey(ctx, "GetOrganizationAncestors", r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1738,7 +1813,7 @@ func (s *Server) handleSetPersonOrcidRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
-	request, close, err := s.decodeSetPersonOrcidRequest(r)
+	request, close, err := s.decodeGetOrganizationAncestorsRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -1754,12 +1829,3524 @@ func (s *Server) handleSetPersonOrcidRequest(args [0]string, argsEscaped bool, w
 		}
 	}()
 
-	var response *Person
+	var response *OrganizationTreeResponse
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "SetPersonOrcid",
-			OperationSummary: "Update person ORCID",
+			OperationName:    "GetOrganizationAncestors",
+			OperationSummary: "Get the organizations above an organization",
+			OperationID:      "GetOrganizationAncestors",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *GetOrganizationTreeRequest
+			Params   = struct{}
+			Response = *OrganizationTreeResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetOrganizationAncestors(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetOrganizationAncestors(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetOrganizationAncestorsResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetOrganizationDescendantsRequest handles GetOrganizationDescendants operation.
+//
+// Get the organizations below an organization, nearest first.
+// Every node holds its parent and the path of organization ids from the given organization down to
+// the node.
+// An organization with several parents is returned once per path.
+// Only parent relations that are valid at `as_of` (`from` <= `as_of` < `until`) are followed,
+// `as_of` defaults to now. `max_depth` limits the number of levels.
+//
+// POST /get-organization-descendants
+func (s *Server) handleGetOrganizationDescendantsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetOrganizationDescendants"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/get-organization-descendants"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetOrganizationDescendants",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetOrganizationDescendants",
+			ID:   "GetOrganizationDescendants",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityApiKey(ctx, "GetOrganizationDescendants", r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKey",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					recordError("Security:ApiKey", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeGetOrganizationDescendantsRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *OrganizationTreeResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetOrganizationDescendants",
+			OperationSummary: "Get the organizations below an organization",
+			OperationID:      "GetOrganizationDescendants",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *GetOrganizationTreeRequest
+			Params   = struct{}
+			Response = *OrganizationTreeResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetOrganizationDescendants(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetOrganizationDescendants(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetOrganizationDescendantsResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetOrganizationHistoryRequest handles GetOrganizationHistory operation.
+//
+// Retrieve all revisions of a single organization record, oldest first.
+// Every revision holds the record as it was after the change (except for deletions),
+// who made the change (actor), and the attributes that changed since the previous revision.
+// The history also covers records that were deleted since.
+//
+// POST /get-organization-history
+func (s *Server) handleGetOrganizationHistoryRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetOrganizationHistory"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/get-organization-history"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetOrganizationHistory",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetOrganizationHistory",
+			ID:   "GetOrganizationHistory",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityApiKey(ctx, "GetOrganizationHistory", r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKey",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					recordError("Security:ApiKey", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeGetOrganizationHistoryRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *OrganizationHistoryResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetOrganizationHistory",
+			OperationSummary: "Retrieve the revision history of a single organization record",
+			OperationID:      "GetOrganizationHistory",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *GetOrganizationHistoryRequest
+			Params   = struct{}
+			Response = *OrganizationHistoryResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetOrganizationHistory(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetOrganizationHistory(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetOrganizationHistoryResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetOrganizationLineageRequest handles GetOrganizationLineage operation.
+//
+// Follow the successor relations of an organization, or its predecessor relations with `direction`
+// predecessors.
+// The given organization is returned first with depth 0.
+// Every node holds the path of organization ids from the given organization to the node.
+// An organization reached along several paths is returned once per path.
+// Only relations that took effect at `as_of` (`date` <= `as_of`) are followed,
+// `as_of` defaults to now. `max_depth` limits the number of steps.
+// A node is `current` if its organization has no successor at `as_of`:
+// following successors, the current nodes are the organizations that replace the given organization.
+//
+// POST /get-organization-lineage
+func (s *Server) handleGetOrganizationLineageRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetOrganizationLineage"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/get-organization-lineage"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetOrganizationLineage",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetOrganizationLineage",
+			ID:   "GetOrganizationLineage",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityApiKey(ctx, "GetOrganizationLineage", r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKey",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					recordError("Security:ApiKey", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeGetOrganizationLineageRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *OrganizationLineageResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetOrganizationLineage",
+			OperationSummary: "Get the successors or predecessors of an organization",
+			OperationID:      "GetOrganizationLineage",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *GetOrganizationLineageRequest
+			Params   = struct{}
+			Response = *OrganizationLineageResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetOrganizationLineage(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetOrganizationLineage(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetOrganizationLineageResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetOrganizationMembersRequest handles GetOrganizationMembers operation.
+//
+// Get the person records that are members of an organization, one page at a time.
+// Send the same request with the cursor of the previous page to get the next page.
+// With `include_descendants`, members of all organizations below it are returned too,
+// following parent relations valid at `as_of` (default now) only.
+// Only people with a membership valid at `as_of` (default now) are returned,
+// with `historic` people with a past or future membership are returned too. With `ids_only`, only
+// person ids are returned in attribute `ids`.
+//
+// POST /get-organization-members
+func (s *Server) handleGetOrganizationMembersRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetOrganizationMembers"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/get-organization-members"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetOrganizationMembers",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetOrganizationMembers",
+			ID:   "GetOrganizationMembers",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityApiKey(ctx, "GetOrganizationMembers", r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKey",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					recordError("Security:ApiKey", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeGetOrganizationMembersRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *OrganizationMembersResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetOrganizationMembers",
+			OperationSummary: "Get the members of an organization",
+			OperationID:      "GetOrganizationMembers",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *GetOrganizationMembersRequest
+			Params   = struct{}
+			Response = *OrganizationMembersResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetOrganizationMembers(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetOrganizationMembers(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetOrganizationMembersResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetOrganizationTreeRequest handles GetOrganizationTree operation.
+//
+// Get the roots above an organization (depth 0), with all organizations below them.
+// Every node holds its parent and the path of organization ids from its root down to the node.
+// Only parent relations that are valid at `as_of` (`from` <= `as_of` < `until`) are followed,
+// `as_of` defaults to now. `max_depth` limits the number of levels.
+//
+// POST /get-organization-tree
+func (s *Server) handleGetOrganizationTreeRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetOrganizationTree"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/get-organization-tree"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetOrganizationTree",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetOrganizationTree",
+			ID:   "GetOrganizationTree",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityApiKey(ctx, "GetOrganizationTree", r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKey",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					recordError("Security:ApiKey", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeGetOrganizationTreeRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *OrganizationTreeResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetOrganizationTree",
+			OperationSummary: "Get the whole hierarchy of an organization",
+			OperationID:      "GetOrganizationTree",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *GetOrganizationTreeRequest
+			Params   = struct{}
+			Response = *OrganizationTreeResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetOrganizationTree(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetOrganizationTree(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetOrganizationTreeResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetOrganizationsRequest handles GetOrganizations operation.
+//
+// Get all organization records, one page at a time.
+// With updated_since, only organization records that changed since then are returned, in the order
+// in which they were committed,
+// and deleted organization records are returned as tombstones in attribute gone.
+// Changes are held back while an older transaction is still in progress, so that no change is ever
+// missed.
+// Every page with changes has a cursor; the feed ends with an empty page without cursor.
+// To continue a finished feed later, send the cursor of the last page with changes, together with
+// the same updated_since.
+//
+// POST /get-organizations
+func (s *Server) handleGetOrganizationsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetOrganizations"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/get-organizations"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetOrganizations",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetOrganizations",
+			ID:   "GetOrganizations",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityApiKey(ctx, "GetOrganizations", r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKey",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					recordError("Security:ApiKey", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeGetOrganizationsRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *OrganizationPagedListResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetOrganizations",
+			OperationSummary: "Get all organization records",
+			OperationID:      "GetOrganizations",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *GetOrganizationsRequest
+			Params   = struct{}
+			Response = *OrganizationPagedListResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetOrganizations(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetOrganizations(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetOrganizationsResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetOrganizationsByIdRequest handles GetOrganizationsById operation.
+//
+// Get organization records by their ids.
+// Requested records that were deleted are listed in attribute gone.
+//
+// POST /get-organizations-by-id
+func (s *Server) handleGetOrganizationsByIdRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetOrganizationsById"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/get-organizations-by-id"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetOrganizationsById",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetOrganizationsById",
+			ID:   "GetOrganizationsById",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityApiKey(ctx, "GetOrganizationsById", r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKey",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					recordError("Security:ApiKey", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeGetOrganizationsByIdRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *OrganizationListResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetOrganizationsById",
+			OperationSummary: "Get organization records by their ids",
+			OperationID:      "GetOrganizationsById",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *GetOrganizationsByIdRequest
+			Params   = struct{}
+			Response = *OrganizationListResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetOrganizationsById(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetOrganizationsById(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetOrganizationsByIdResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetOrganizationsByIdentifierRequest handles GetOrganizationsByIdentifier operation.
+//
+// Get organization records by one of the extra identifiers.
+//
+// POST /get-organizations-by-identifier
+func (s *Server) handleGetOrganizationsByIdentifierRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetOrganizationsByIdentifier"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/get-organizations-by-identifier"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetOrganizationsByIdentifier",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetOrganizationsByIdentifier",
+			ID:   "GetOrganizationsByIdentifier",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityApiKey(ctx, "GetOrganizationsByIdentifier", r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKey",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					recordError("Security:ApiKey", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeGetOrganizationsByIdentifierRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *OrganizationListResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetOrganizationsByIdentifier",
+			OperationSummary: "Get organization records by one of the extra identifiers",
+			OperationID:      "GetOrganizationsByIdentifier",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *GetOrganizationsByIdentifierRequest
+			Params   = struct{}
+			Response = *OrganizationListResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetOrganizationsByIdentifier(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetOrganizationsByIdentifier(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetOrganizationsByIdentifierResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetPeopleRequest handles GetPeople operation.
+//
+// Get all person records, one page at a time.
+// With updated_since, only person records that changed since then are returned, in the order in
+// which they were committed,
+// and deleted person records are returned as tombstones in attribute gone.
+// Changes are held back while an older transaction is still in progress, so that no change is ever
+// missed.
+// Every page with changes has a cursor; the feed ends with an empty page without cursor.
+// To continue a finished feed later, send the cursor of the last page with changes, together with
+// the same updated_since.
+//
+// POST /get-people
+func (s *Server) handleGetPeopleRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetPeople"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/get-people"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetPeople",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetPeople",
+			ID:   "GetPeople",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityApiKey(ctx, "GetPeople", r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKey",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					recordError("Security:ApiKey", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeGetPeopleRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *PersonPagedListResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetPeople",
+			OperationSummary: "Get all person records",
+			OperationID:      "GetPeople",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *GetPeopleRequest
+			Params   = struct{}
+			Response = *PersonPagedListResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetPeople(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetPeople(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetPeopleResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetPeopleByIdRequest handles GetPeopleById operation.
+//
+// Retrieve person records by their ids.
+// Requested records that were deleted or merged into another record are listed in attribute gone.
+//
+// POST /get-people-by-id
+func (s *Server) handleGetPeopleByIdRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetPeopleById"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/get-people-by-id"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetPeopleById",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetPeopleById",
+			ID:   "GetPeopleById",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityApiKey(ctx, "GetPeopleById", r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKey",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					recordError("Security:ApiKey", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeGetPeopleByIdRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *PersonListResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetPeopleById",
+			OperationSummary: "Retrieve person records by their ids",
+			OperationID:      "GetPeopleById",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *GetPeopleByIdRequest
+			Params   = struct{}
+			Response = *PersonListResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetPeopleById(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetPeopleById(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetPeopleByIdResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetPeopleByIdentifierRequest handles GetPeopleByIdentifier operation.
+//
+// Retrieve person records by one of the extra identifiers.
+//
+// POST /get-people-by-identifier
+func (s *Server) handleGetPeopleByIdentifierRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetPeopleByIdentifier"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/get-people-by-identifier"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetPeopleByIdentifier",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetPeopleByIdentifier",
+			ID:   "GetPeopleByIdentifier",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityApiKey(ctx, "GetPeopleByIdentifier", r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKey",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					recordError("Security:ApiKey", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeGetPeopleByIdentifierRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *PersonListResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetPeopleByIdentifier",
+			OperationSummary: "Retrieve person records by one of the extra identifiers",
+			OperationID:      "GetPeopleByIdentifier",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *GetPeopleByIdentifierRequest
+			Params   = struct{}
+			Response = *PersonListResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetPeopleByIdentifier(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetPeopleByIdentifier(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetPeopleByIdentifierResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetPersonRequest handles GetPerson operation.
+//
+// Retrieve a single person record.
+// When the record was deleted or merged into another record, status 410 is returned
+// with attribute tombstone describing what happened.
+//
+// POST /get-person
+func (s *Server) handleGetPersonRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetPerson"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/get-person"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetPerson",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetPerson",
+			ID:   "GetPerson",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityApiKey(ctx, "GetPerson", r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKey",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					recordError("Security:ApiKey", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeGetPersonRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *Person
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetPerson",
+			OperationSummary: "Retrieve a single person record",
+			OperationID:      "GetPerson",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *GetPersonRequest
+			Params   = struct{}
+			Response = *Person
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetPerson(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetPerson(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetPersonResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetPersonHistoryRequest handles GetPersonHistory operation.
+//
+// Retrieve all revisions of a single person record, oldest first.
+// Every revision holds the record as it was after the change (except for deletions),
+// who made the change (actor), and the attributes that changed since the previous revision.
+// The history also covers records that were deleted since.
+//
+// POST /get-person-history
+func (s *Server) handleGetPersonHistoryRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetPersonHistory"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/get-person-history"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetPersonHistory",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetPersonHistory",
+			ID:   "GetPersonHistory",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityApiKey(ctx, "GetPersonHistory", r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKey",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					recordError("Security:ApiKey", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeGetPersonHistoryRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *PersonHistoryResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetPersonHistory",
+			OperationSummary: "Retrieve the revision history of a single person record",
+			OperationID:      "GetPersonHistory",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *GetPersonHistoryRequest
+			Params   = struct{}
+			Response = *PersonHistoryResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetPersonHistory(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetPersonHistory(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetPersonHistoryResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetWebhookDeliveriesRequest handles GetWebhookDeliveries operation.
+//
+// Retrieve the most recent deliveries of a webhook subscription, newest first, with every attempt.
+//
+// POST /get-webhook-deliveries
+func (s *Server) handleGetWebhookDeliveriesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetWebhookDeliveries"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/get-webhook-deliveries"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetWebhookDeliveries",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetWebhookDeliveries",
+			ID:   "GetWebhookDeliveries",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityApiKey(ctx, "GetWebhookDeliveries", r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKey",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					recordError("Security:ApiKey", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeGetWebhookDeliveriesRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *WebhookDeliveryListResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetWebhookDeliveries",
+			OperationSummary: "Retrieve the delivery log of a webhook subscription",
+			OperationID:      "GetWebhookDeliveries",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *GetWebhookDeliveriesRequest
+			Params   = struct{}
+			Response = *WebhookDeliveryListResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetWebhookDeliveries(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetWebhookDeliveries(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetWebhookDeliveriesResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetWebhookSubscriptionsRequest handles GetWebhookSubscriptions operation.
+//
+// Retrieve all webhook subscriptions.
+//
+// POST /get-webhook-subscriptions
+func (s *Server) handleGetWebhookSubscriptionsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetWebhookSubscriptions"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/get-webhook-subscriptions"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetWebhookSubscriptions",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetWebhookSubscriptions",
+			ID:   "GetWebhookSubscriptions",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityApiKey(ctx, "GetWebhookSubscriptions", r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKey",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					recordError("Security:ApiKey", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeGetWebhookSubscriptionsRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *WebhookSubscriptionListResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetWebhookSubscriptions",
+			OperationSummary: "Retrieve all webhook subscriptions",
+			OperationID:      "GetWebhookSubscriptions",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *GetWebhookSubscriptionsRequest
+			Params   = struct{}
+			Response = *WebhookSubscriptionListResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetWebhookSubscriptions(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetWebhookSubscriptions(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetWebhookSubscriptionsResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleMergePeopleRequest handles MergePeople operation.
+//
+// Merge the person records in merge_id into the person record with id, and delete them afterwards.
+// Identifiers, organization memberships and roles of all records are combined.
+// Other attributes are taken from the surviving record.
+// When records hold a different orcid, token or setting, on_conflict decides the outcome:
+// keep the value of the surviving record (survivor), of the most recently updated record (newest),
+// or fail with status 409 (fail).
+//
+// POST /merge-people
+func (s *Server) handleMergePeopleRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("MergePeople"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/merge-people"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "MergePeople",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "MergePeople",
+			ID:   "MergePeople",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityApiKey(ctx, "MergePeople", r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKey",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					recordError("Security:ApiKey", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeMergePeopleRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *Person
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "MergePeople",
+			OperationSummary: "Merge person records into a single person record",
+			OperationID:      "MergePeople",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *MergePeopleRequest
+			Params   = struct{}
+			Response = *Person
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.MergePeople(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.MergePeople(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeMergePeopleResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleRevertOrganizationRequest handles RevertOrganization operation.
+//
+// Revert organization records to an earlier revision.
+// Either pass `id` and `version` to restore a single record to the state of that revision,
+// or pass `actor_source` (and optionally `actor_id`), `from` and `until` to restore every record
+// that this actor changed within [from, until) to the state before its first change in that window.
+// Records that were created by the actor are deleted again, deleted records are recreated under
+// their old id.
+// Records that were changed by others after the window are skipped unless `force` is set.
+// Pass `dry_run` to see what would change without changing anything.
+// Every record is reverted in its own transaction; the revert itself shows up in the history as a
+// new revision.
+//
+// POST /revert-organization
+func (s *Server) handleRevertOrganizationRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("RevertOrganization"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/revert-organization"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "RevertOrganization",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "RevertOrganization",
+			ID:   "RevertOrganization",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityApiKey(ctx, "RevertOrganization", r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKey",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					recordError("Security:ApiKey", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeRevertOrganizationRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *RevertResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "RevertOrganization",
+			OperationSummary: "Revert organization records to an earlier revision",
+			OperationID:      "RevertOrganization",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *RevertRequest
+			Params   = struct{}
+			Response = *RevertResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.RevertOrganization(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.RevertOrganization(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeRevertOrganizationResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleRevertPersonRequest handles RevertPerson operation.
+//
+// Revert person records to an earlier revision.
+// Either pass `id` and `version` to restore a single record to the state of that revision,
+// or pass `actor_source` (and optionally `actor_id`), `from` and `until` to restore every record
+// that this actor changed within [from, until) to the state before its first change in that window.
+// Records that were created by the actor are deleted again, deleted records are recreated under
+// their old id.
+// Records that were changed by others after the window are skipped unless `force` is set.
+// Records that were merged into another record are only recreated with `force`, as the surviving
+// record keeps their identifiers.
+// Tokens are not kept in revisions: recreated records have no tokens, and `warnings` reports tokens
+// that a revert deletes.
+// Pass `dry_run` to see what would change without changing anything.
+// Every record is reverted in its own transaction; the revert itself shows up in the history as a
+// new revision.
+//
+// POST /revert-person
+func (s *Server) handleRevertPersonRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("RevertPerson"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/revert-person"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "RevertPerson",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "RevertPerson",
+			ID:   "RevertPerson",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityApiKey(ctx, "RevertPerson", r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKey",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					recordError("Security:ApiKey", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeRevertPersonRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *RevertResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "RevertPerson",
+			OperationSummary: "Revert person records to an earlier revision",
+			OperationID:      "RevertPerson",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *RevertRequest
+			Params   = struct{}
+			Response = *RevertResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.RevertPerson(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.RevertPerson(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeRevertPersonResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleSearchOrganizationsRequest handles SearchOrganizations operation.
+//
+// Search organization records. All filters are optional: values within a filter are combined with OR,
+// filters are combined with AND.
+// `ancestor` matches the whole subtree below the given organizations (the organizations themselves
+// excluded).
+// `current` only matches organizations without parent or with a parent relation that is valid now
+// (`from` <= now < `until`), and follows only current parent relations for `ancestor`.
+// The response holds one page of matching records, the total number of matching records,
+// and the number of matching records per type.
+// Records are ranked by relevance if a `query` is given, and sorted by `name` otherwise.
+//
+// POST /search-organizations
+func (s *Server) handleSearchOrganizationsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("SearchOrganizations"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/search-organizations"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "SearchOrganizations",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "SearchOrganizations",
+			ID:   "SearchOrganizations",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityApiKey(ctx, "SearchOrganizations", r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKey",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					recordError("Security:ApiKey", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeSearchOrganizationsRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *OrganizationSearchResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "SearchOrganizations",
+			OperationSummary: "Search organization records with filters and facets",
+			OperationID:      "SearchOrganizations",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *SearchOrganizationsRequest
+			Params   = struct{}
+			Response = *OrganizationSearchResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.SearchOrganizations(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.SearchOrganizations(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeSearchOrganizationsResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleSearchPeopleRequest handles SearchPeople operation.
+//
+// Search person records. All filters are optional: values within a filter are combined with OR,
+// filters are combined with AND.
+// The response holds one page of matching records, the total number of matching records,
+// and the value counts of every facet over all matching records (most frequent values first).
+// Sort by `relevance` requires a `query`; without `query` records are sorted by `name` by default.
+// The `organization` filter and facet only look at memberships valid at `as_of` (default now),
+// unless `historic` is set.
+//
+// POST /search-people
+func (s *Server) handleSearchPeopleRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("SearchPeople"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/search-people"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "SearchPeople",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "SearchPeople",
+			ID:   "SearchPeople",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityApiKey(ctx, "SearchPeople", r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKey",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					recordError("Security:ApiKey", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeSearchPeopleRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *PersonSearchResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "SearchPeople",
+			OperationSummary: "Search person records with filters and facets",
+			OperationID:      "SearchPeople",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *SearchPeopleRequest
+			Params   = struct{}
+			Response = *PersonSearchResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.SearchPeople(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.SearchPeople(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeSearchPeopleResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleSetPersonOrcidRequest handles SetPersonOrcid operation.
+//
+// Update person ORCID.
+//
+// POST /set-person-orcid
+func (s *Server) handleSetPersonOrcidRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("SetPersonOrcid"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/set-person-orcid"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "SetPersonOrcid",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "SetPersonOrcid",
+			ID:   "SetPersonOrcid",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityApiKey(ctx, "SetPersonOrcid", r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKey",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					recordError("Security:ApiKey", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeSetPersonOrcidRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *Person
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "SetPersonOrcid",
+			OperationSummary: "Update person ORCID",
 			OperationID:      "SetPersonOrcid",
 			Body:             request,
 			Params:           middleware.Parameters{},
@@ -1767,7 +5354,333 @@ func (s *Server) handleSetPersonOrcidRequest(args [0]string, argsEscaped bool, w
 		}
 
 		type (
-			Request  = *SetPersonOrcidRequest
+			Request  = *SetPersonOrcidRequest
+			Params   = struct{}
+			Response = *Person
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.SetPersonOrcid(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.SetPersonOrcid(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeSetPersonOrcidResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleSetPersonRoleRequest handles SetPersonRole operation.
+//
+// Update person role.
+//
+// POST /set-person-role
+func (s *Server) handleSetPersonRoleRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("SetPersonRole"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/set-person-role"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "SetPersonRole",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "SetPersonRole",
+			ID:   "SetPersonRole",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityApiKey(ctx, "SetPersonRole", r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKey",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					recordError("Security:ApiKey", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeSetPersonRoleRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *Person
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "SetPersonRole",
+			OperationSummary: "Update person role",
+			OperationID:      "SetPersonRole",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *SetPersonRoleRequest
+			Params   = struct{}
+			Response = *Person
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.SetPersonRole(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.SetPersonRole(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeSetPersonRoleResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleSetPersonSettingsRequest handles SetPersonSettings operation.
+//
+// Update person settings.
+//
+// POST /set-person-settings
+func (s *Server) handleSetPersonSettingsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("SetPersonSettings"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/set-person-settings"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "SetPersonSettings",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "SetPersonSettings",
+			ID:   "SetPersonSettings",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityApiKey(ctx, "SetPersonSettings", r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKey",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					recordError("Security:ApiKey", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeSetPersonSettingsRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *Person
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "SetPersonSettings",
+			OperationSummary: "Update person settings",
+			OperationID:      "SetPersonSettings",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *SetPersonSettingsRequest
 			Params   = struct{}
 			Response = *Person
 		)
@@ -1780,12 +5693,12 @@ func (s *Server) handleSetPersonOrcidRequest(args [0]string, argsEscaped bool, w
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.SetPersonOrcid(ctx, request)
+				response, err = s.h.SetPersonSettings(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.SetPersonOrcid(ctx, request)
+		response, err = s.h.SetPersonSettings(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1804,7 +5717,7 @@ func (s *Server) handleSetPersonOrcidRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeSetPersonOrcidResponse(response, w, span); err != nil {
+	if err := encodeSetPersonSettingsResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1813,20 +5726,20 @@ func (s *Server) handleSetPersonOrcidRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleSetPersonRoleRequest handles SetPersonRole operation.
+// handleSetPersonTokenRequest handles SetPersonToken operation.
 //
-// Update person role.
+// Update person tokens.
 //
-// POST /set-person-role
-func (s *Server) handleSetPersonRoleRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /set-person-token
+func (s *Server) handleSetPersonTokenRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("SetPersonRole"),
+		otelogen.OperationID("SetPersonToken"),
 		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/set-person-role"),
+		semconv.HTTPRouteKey.String("/set-person-token"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "SetPersonRole",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "SetPersonToken",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1851,15 +5764,15 @@ func (s *Server) handleSetPersonRoleRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "SetPersonRole",
-			ID:   "SetPersonRole",
+			Name: "SetPersonToken",
+			ID:   "SetPersonToken",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityApiKey(ctx, "SetPersonRole", r)
+			sctx, ok, err := s.securityApiKey(ctx, "SetPersonToken", r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1901,7 +5814,7 @@ func (s *Server) handleSetPersonRoleRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
-	request, close, err := s.decodeSetPersonRoleRequest(r)
+	request, close, err := s.decodeSetPersonTokenRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -1921,16 +5834,16 @@ func (s *Server) handleSetPersonRoleRequest(args [0]string, argsEscaped bool, w
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "SetPersonRole",
-			OperationSummary: "Update person role",
-			OperationID:      "SetPersonRole",
+			OperationName:    "SetPersonToken",
+			OperationSummary: "Update person tokens",
+			OperationID:      "SetPersonToken",
 			Body:             request,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = *SetPersonRoleRequest
+			Request  = *SetPersonTokenRequest
 			Params   = struct{}
 			Response = *Person
 		)
@@ -1943,12 +5856,12 @@ func (s *Server) handleSetPersonRoleRequest(args [0]string, argsEscaped bool, w
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.SetPersonRole(ctx, request)
+				response, err = s.h.SetPersonToken(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.SetPersonRole(ctx, request)
+		response, err = s.h.SetPersonToken(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1967,7 +5880,7 @@ func (s *Server) handleSetPersonRoleRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeSetPersonRoleResponse(response, w, span); err != nil {
+	if err := encodeSetPersonTokenResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1976,20 +5889,20 @@ func (s *Server) handleSetPersonRoleRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleSetPersonSettingsRequest handles SetPersonSettings operation.
+// handleSuggestOrganizationsRequest handles SuggestOrganizations operation.
 //
-// Update person settings.
+// Search on organization records.
 //
-// POST /set-person-settings
-func (s *Server) handleSetPersonSettingsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /suggest-organizations
+func (s *Server) handleSuggestOrganizationsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("SetPersonSettings"),
+		otelogen.OperationID("SuggestOrganizations"),
 		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/set-person-settings"),
+		semconv.HTTPRouteKey.String("/suggest-organizations"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "SetPersonSettings",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "SuggestOrganizations",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2014,15 +5927,15 @@ func (s *Server) handleSetPersonSettingsRequest(args [0]string, argsEscaped bool
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "SetPersonSettings",
-			ID:   "SetPersonSettings",
+			Name: "SuggestOrganizations",
+			ID:   "SuggestOrganizations",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityApiKey(ctx, "SetPersonSettings", r)
+			sctx, ok, err := s.securityApiKey(ctx, "SuggestOrganizations", r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2064,7 +5977,7 @@ func (s *Server) handleSetPersonSettingsRequest(args [0]string, argsEscaped bool
 			return
 		}
 	}
-	request, close, err := s.decodeSetPersonSettingsRequest(r)
+	request, close, err := s.decodeSuggestOrganizationsRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -2080,22 +5993,22 @@ func (s *Server) handleSetPersonSettingsRequest(args [0]string, argsEscaped bool
 		}
 	}()
 
-	var response *Person
+	var response *OrganizationListResponse
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "SetPersonSettings",
-			OperationSummary: "Update person settings",
-			OperationID:      "SetPersonSettings",
+			OperationName:    "SuggestOrganizations",
+			OperationSummary: "Search on organization records",
+			OperationID:      "SuggestOrganizations",
 			Body:             request,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = *SetPersonSettingsRequest
+			Request  = *SuggestOrganizationsRequest
 			Params   = struct{}
-			Response = *Person
+			Response = *OrganizationListResponse
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2106,12 +6019,12 @@ func (s *Server) handleSetPersonSettingsRequest(args [0]string, argsEscaped bool
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.SetPersonSettings(ctx, request)
+				response, err = s.h.SuggestOrganizations(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.SetPersonSettings(ctx, request)
+		response, err = s.h.SuggestOrganizations(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -2130,7 +6043,7 @@ func (s *Server) handleSetPersonSettingsRequest(args [0]string, argsEscaped bool
 		return
 	}
 
-	if err := encodeSetPersonSettingsResponse(response, w, span); err != nil {
+	if err := encodeSuggestOrganizationsResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2139,20 +6052,20 @@ func (s *Server) handleSetPersonSettingsRequest(args [0]string, argsEscaped bool
 	}
 }
 
-// handleSetPersonTokenRequest handles SetPersonToken operation.
+// handleSuggestPeopleRequest handles SuggestPeople operation.
 //
-// Update person tokens.
+// Search on person records.
 //
-// POST /set-person-token
-func (s *Server) handleSetPersonTokenRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /suggest-people
+func (s *Server) handleSuggestPeopleRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("SetPersonToken"),
+		otelogen.OperationID("SuggestPeople"),
 		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/set-person-token"),
+		semconv.HTTPRouteKey.String("/suggest-people"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "SetPersonToken",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "SuggestPeople",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2177,15 +6090,15 @@ func (s *Server) handleSetPersonTokenRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "SetPersonToken",
-			ID:   "SetPersonToken",
+			Name: "SuggestPeople",
+			ID:   "SuggestPeople",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityApiKey(ctx, "SetPersonToken", r)
+			sctx, ok, err := s.securityApiKey(ctx, "SuggestPeople", r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2227,7 +6140,7 @@ func (s *Server) handleSetPersonTokenRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
-	request, close, err := s.decodeSetPersonTokenRequest(r)
+	request, close, err := s.decodeSuggestPeopleRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -2243,22 +6156,22 @@ func (s *Server) handleSetPersonTokenRequest(args [0]string, argsEscaped bool, w
 		}
 	}()
 
-	var response *Person
+	var response *PersonListResponse
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "SetPersonToken",
-			OperationSummary: "Update person tokens",
-			OperationID:      "SetPersonToken",
+			OperationName:    "SuggestPeople",
+			OperationSummary: "Search on person records",
+			OperationID:      "SuggestPeople",
 			Body:             request,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = *SetPersonTokenRequest
+			Request  = *SuggestPeopleRequest
 			Params   = struct{}
-			Response = *Person
+			Response = *PersonListResponse
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2269,12 +6182,12 @@ func (s *Server) handleSetPersonTokenRequest(args [0]string, argsEscaped bool, w
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.SetPersonToken(ctx, request)
+				response, err = s.h.SuggestPeople(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.SetPersonToken(ctx, request)
+		response, err = s.h.SuggestPeople(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -2293,7 +6206,7 @@ func (s *Server) handleSetPersonTokenRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeSetPersonTokenResponse(response, w, span); err != nil {
+	if err := encodeSuggestPeopleResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2302,20 +6215,24 @@ func (s *Server) handleSetPersonTokenRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleSuggestOrganizationsRequest handles SuggestOrganizations operation.
+// handleUpdateOrganizationRequest handles UpdateOrganization operation.
 //
-// Search on organization records.
+// Change only some attributes of a single organization record.
+// Without update_mask, attribute organization is applied as a JSON Merge Patch (RFC 7396):
+// omitted attributes are left untouched and null removes an attribute.
+// With update_mask, only the listed attributes are copied from attribute organization.
+// Listed attributes that are omitted or null are cleared.
 //
-// POST /suggest-organizations
-func (s *Server) handleSuggestOrganizationsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /update-organization
+func (s *Server) handleUpdateOrganizationRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("SuggestOrganizations"),
+		otelogen.OperationID("UpdateOrganization"),
 		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/suggest-organizations"),
+		semconv.HTTPRouteKey.String("/update-organization"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "SuggestOrganizations",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "UpdateOrganization",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2340,15 +6257,15 @@ func (s *Server) handleSuggestOrganizationsRequest(args [0]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "SuggestOrganizations",
-			ID:   "SuggestOrganizations",
+			Name: "UpdateOrganization",
+			ID:   "UpdateOrganization",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityApiKey(ctx, "SuggestOrganizations", r)
+			sctx, ok, err := s.securityApiKey(ctx, "UpdateOrganization", r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2390,7 +6307,7 @@ func (s *Server) handleSuggestOrganizationsRequest(args [0]string, argsEscaped b
 			return
 		}
 	}
-	request, close, err := s.decodeSuggestOrganizationsRequest(r)
+	request, close, err := s.decodeUpdateOrganizationRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -2406,22 +6323,22 @@ func (s *Server) handleSuggestOrganizationsRequest(args [0]string, argsEscaped b
 		}
 	}()
 
-	var response *OrganizationListResponse
+	var response *Organization
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "SuggestOrganizations",
-			OperationSummary: "Search on organization records",
-			OperationID:      "SuggestOrganizations",
+			OperationName:    "UpdateOrganization",
+			OperationSummary: "Partially update a single organization record",
+			OperationID:      "UpdateOrganization",
 			Body:             request,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = *SuggestOrganizationsRequest
+			Request  = *UpdateOrganizationRequest
 			Params   = struct{}
-			Response = *OrganizationListResponse
+			Response = *Organization
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2432,12 +6349,12 @@ func (s *Server) handleSuggestOrganizationsRequest(args [0]string, argsEscaped b
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.SuggestOrganizations(ctx, request)
+				response, err = s.h.UpdateOrganization(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.SuggestOrganizations(ctx, request)
+		response, err = s.h.UpdateOrganization(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -2456,7 +6373,7 @@ func (s *Server) handleSuggestOrganizationsRequest(args [0]string, argsEscaped b
 		return
 	}
 
-	if err := encodeSuggestOrganizationsResponse(response, w, span); err != nil {
+	if err := encodeUpdateOrganizationResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2465,20 +6382,25 @@ func (s *Server) handleSuggestOrganizationsRequest(args [0]string, argsEscaped b
 	}
 }
 
-// handleSuggestPeopleRequest handles SuggestPeople operation.
+// handleUpdatePersonRequest handles UpdatePerson operation.
 //
-// Search on person records.
+// Change only some attributes of a single person record.
+// Without update_mask, attribute person is applied as a JSON Merge Patch (RFC 7396):
+// omitted attributes are left untouched, null removes an attribute and the maps token and settings
+// are merged key by key.
+// With update_mask, only the listed attributes are copied from attribute person.
+// Listed attributes that are omitted or null are cleared.
 //
-// POST /suggest-people
-func (s *Server) handleSuggestPeopleRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /update-person
+func (s *Server) handleUpdatePersonRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("SuggestPeople"),
+		otelogen.OperationID("UpdatePerson"),
 		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/suggest-people"),
+		semconv.HTTPRouteKey.String("/update-person"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "SuggestPeople",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "UpdatePerson",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2503,15 +6425,15 @@ func (s *Server) handleSuggestPeopleRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "SuggestPeople",
-			ID:   "SuggestPeople",
+			Name: "UpdatePerson",
+			ID:   "UpdatePerson",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityApiKey(ctx, "SuggestPeople", r)
+			sctx, ok, err := s.securityApiKey(ctx, "UpdatePerson", r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2553,7 +6475,7 @@ func (s *Server) handleSuggestPeopleRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
-	request, close, err := s.decodeSuggestPeopleRequest(r)
+	request, close, err := s.decodeUpdatePersonRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -2569,22 +6491,22 @@ func (s *Server) handleSuggestPeopleRequest(args [0]string, argsEscaped bool, w
 		}
 	}()
 
-	var response *PersonListResponse
+	var response *Person
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "SuggestPeople",
-			OperationSummary: "Search on person records",
-			OperationID:      "SuggestPeople",
+			OperationName:    "UpdatePerson",
+			OperationSummary: "Partially update a single person record",
+			OperationID:      "UpdatePerson",
 			Body:             request,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = *SuggestPeopleRequest
+			Request  = *UpdatePersonRequest
 			Params   = struct{}
-			Response = *PersonListResponse
+			Response = *Person
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2595,12 +6517,12 @@ func (s *Server) handleSuggestPeopleRequest(args [0]string, argsEscaped bool, w
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.SuggestPeople(ctx, request)
+				response, err = s.h.UpdatePerson(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.SuggestPeople(ctx, request)
+		response, err = s.h.UpdatePerson(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -2619,7 +6541,7 @@ func (s *Server) handleSuggestPeopleRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeSuggestPeopleResponse(response, w, span); err != nil {
+	if err := encodeUpdatePersonResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -0,0 +1,292 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/ogen-go/ogen/conv"
+	"github.com/ogen-go/ogen/middleware"
+	"github.com/ogen-go/ogen/ogenerrors"
+	"github.com/ogen-go/ogen/uri"
+)
+
+// AddOrganizationsParams is parameters of AddOrganizations operation.
+type AddOrganizationsParams struct {
+	MatchOn       OptAddOrganizationsMatchOn
+	Transactional OptBool
+}
+
+func unpackAddOrganizationsParams(packed middleware.Parameters) (params AddOrganizationsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "match_on",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.MatchOn = v.(OptAddOrganizationsMatchOn)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "transactional",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Transactional = v.(OptBool)
+		}
+	}
+	return params
+}
+
+func decodeAddOrganizationsParams(args [0]string, argsEscaped bool, r *http.Request) (params AddOrganizationsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: match_on.
+	{
+		val := AddOrganizationsMatchOn("id")
+		params.MatchOn.SetTo(val)
+	}
+	// Decode query: match_on.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "match_on",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotMatchOnVal AddOrganizationsMatchOn
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotMatchOnVal = AddOrganizationsMatchOn(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.MatchOn.SetTo(paramsDotMatchOnVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.MatchOn.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "match_on",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: transactional.
+	{
+		val := bool(false)
+		params.Transactional.SetTo(val)
+	}
+	// Decode query: transactional.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "transactional",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotTransactionalVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotTransactionalVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Transactional.SetTo(paramsDotTransactionalVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "transactional",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// AddPeopleParams is parameters of AddPeople operation.
+type AddPeopleParams struct {
+	MatchOn       OptAddPeopleMatchOn
+	Transactional OptBool
+}
+
+func unpackAddPeopleParams(packed middleware.Parameters) (params AddPeopleParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "match_on",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.MatchOn = v.(OptAddPeopleMatchOn)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "transactional",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Transactional = v.(OptBool)
+		}
+	}
+	return params
+}
+
+func decodeAddPeopleParams(args [0]string, argsEscaped bool, r *http.Request) (params AddPeopleParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: match_on.
+	{
+		val := AddPeopleMatchOn("id")
+		params.MatchOn.SetTo(val)
+	}
+	// Decode query: match_on.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "match_on",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotMatchOnVal AddPeopleMatchOn
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotMatchOnVal = AddPeopleMatchOn(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.MatchOn.SetTo(paramsDotMatchOnVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.MatchOn.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "match_on",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: transactional.
+	{
+		val := bool(false)
+		params.Transactional.SetTo(val)
+	}
+	// Decode query: transactional.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "transactional",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotTransactionalVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotTransactionalVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Transactional.SetTo(paramsDotTransactionalVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "transactional",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
@@ -78,7 +78,6 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					}
 
 					if len(elem) == 0 {
-						// Leaf node.
 						switch r.Method {
 						case "POST":
 							s.handleAddOrganizationRequest([0]string{}, elemIsEscaped, w, r)
@@ -86,6 +85,122 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							s.notAllowed(w, r, "POST")
 						}
 
+						return
+					}
+					switch elem[0] {
+					case 's': // Prefix: "s"
+						if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleAddOrganizationsRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+					}
+				case 'p': // Prefix: "pe"
+					if l := len("pe"); len(elem) >= l && elem[0:l] == "pe" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'o': // Prefix: "ople"
+						if l := len("ople"); len(elem) >= l && elem[0:l] == "ople" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleAddPeopleRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+					case 'r': // Prefix: "rson"
+						if l := len("rson"); len(elem) >= l && elem[0:l] == "rson" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleAddPersonRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+					}
+				case 'w': // Prefix: "webhook-subscription"
+					if l := len("webhook-subscription"); len(elem) >= l && elem[0:l] == "webhook-subscription" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "POST":
+							s.handleAddWebhookSubscriptionRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "POST")
+						}
+
+						return
+					}
+				}
+			case 'd': // Prefix: "delete-"
+				if l := len("delete-"); len(elem) >= l && elem[0:l] == "delete-" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'o': // Prefix: "organization"
+					if l := len("organization"); len(elem) >= l && elem[0:l] == "organization" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "POST":
+							s.handleDeleteOrganizationRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "POST")
+						}
+
 						return
 					}
 				case 'p': // Prefix: "person"
@@ -99,7 +214,25 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						// Leaf node.
 						switch r.Method {
 						case "POST":
-							s.handleAddPersonRequest([0]string{}, elemIsEscaped, w, r)
+							s.handleDeletePersonRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "POST")
+						}
+
+						return
+					}
+				case 'w': // Prefix: "webhook-subscription"
+					if l := len("webhook-subscription"); len(elem) >= l && elem[0:l] == "webhook-subscription" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "POST":
+							s.handleDeleteWebhookSubscriptionRequest([0]string{}, elemIsEscaped, w, r)
 						default:
 							s.notAllowed(w, r, "POST")
 						}
@@ -107,6 +240,24 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						return
 					}
 				}
+			case 'f': // Prefix: "find-person-duplicates"
+				if l := len("find-person-duplicates"); len(elem) >= l && elem[0:l] == "find-person-duplicates" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch r.Method {
+					case "POST":
+						s.handleFindPersonDuplicatesRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "POST")
+					}
+
+					return
+				}
 			case 'g': // Prefix: "get-"
 				if l := len("get-"); len(elem) >= l && elem[0:l] == "get-" {
 					elem = elem[l:]
@@ -136,6 +287,126 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						return
 					}
 					switch elem[0] {
+					case '-': // Prefix: "-"
+						if l := len("-"); len(elem) >= l && elem[0:l] == "-" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'a': // Prefix: "ancestors"
+							if l := len("ancestors"); len(elem) >= l && elem[0:l] == "ancestors" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleGetOrganizationAncestorsRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+						case 'd': // Prefix: "descendants"
+							if l := len("descendants"); len(elem) >= l && elem[0:l] == "descendants" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleGetOrganizationDescendantsRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+						case 'h': // Prefix: "history"
+							if l := len("history"); len(elem) >= l && elem[0:l] == "history" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleGetOrganizationHistoryRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+						case 'l': // Prefix: "lineage"
+							if l := len("lineage"); len(elem) >= l && elem[0:l] == "lineage" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleGetOrganizationLineageRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+						case 'm': // Prefix: "members"
+							if l := len("members"); len(elem) >= l && elem[0:l] == "members" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleGetOrganizationMembersRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+						case 't': // Prefix: "tree"
+							if l := len("tree"); len(elem) >= l && elem[0:l] == "tree" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleGetOrganizationTreeRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+						}
 					case 's': // Prefix: "s"
 						if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
 							elem = elem[l:]
@@ -268,7 +539,6 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						}
 
 						if len(elem) == 0 {
-							// Leaf node.
 							switch r.Method {
 							case "POST":
 								s.handleGetPersonRequest([0]string{}, elemIsEscaped, w, r)
@@ -278,21 +548,29 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 							return
 						}
-					}
-				}
-			case 's': // Prefix: "s"
-				if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+						switch elem[0] {
+						case '-': // Prefix: "-history"
+							if l := len("-history"); len(elem) >= l && elem[0:l] == "-history" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case 'e': // Prefix: "et-person-"
-					if l := len("et-person-"); len(elem) >= l && elem[0:l] == "et-person-" {
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleGetPersonHistoryRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+						}
+					}
+				case 'w': // Prefix: "webhook-"
+					if l := len("webhook-"); len(elem) >= l && elem[0:l] == "webhook-" {
 						elem = elem[l:]
 					} else {
 						break
@@ -302,8 +580,8 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						break
 					}
 					switch elem[0] {
-					case 'o': // Prefix: "orcid"
-						if l := len("orcid"); len(elem) >= l && elem[0:l] == "orcid" {
+					case 'd': // Prefix: "deliveries"
+						if l := len("deliveries"); len(elem) >= l && elem[0:l] == "deliveries" {
 							elem = elem[l:]
 						} else {
 							break
@@ -313,15 +591,15 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							// Leaf node.
 							switch r.Method {
 							case "POST":
-								s.handleSetPersonOrcidRequest([0]string{}, elemIsEscaped, w, r)
+								s.handleGetWebhookDeliveriesRequest([0]string{}, elemIsEscaped, w, r)
 							default:
 								s.notAllowed(w, r, "POST")
 							}
 
 							return
 						}
-					case 'r': // Prefix: "role"
-						if l := len("role"); len(elem) >= l && elem[0:l] == "role" {
+					case 's': // Prefix: "subscriptions"
+						if l := len("subscriptions"); len(elem) >= l && elem[0:l] == "subscriptions" {
 							elem = elem[l:]
 						} else {
 							break
@@ -331,48 +609,234 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							// Leaf node.
 							switch r.Method {
 							case "POST":
-								s.handleSetPersonRoleRequest([0]string{}, elemIsEscaped, w, r)
+								s.handleGetWebhookSubscriptionsRequest([0]string{}, elemIsEscaped, w, r)
 							default:
 								s.notAllowed(w, r, "POST")
 							}
 
 							return
 						}
-					case 's': // Prefix: "settings"
-						if l := len("settings"); len(elem) >= l && elem[0:l] == "settings" {
-							elem = elem[l:]
+					}
+				}
+			case 'm': // Prefix: "merge-people"
+				if l := len("merge-people"); len(elem) >= l && elem[0:l] == "merge-people" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch r.Method {
+					case "POST":
+						s.handleMergePeopleRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "POST")
+					}
+
+					return
+				}
+			case 'r': // Prefix: "revert-"
+				if l := len("revert-"); len(elem) >= l && elem[0:l] == "revert-" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'o': // Prefix: "organization"
+					if l := len("organization"); len(elem) >= l && elem[0:l] == "organization" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "POST":
+							s.handleRevertOrganizationRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "POST")
+						}
+
+						return
+					}
+				case 'p': // Prefix: "person"
+					if l := len("person"); len(elem) >= l && elem[0:l] == "person" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "POST":
+							s.handleRevertPersonRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "POST")
+						}
+
+						return
+					}
+				}
+			case 's': // Prefix: "s"
+				if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'e': // Prefix: "e"
+					if l := len("e"); len(elem) >= l && elem[0:l] == "e" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'a': // Prefix: "arch-"
+						if l := len("arch-"); len(elem) >= l && elem[0:l] == "arch-" {
+							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "POST":
-								s.handleSetPersonSettingsRequest([0]string{}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "POST")
+							break
+						}
+						switch elem[0] {
+						case 'o': // Prefix: "organizations"
+							if l := len("organizations"); len(elem) >= l && elem[0:l] == "organizations" {
+								elem = elem[l:]
+							} else {
+								break
 							}
 
-							return
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleSearchOrganizationsRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+						case 'p': // Prefix: "people"
+							if l := len("people"); len(elem) >= l && elem[0:l] == "people" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleSearchPeopleRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
 						}
-					case 't': // Prefix: "token"
-						if l := len("token"); len(elem) >= l && elem[0:l] == "token" {
+					case 't': // Prefix: "t-person-"
+						if l := len("t-person-"); len(elem) >= l && elem[0:l] == "t-person-" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "POST":
-								s.handleSetPersonTokenRequest([0]string{}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "POST")
+							break
+						}
+						switch elem[0] {
+						case 'o': // Prefix: "orcid"
+							if l := len("orcid"); len(elem) >= l && elem[0:l] == "orcid" {
+								elem = elem[l:]
+							} else {
+								break
 							}
 
-							return
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleSetPersonOrcidRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+						case 'r': // Prefix: "role"
+							if l := len("role"); len(elem) >= l && elem[0:l] == "role" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleSetPersonRoleRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+						case 's': // Prefix: "settings"
+							if l := len("settings"); len(elem) >= l && elem[0:l] == "settings" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleSetPersonSettingsRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+						case 't': // Prefix: "token"
+							if l := len("token"); len(elem) >= l && elem[0:l] == "token" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleSetPersonTokenRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
 						}
 					}
 				case 'u': // Prefix: "uggest-"
@@ -424,6 +888,54 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						}
 					}
 				}
+			case 'u': // Prefix: "update-"
+				if l := len("update-"); len(elem) >= l && elem[0:l] == "update-" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'o': // Prefix: "organization"
+					if l := len("organization"); len(elem) >= l && elem[0:l] == "organization" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "POST":
+							s.handleUpdateOrganizationRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "POST")
+						}
+
+						return
+					}
+				case 'p': // Prefix: "person"
+					if l := len("person"); len(elem) >= l && elem[0:l] == "person" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "POST":
+							s.handleUpdatePersonRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "POST")
+						}
+
+						return
+					}
+				}
 			}
 		}
 	}
@@ -537,7 +1049,6 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					if len(elem) == 0 {
 						switch method {
 						case "POST":
-							// Leaf: AddOrganization
 							r.name = "AddOrganization"
 							r.summary = "Insert/update a single organization record"
 							r.operationID = "AddOrganization"
@@ -549,6 +1060,142 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							return
 						}
 					}
+					switch elem[0] {
+					case 's': // Prefix: "s"
+						if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "POST":
+								// Leaf: AddOrganizations
+								r.name = "AddOrganizations"
+								r.summary = "Insert/update multiple organization records"
+								r.operationID = "AddOrganizations"
+								r.pathPattern = "/add-organizations"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+					}
+				case 'p': // Prefix: "pe"
+					if l := len("pe"); len(elem) >= l && elem[0:l] == "pe" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'o': // Prefix: "ople"
+						if l := len("ople"); len(elem) >= l && elem[0:l] == "ople" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "POST":
+								// Leaf: AddPeople
+								r.name = "AddPeople"
+								r.summary = "Insert/update multiple person records"
+								r.operationID = "AddPeople"
+								r.pathPattern = "/add-people"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+					case 'r': // Prefix: "rson"
+						if l := len("rson"); len(elem) >= l && elem[0:l] == "rson" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "POST":
+								// Leaf: AddPerson
+								r.name = "AddPerson"
+								r.summary = "Insert/update a single person record"
+								r.operationID = "AddPerson"
+								r.pathPattern = "/add-person"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+					}
+				case 'w': // Prefix: "webhook-subscription"
+					if l := len("webhook-subscription"); len(elem) >= l && elem[0:l] == "webhook-subscription" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "POST":
+							// Leaf: AddWebhookSubscription
+							r.name = "AddWebhookSubscription"
+							r.summary = "Register a webhook subscription"
+							r.operationID = "AddWebhookSubscription"
+							r.pathPattern = "/add-webhook-subscription"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+				}
+			case 'd': // Prefix: "delete-"
+				if l := len("delete-"); len(elem) >= l && elem[0:l] == "delete-" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'o': // Prefix: "organization"
+					if l := len("organization"); len(elem) >= l && elem[0:l] == "organization" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "POST":
+							// Leaf: DeleteOrganization
+							r.name = "DeleteOrganization"
+							r.summary = "Delete a single organization record"
+							r.operationID = "DeleteOrganization"
+							r.pathPattern = "/delete-organization"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
 				case 'p': // Prefix: "person"
 					if l := len("person"); len(elem) >= l && elem[0:l] == "person" {
 						elem = elem[l:]
@@ -559,11 +1206,33 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					if len(elem) == 0 {
 						switch method {
 						case "POST":
-							// Leaf: AddPerson
-							r.name = "AddPerson"
-							r.summary = "Insert/update a single person record"
-							r.operationID = "AddPerson"
-							r.pathPattern = "/add-person"
+							// Leaf: DeletePerson
+							r.name = "DeletePerson"
+							r.summary = "Delete a single person record"
+							r.operationID = "DeletePerson"
+							r.pathPattern = "/delete-person"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+				case 'w': // Prefix: "webhook-subscription"
+					if l := len("webhook-subscription"); len(elem) >= l && elem[0:l] == "webhook-subscription" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "POST":
+							// Leaf: DeleteWebhookSubscription
+							r.name = "DeleteWebhookSubscription"
+							r.summary = "Delete a webhook subscription"
+							r.operationID = "DeleteWebhookSubscription"
+							r.pathPattern = "/delete-webhook-subscription"
 							r.args = args
 							r.count = 0
 							return r, true
@@ -572,6 +1241,28 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						}
 					}
 				}
+			case 'f': // Prefix: "find-person-duplicates"
+				if l := len("find-person-duplicates"); len(elem) >= l && elem[0:l] == "find-person-duplicates" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch method {
+					case "POST":
+						// Leaf: FindPersonDuplicates
+						r.name = "FindPersonDuplicates"
+						r.summary = "Report person records that probably describe the same person"
+						r.operationID = "FindPersonDuplicates"
+						r.pathPattern = "/find-person-duplicates"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
 			case 'g': // Prefix: "get-"
 				if l := len("get-"); len(elem) >= l && elem[0:l] == "get-" {
 					elem = elem[l:]
@@ -605,6 +1296,150 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						}
 					}
 					switch elem[0] {
+					case '-': // Prefix: "-"
+						if l := len("-"); len(elem) >= l && elem[0:l] == "-" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'a': // Prefix: "ancestors"
+							if l := len("ancestors"); len(elem) >= l && elem[0:l] == "ancestors" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "POST":
+									// Leaf: GetOrganizationAncestors
+									r.name = "GetOrganizationAncestors"
+									r.summary = "Get the organizations above an organization"
+									r.operationID = "GetOrganizationAncestors"
+									r.pathPattern = "/get-organization-ancestors"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+						case 'd': // Prefix: "descendants"
+							if l := len("descendants"); len(elem) >= l && elem[0:l] == "descendants" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "POST":
+									// Leaf: GetOrganizationDescendants
+									r.name = "GetOrganizationDescendants"
+									r.summary = "Get the organizations below an organization"
+									r.operationID = "GetOrganizationDescendants"
+									r.pathPattern = "/get-organization-descendants"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+						case 'h': // Prefix: "history"
+							if l := len("history"); len(elem) >= l && elem[0:l] == "history" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "POST":
+									// Leaf: GetOrganizationHistory
+									r.name = "GetOrganizationHistory"
+									r.summary = "Retrieve the revision history of a single organization record"
+									r.operationID = "GetOrganizationHistory"
+									r.pathPattern = "/get-organization-history"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+						case 'l': // Prefix: "lineage"
+							if l := len("lineage"); len(elem) >= l && elem[0:l] == "lineage" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "POST":
+									// Leaf: GetOrganizationLineage
+									r.name = "GetOrganizationLineage"
+									r.summary = "Get the successors or predecessors of an organization"
+									r.operationID = "GetOrganizationLineage"
+									r.pathPattern = "/get-organization-lineage"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+						case 'm': // Prefix: "members"
+							if l := len("members"); len(elem) >= l && elem[0:l] == "members" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "POST":
+									// Leaf: GetOrganizationMembers
+									r.name = "GetOrganizationMembers"
+									r.summary = "Get the members of an organization"
+									r.operationID = "GetOrganizationMembers"
+									r.pathPattern = "/get-organization-members"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+						case 't': // Prefix: "tree"
+							if l := len("tree"); len(elem) >= l && elem[0:l] == "tree" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "POST":
+									// Leaf: GetOrganizationTree
+									r.name = "GetOrganizationTree"
+									r.summary = "Get the whole hierarchy of an organization"
+									r.operationID = "GetOrganizationTree"
+									r.pathPattern = "/get-organization-tree"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+						}
 					case 's': // Prefix: "s"
 						if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
 							elem = elem[l:]
@@ -763,7 +1598,6 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						if len(elem) == 0 {
 							switch method {
 							case "POST":
-								// Leaf: GetPerson
 								r.name = "GetPerson"
 								r.summary = "Retrieve a single person record"
 								r.operationID = "GetPerson"
@@ -775,21 +1609,33 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								return
 							}
 						}
-					}
-				}
-			case 's': // Prefix: "s"
-				if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+						switch elem[0] {
+						case '-': // Prefix: "-history"
+							if l := len("-history"); len(elem) >= l && elem[0:l] == "-history" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case 'e': // Prefix: "et-person-"
-					if l := len("et-person-"); len(elem) >= l && elem[0:l] == "et-person-" {
+							if len(elem) == 0 {
+								switch method {
+								case "POST":
+									// Leaf: GetPersonHistory
+									r.name = "GetPersonHistory"
+									r.summary = "Retrieve the revision history of a single person record"
+									r.operationID = "GetPersonHistory"
+									r.pathPattern = "/get-person-history"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+						}
+					}
+				case 'w': // Prefix: "webhook-"
+					if l := len("webhook-"); len(elem) >= l && elem[0:l] == "webhook-" {
 						elem = elem[l:]
 					} else {
 						break
@@ -799,8 +1645,8 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						break
 					}
 					switch elem[0] {
-					case 'o': // Prefix: "orcid"
-						if l := len("orcid"); len(elem) >= l && elem[0:l] == "orcid" {
+					case 'd': // Prefix: "deliveries"
+						if l := len("deliveries"); len(elem) >= l && elem[0:l] == "deliveries" {
 							elem = elem[l:]
 						} else {
 							break
@@ -809,11 +1655,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						if len(elem) == 0 {
 							switch method {
 							case "POST":
-								// Leaf: SetPersonOrcid
-								r.name = "SetPersonOrcid"
-								r.summary = "Update person ORCID"
-								r.operationID = "SetPersonOrcid"
-								r.pathPattern = "/set-person-orcid"
+								// Leaf: GetWebhookDeliveries
+								r.name = "GetWebhookDeliveries"
+								r.summary = "Retrieve the delivery log of a webhook subscription"
+								r.operationID = "GetWebhookDeliveries"
+								r.pathPattern = "/get-webhook-deliveries"
 								r.args = args
 								r.count = 0
 								return r, true
@@ -821,8 +1667,8 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								return
 							}
 						}
-					case 'r': // Prefix: "role"
-						if l := len("role"); len(elem) >= l && elem[0:l] == "role" {
+					case 's': // Prefix: "subscriptions"
+						if l := len("subscriptions"); len(elem) >= l && elem[0:l] == "subscriptions" {
 							elem = elem[l:]
 						} else {
 							break
@@ -831,11 +1677,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						if len(elem) == 0 {
 							switch method {
 							case "POST":
-								// Leaf: SetPersonRole
-								r.name = "SetPersonRole"
-								r.summary = "Update person role"
-								r.operationID = "SetPersonRole"
-								r.pathPattern = "/set-person-role"
+								// Leaf: GetWebhookSubscriptions
+								r.name = "GetWebhookSubscriptions"
+								r.summary = "Retrieve all webhook subscriptions"
+								r.operationID = "GetWebhookSubscriptions"
+								r.pathPattern = "/get-webhook-subscriptions"
 								r.args = args
 								r.count = 0
 								return r, true
@@ -843,48 +1689,262 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								return
 							}
 						}
-					case 's': // Prefix: "settings"
-						if l := len("settings"); len(elem) >= l && elem[0:l] == "settings" {
+					}
+				}
+			case 'm': // Prefix: "merge-people"
+				if l := len("merge-people"); len(elem) >= l && elem[0:l] == "merge-people" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch method {
+					case "POST":
+						// Leaf: MergePeople
+						r.name = "MergePeople"
+						r.summary = "Merge person records into a single person record"
+						r.operationID = "MergePeople"
+						r.pathPattern = "/merge-people"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+			case 'r': // Prefix: "revert-"
+				if l := len("revert-"); len(elem) >= l && elem[0:l] == "revert-" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'o': // Prefix: "organization"
+					if l := len("organization"); len(elem) >= l && elem[0:l] == "organization" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "POST":
+							// Leaf: RevertOrganization
+							r.name = "RevertOrganization"
+							r.summary = "Revert organization records to an earlier revision"
+							r.operationID = "RevertOrganization"
+							r.pathPattern = "/revert-organization"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+				case 'p': // Prefix: "person"
+					if l := len("person"); len(elem) >= l && elem[0:l] == "person" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "POST":
+							// Leaf: RevertPerson
+							r.name = "RevertPerson"
+							r.summary = "Revert person records to an earlier revision"
+							r.operationID = "RevertPerson"
+							r.pathPattern = "/revert-person"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+				}
+			case 's': // Prefix: "s"
+				if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'e': // Prefix: "e"
+					if l := len("e"); len(elem) >= l && elem[0:l] == "e" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'a': // Prefix: "arch-"
+						if l := len("arch-"); len(elem) >= l && elem[0:l] == "arch-" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							switch method {
-							case "POST":
-								// Leaf: SetPersonSettings
-								r.name = "SetPersonSettings"
-								r.summary = "Update person settings"
-								r.operationID = "SetPersonSettings"
-								r.pathPattern = "/set-person-settings"
-								r.args = args
-								r.count = 0
-								return r, true
-							default:
-								return
+							break
+						}
+						switch elem[0] {
+						case 'o': // Prefix: "organizations"
+							if l := len("organizations"); len(elem) >= l && elem[0:l] == "organizations" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "POST":
+									// Leaf: SearchOrganizations
+									r.name = "SearchOrganizations"
+									r.summary = "Search organization records with filters and facets"
+									r.operationID = "SearchOrganizations"
+									r.pathPattern = "/search-organizations"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+						case 'p': // Prefix: "people"
+							if l := len("people"); len(elem) >= l && elem[0:l] == "people" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "POST":
+									// Leaf: SearchPeople
+									r.name = "SearchPeople"
+									r.summary = "Search person records with filters and facets"
+									r.operationID = "SearchPeople"
+									r.pathPattern = "/search-people"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
 							}
 						}
-					case 't': // Prefix: "token"
-						if l := len("token"); len(elem) >= l && elem[0:l] == "token" {
+					case 't': // Prefix: "t-person-"
+						if l := len("t-person-"); len(elem) >= l && elem[0:l] == "t-person-" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							switch method {
-							case "POST":
-								// Leaf: SetPersonToken
-								r.name = "SetPersonToken"
-								r.summary = "Update person tokens"
-								r.operationID = "SetPersonToken"
-								r.pathPattern = "/set-person-token"
-								r.args = args
-								r.count = 0
-								return r, true
-							default:
-								return
+							break
+						}
+						switch elem[0] {
+						case 'o': // Prefix: "orcid"
+							if l := len("orcid"); len(elem) >= l && elem[0:l] == "orcid" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "POST":
+									// Leaf: SetPersonOrcid
+									r.name = "SetPersonOrcid"
+									r.summary = "Update person ORCID"
+									r.operationID = "SetPersonOrcid"
+									r.pathPattern = "/set-person-orcid"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+						case 'r': // Prefix: "role"
+							if l := len("role"); len(elem) >= l && elem[0:l] == "role" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "POST":
+									// Leaf: SetPersonRole
+									r.name = "SetPersonRole"
+									r.summary = "Update person role"
+									r.operationID = "SetPersonRole"
+									r.pathPattern = "/set-person-role"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+						case 's': // Prefix: "settings"
+							if l := len("settings"); len(elem) >= l && elem[0:l] == "settings" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "POST":
+									// Leaf: SetPersonSettings
+									r.name = "SetPersonSettings"
+									r.summary = "Update person settings"
+									r.operationID = "SetPersonSettings"
+									r.pathPattern = "/set-person-settings"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+						case 't': // Prefix: "token"
+							if l := len("token"); len(elem) >= l && elem[0:l] == "token" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "POST":
+									// Leaf: SetPersonToken
+									r.name = "SetPersonToken"
+									r.summary = "Update person tokens"
+									r.operationID = "SetPersonToken"
+									r.pathPattern = "/set-person-token"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
 							}
 						}
 					}
@@ -945,6 +2005,62 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						}
 					}
 				}
+			case 'u': // Prefix: "update-"
+				if l := len("update-"); len(elem) >= l && elem[0:l] == "update-" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'o': // Prefix: "organization"
+					if l := len("organization"); len(elem) >= l && elem[0:l] == "organization" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "POST":
+							// Leaf: UpdateOrganization
+							r.name = "UpdateOrganization"
+							r.summary = "Partially update a single organization record"
+							r.operationID = "UpdateOrganization"
+							r.pathPattern = "/update-organization"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+				case 'p': // Prefix: "person"
+					if l := len("person"); len(elem) >= l && elem[0:l] == "person" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "POST":
+							// Leaf: UpdatePerson
+							r.name = "UpdatePerson"
+							r.summary = "Partially update a single person record"
+							r.operationID = "UpdatePerson"
+							r.pathPattern = "/update-person"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+				}
 			}
 		}
 	}
@@ -4,13 +4,276 @@ package api
 
 import (
 	"fmt"
+	"io"
+	"net/url"
 	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/go-faster/jx"
 )
 
 func (s *ErrorStatusCode) Error() string {
 	return fmt.Sprintf("code %d: %+v", s.StatusCode, s.Response)
 }
 
+// Ref: #/components/schemas/Actor
+type Actor struct {
+	// Api, ldapsync, cli or unknown.
+	Source string `json:"source"`
+	// Api key fingerprint, ldapsync run id or system user.
+	ID OptString `json:"id"`
+}
+
+// GetSource returns the value of Source.
+func (s *Actor) GetSource() string {
+	return s.Source
+}
+
+// GetID returns the value of ID.
+func (s *Actor) GetID() OptString {
+	return s.ID
+}
+
+// SetSource sets the value of Source.
+func (s *Actor) SetSource(val string) {
+	s.Source = val
+}
+
+// SetID sets the value of ID.
+func (s *Actor) SetID(val OptString) {
+	s.ID = val
+}
+
+type AddOrganizationsMatchOn string
+
+const (
+	AddOrganizationsMatchOnID                AddOrganizationsMatchOn = "id"
+	AddOrganizationsMatchOnGismoID           AddOrganizationsMatchOn = "gismo_id"
+	AddOrganizationsMatchOnUgentID           AddOrganizationsMatchOn = "ugent_id"
+	AddOrganizationsMatchOnBiblioID          AddOrganizationsMatchOn = "biblio_id"
+	AddOrganizationsMatchOnUgentMemorialisID AddOrganizationsMatchOn = "ugent_memorialis_id"
+)
+
+// AllValues returns all AddOrganizationsMatchOn values.
+func (AddOrganizationsMatchOn) AllValues() []AddOrganizationsMatchOn {
+	return []AddOrganizationsMatchOn{
+		AddOrganizationsMatchOnID,
+		AddOrganizationsMatchOnGismoID,
+		AddOrganizationsMatchOnUgentID,
+		AddOrganizationsMatchOnBiblioID,
+		AddOrganizationsMatchOnUgentMemorialisID,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s AddOrganizationsMatchOn) MarshalText() ([]byte, error) {
+	switch s {
+	case AddOrganizationsMatchOnID:
+		return []byte(s), nil
+	case AddOrganizationsMatchOnGismoID:
+		return []byte(s), nil
+	case AddOrganizationsMatchOnUgentID:
+		return []byte(s), nil
+	case AddOrganizationsMatchOnBiblioID:
+		return []byte(s), nil
+	case AddOrganizationsMatchOnUgentMemorialisID:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *AddOrganizationsMatchOn) UnmarshalText(data []byte) error {
+	switch AddOrganizationsMatchOn(data) {
+	case AddOrganizationsMatchOnID:
+		*s = AddOrganizationsMatchOnID
+		return nil
+	case AddOrganizationsMatchOnGismoID:
+		*s = AddOrganizationsMatchOnGismoID
+		return nil
+	case AddOrganizationsMatchOnUgentID:
+		*s = AddOrganizationsMatchOnUgentID
+		return nil
+	case AddOrganizationsMatchOnBiblioID:
+		*s = AddOrganizationsMatchOnBiblioID
+		return nil
+	case AddOrganizationsMatchOnUgentMemorialisID:
+		*s = AddOrganizationsMatchOnUgentMemorialisID
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type AddOrganizationsReqApplicationJSON []Organization
+
+func (*AddOrganizationsReqApplicationJSON) addOrganizationsReq() {}
+
+type AddOrganizationsReqApplicationXNdjson struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s AddOrganizationsReqApplicationXNdjson) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*AddOrganizationsReqApplicationXNdjson) addOrganizationsReq() {}
+
+type AddPeopleMatchOn string
+
+const (
+	AddPeopleMatchOnID                AddPeopleMatchOn = "id"
+	AddPeopleMatchOnOrcid             AddPeopleMatchOn = "orcid"
+	AddPeopleMatchOnGismoID           AddPeopleMatchOn = "gismo_id"
+	AddPeopleMatchOnUgentID           AddPeopleMatchOn = "ugent_id"
+	AddPeopleMatchOnHistoricUgentID   AddPeopleMatchOn = "historic_ugent_id"
+	AddPeopleMatchOnUgentBarcode      AddPeopleMatchOn = "ugent_barcode"
+	AddPeopleMatchOnUgentUsername     AddPeopleMatchOn = "ugent_username"
+	AddPeopleMatchOnUgentMemorialisID AddPeopleMatchOn = "ugent_memorialis_id"
+	AddPeopleMatchOnBiblioID          AddPeopleMatchOn = "biblio_id"
+)
+
+// AllValues returns all AddPeopleMatchOn values.
+func (AddPeopleMatchOn) AllValues() []AddPeopleMatchOn {
+	return []AddPeopleMatchOn{
+		AddPeopleMatchOnID,
+		AddPeopleMatchOnOrcid,
+		AddPeopleMatchOnGismoID,
+		AddPeopleMatchOnUgentID,
+		AddPeopleMatchOnHistoricUgentID,
+		AddPeopleMatchOnUgentBarcode,
+		AddPeopleMatchOnUgentUsername,
+		AddPeopleMatchOnUgentMemorialisID,
+		AddPeopleMatchOnBiblioID,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s AddPeopleMatchOn) MarshalText() ([]byte, error) {
+	switch s {
+	case AddPeopleMatchOnID:
+		return []byte(s), nil
+	case AddPeopleMatchOnOrcid:
+		return []byte(s), nil
+	case AddPeopleMatchOnGismoID:
+		return []byte(s), nil
+	case AddPeopleMatchOnUgentID:
+		return []byte(s), nil
+	case AddPeopleMatchOnHistoricUgentID:
+		return []byte(s), nil
+	case AddPeopleMatchOnUgentBarcode:
+		return []byte(s), nil
+	case AddPeopleMatchOnUgentUsername:
+		return []byte(s), nil
+	case AddPeopleMatchOnUgentMemorialisID:
+		return []byte(s), nil
+	case AddPeopleMatchOnBiblioID:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *AddPeopleMatchOn) UnmarshalText(data []byte) error {
+	switch AddPeopleMatchOn(data) {
+	case AddPeopleMatchOnID:
+		*s = AddPeopleMatchOnID
+		return nil
+	case AddPeopleMatchOnOrcid:
+		*s = AddPeopleMatchOnOrcid
+		return nil
+	case AddPeopleMatchOnGismoID:
+		*s = AddPeopleMatchOnGismoID
+		return nil
+	case AddPeopleMatchOnUgentID:
+		*s = AddPeopleMatchOnUgentID
+		return nil
+	case AddPeopleMatchOnHistoricUgentID:
+		*s = AddPeopleMatchOnHistoricUgentID
+		return nil
+	case AddPeopleMatchOnUgentBarcode:
+		*s = AddPeopleMatchOnUgentBarcode
+		return nil
+	case AddPeopleMatchOnUgentUsername:
+		*s = AddPeopleMatchOnUgentUsername
+		return nil
+	case AddPeopleMatchOnUgentMemorialisID:
+		*s = AddPeopleMatchOnUgentMemorialisID
+		return nil
+	case AddPeopleMatchOnBiblioID:
+		*s = AddPeopleMatchOnBiblioID
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type AddPeopleReqApplicationJSON []Person
+
+func (*AddPeopleReqApplicationJSON) addPeopleReq() {}
+
+type AddPeopleReqApplicationXNdjson struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s AddPeopleReqApplicationXNdjson) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*AddPeopleReqApplicationXNdjson) addPeopleReq() {}
+
+// Ref: #/components/schemas/AddWebhookSubscriptionRequest
+type AddWebhookSubscriptionRequest struct {
+	// Absolute http or https url.
+	URL       url.URL     `json:"url"`
+	EventType []EventType `json:"event_type"`
+	Secret    string      `json:"secret"`
+}
+
+// GetURL returns the value of URL.
+func (s *AddWebhookSubscriptionRequest) GetURL() url.URL {
+	return s.URL
+}
+
+// GetEventType returns the value of EventType.
+func (s *AddWebhookSubscriptionRequest) GetEventType() []EventType {
+	return s.EventType
+}
+
+// GetSecret returns the value of Secret.
+func (s *AddWebhookSubscriptionRequest) GetSecret() string {
+	return s.Secret
+}
+
+// SetURL sets the value of URL.
+func (s *AddWebhookSubscriptionRequest) SetURL(val url.URL) {
+	s.URL = val
+}
+
+// SetEventType sets the value of EventType.
+func (s *AddWebhookSubscriptionRequest) SetEventType(val []EventType) {
+	s.EventType = val
+}
+
+// SetSecret sets the value of Secret.
+func (s *AddWebhookSubscriptionRequest) SetSecret(val string) {
+	s.Secret = val
+}
+
 type ApiKey struct {
 	APIKey string
 }
@@ -25,10 +288,77 @@ func (s *ApiKey) SetAPIKey(val string) {
 	s.APIKey = val
 }
 
+// DeleteOrganizationOK is response for DeleteOrganization operation.
+type DeleteOrganizationOK struct{}
+
+// Ref: #/components/schemas/DeleteOrganizationRequest
+type DeleteOrganizationRequest struct {
+	ID string `json:"id"`
+	// Also remove memberships and detach child organizations.
+	Cascade OptBool `json:"cascade"`
+}
+
+// GetID returns the value of ID.
+func (s *DeleteOrganizationRequest) GetID() string {
+	return s.ID
+}
+
+// GetCascade returns the value of Cascade.
+func (s *DeleteOrganizationRequest) GetCascade() OptBool {
+	return s.Cascade
+}
+
+// SetID sets the value of ID.
+func (s *DeleteOrganizationRequest) SetID(val string) {
+	s.ID = val
+}
+
+// SetCascade sets the value of Cascade.
+func (s *DeleteOrganizationRequest) SetCascade(val OptBool) {
+	s.Cascade = val
+}
+
+// DeletePersonOK is response for DeletePerson operation.
+type DeletePersonOK struct{}
+
+// Ref: #/components/schemas/DeletePersonRequest
+type DeletePersonRequest struct {
+	ID string `json:"id"`
+}
+
+// GetID returns the value of ID.
+func (s *DeletePersonRequest) GetID() string {
+	return s.ID
+}
+
+// SetID sets the value of ID.
+func (s *DeletePersonRequest) SetID(val string) {
+	s.ID = val
+}
+
+// DeleteWebhookSubscriptionOK is response for DeleteWebhookSubscription operation.
+type DeleteWebhookSubscriptionOK struct{}
+
+// Ref: #/components/schemas/DeleteWebhookSubscriptionRequest
+type DeleteWebhookSubscriptionRequest struct {
+	ID string `json:"id"`
+}
+
+// GetID returns the value of ID.
+func (s *DeleteWebhookSubscriptionRequest) GetID() string {
+	return s.ID
+}
+
+// SetID sets the value of ID.
+func (s *DeleteWebhookSubscriptionRequest) SetID(val string) {
+	s.ID = val
+}
+
 // Ref: #/components/schemas/Error
 type Error struct {
-	Code    int64  `json:"code"`
-	Message string `json:"message"`
+	Code      int64        `json:"code"`
+	Message   string       `json:"message"`
+	Tombstone OptTombstone `json:"tombstone"`
 }
 
 // GetCode returns the value of Code.
@@ -41,6 +371,11 @@ func (s *Error) GetMessage() string {
 	return s.Message
 }
 
+// GetTombstone returns the value of Tombstone.
+func (s *Error) GetTombstone() OptTombstone {
+	return s.Tombstone
+}
+
 // SetCode sets the value of Code.
 func (s *Error) SetCode(val int64) {
 	s.Code = val
@@ -51,6 +386,11 @@ func (s *Error) SetMessage(val string) {
 	s.Message = val
 }
 
+// SetTombstone sets the value of Tombstone.
+func (s *Error) SetTombstone(val OptTombstone) {
+	s.Tombstone = val
+}
+
 // ErrorStatusCode wraps Error with StatusCode.
 type ErrorStatusCode struct {
 	StatusCode int
@@ -77,1104 +417,5716 @@ func (s *ErrorStatusCode) SetResponse(val Error) {
 	s.Response = val
 }
 
-// Ref: #/components/schemas/GetOrganizationRequest
-type GetOrganizationRequest struct {
-	ID string `json:"id"`
+// Ref: #/components/schemas/EventType
+type EventType string
+
+const (
+	EventTypePersonCreated       EventType = "person.created"
+	EventTypePersonUpdated       EventType = "person.updated"
+	EventTypePersonDeleted       EventType = "person.deleted"
+	EventTypePersonActivated     EventType = "person.activated"
+	EventTypePersonDeactivated   EventType = "person.deactivated"
+	EventTypeOrganizationCreated EventType = "organization.created"
+	EventTypeOrganizationUpdated EventType = "organization.updated"
+	EventTypeOrganizationDeleted EventType = "organization.deleted"
+)
+
+// AllValues returns all EventType values.
+func (EventType) AllValues() []EventType {
+	return []EventType{
+		EventTypePersonCreated,
+		EventTypePersonUpdated,
+		EventTypePersonDeleted,
+		EventTypePersonActivated,
+		EventTypePersonDeactivated,
+		EventTypeOrganizationCreated,
+		EventTypeOrganizationUpdated,
+		EventTypeOrganizationDeleted,
+	}
 }
 
-// GetID returns the value of ID.
-func (s *GetOrganizationRequest) GetID() string {
-	return s.ID
+// MarshalText implements encoding.TextMarshaler.
+func (s EventType) MarshalText() ([]byte, error) {
+	switch s {
+	case EventTypePersonCreated:
+		return []byte(s), nil
+	case EventTypePersonUpdated:
+		return []byte(s), nil
+	case EventTypePersonDeleted:
+		return []byte(s), nil
+	case EventTypePersonActivated:
+		return []byte(s), nil
+	case EventTypePersonDeactivated:
+		return []byte(s), nil
+	case EventTypeOrganizationCreated:
+		return []byte(s), nil
+	case EventTypeOrganizationUpdated:
+		return []byte(s), nil
+	case EventTypeOrganizationDeleted:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetID sets the value of ID.
-func (s *GetOrganizationRequest) SetID(val string) {
-	s.ID = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *EventType) UnmarshalText(data []byte) error {
+	switch EventType(data) {
+	case EventTypePersonCreated:
+		*s = EventTypePersonCreated
+		return nil
+	case EventTypePersonUpdated:
+		*s = EventTypePersonUpdated
+		return nil
+	case EventTypePersonDeleted:
+		*s = EventTypePersonDeleted
+		return nil
+	case EventTypePersonActivated:
+		*s = EventTypePersonActivated
+		return nil
+	case EventTypePersonDeactivated:
+		*s = EventTypePersonDeactivated
+		return nil
+	case EventTypeOrganizationCreated:
+		*s = EventTypeOrganizationCreated
+		return nil
+	case EventTypeOrganizationUpdated:
+		*s = EventTypeOrganizationUpdated
+		return nil
+	case EventTypeOrganizationDeleted:
+		*s = EventTypeOrganizationDeleted
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// Ref: #/components/schemas/GetOrganizationsByIdRequest
-type GetOrganizationsByIdRequest struct {
-	ID []string `json:"id"`
+// Ref: #/components/schemas/FacetValue
+type FacetValue struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
 }
 
-// GetID returns the value of ID.
-func (s *GetOrganizationsByIdRequest) GetID() []string {
-	return s.ID
+// GetValue returns the value of Value.
+func (s *FacetValue) GetValue() string {
+	return s.Value
 }
 
-// SetID sets the value of ID.
-func (s *GetOrganizationsByIdRequest) SetID(val []string) {
-	s.ID = val
+// GetCount returns the value of Count.
+func (s *FacetValue) GetCount() int {
+	return s.Count
 }
 
-// Ref: #/components/schemas/GetOrganizationsByIdentifierRequest
-type GetOrganizationsByIdentifierRequest struct {
-	Identifier []string `json:"identifier"`
+// SetValue sets the value of Value.
+func (s *FacetValue) SetValue(val string) {
+	s.Value = val
 }
 
-// GetIdentifier returns the value of Identifier.
-func (s *GetOrganizationsByIdentifierRequest) GetIdentifier() []string {
-	return s.Identifier
+// SetCount sets the value of Count.
+func (s *FacetValue) SetCount(val int) {
+	s.Count = val
 }
 
-// SetIdentifier sets the value of Identifier.
-func (s *GetOrganizationsByIdentifierRequest) SetIdentifier(val []string) {
-	s.Identifier = val
+// Ref: #/components/schemas/FieldChange
+type FieldChange struct {
+	Field string `json:"field"`
+	// Previous value, absent when the attribute was not set.
+	Old jx.Raw `json:"old"`
+	// New value, absent when the attribute was removed.
+	New jx.Raw `json:"new"`
 }
 
-// Ref: #/components/schemas/GetOrganizationsRequest
-type GetOrganizationsRequest struct {
-	Cursor OptString `json:"cursor"`
+// GetField returns the value of Field.
+func (s *FieldChange) GetField() string {
+	return s.Field
 }
 
-// GetCursor returns the value of Cursor.
-func (s *GetOrganizationsRequest) GetCursor() OptString {
-	return s.Cursor
+// GetOld returns the value of Old.
+func (s *FieldChange) GetOld() jx.Raw {
+	return s.Old
 }
 
-// SetCursor sets the value of Cursor.
-func (s *GetOrganizationsRequest) SetCursor(val OptString) {
-	s.Cursor = val
+// GetNew returns the value of New.
+func (s *FieldChange) GetNew() jx.Raw {
+	return s.New
 }
 
-// Ref: #/components/schemas/GetPeopleByIdRequest
-type GetPeopleByIdRequest struct {
-	ID []string `json:"id"`
+// SetField sets the value of Field.
+func (s *FieldChange) SetField(val string) {
+	s.Field = val
 }
 
-// GetID returns the value of ID.
-func (s *GetPeopleByIdRequest) GetID() []string {
-	return s.ID
+// SetOld sets the value of Old.
+func (s *FieldChange) SetOld(val jx.Raw) {
+	s.Old = val
 }
 
-// SetID sets the value of ID.
-func (s *GetPeopleByIdRequest) SetID(val []string) {
-	s.ID = val
+// SetNew sets the value of New.
+func (s *FieldChange) SetNew(val jx.Raw) {
+	s.New = val
 }
 
-// Ref: #/components/schemas/GetPeopleByIdentifierRequest
-type GetPeopleByIdentifierRequest struct {
-	Identifier []string `json:"identifier"`
+// Ref: #/components/schemas/FieldMatch
+type FieldMatch struct {
+	// Matched attribute, identifiers as identifier.<namespace> (e.g. identifier.ugent_id).
+	Field string `json:"field"`
+	Value string `json:"value"`
+	// Value with the matched terms between <b> and </b>.
+	Highlight string `json:"highlight"`
 }
 
-// GetIdentifier returns the value of Identifier.
-func (s *GetPeopleByIdentifierRequest) GetIdentifier() []string {
-	return s.Identifier
+// GetField returns the value of Field.
+func (s *FieldMatch) GetField() string {
+	return s.Field
 }
 
-// SetIdentifier sets the value of Identifier.
-func (s *GetPeopleByIdentifierRequest) SetIdentifier(val []string) {
-	s.Identifier = val
+// GetValue returns the value of Value.
+func (s *FieldMatch) GetValue() string {
+	return s.Value
 }
 
-// Ref: #/components/schemas/GetPeopleRequest
-type GetPeopleRequest struct {
-	Cursor OptString `json:"cursor"`
+// GetHighlight returns the value of Highlight.
+func (s *FieldMatch) GetHighlight() string {
+	return s.Highlight
 }
 
-// GetCursor returns the value of Cursor.
-func (s *GetPeopleRequest) GetCursor() OptString {
-	return s.Cursor
+// SetField sets the value of Field.
+func (s *FieldMatch) SetField(val string) {
+	s.Field = val
 }
 
-// SetCursor sets the value of Cursor.
-func (s *GetPeopleRequest) SetCursor(val OptString) {
-	s.Cursor = val
+// SetValue sets the value of Value.
+func (s *FieldMatch) SetValue(val string) {
+	s.Value = val
 }
 
-// Ref: #/components/schemas/GetPersonRequest
-type GetPersonRequest struct {
+// SetHighlight sets the value of Highlight.
+func (s *FieldMatch) SetHighlight(val string) {
+	s.Highlight = val
+}
+
+// Ref: #/components/schemas/FindPersonDuplicatesRequest
+type FindPersonDuplicatesRequest struct {
+	// Minimum score of a pair (default 0.6).
+	MinScore OptFloat64 `json:"min_score"`
+	// Minimum trigram similarity of two names to compare them (default 0.8).
+	NameThreshold OptFloat64 `json:"name_threshold"`
+	// Maximum number of pairs, 0 returns all pairs.
+	Limit OptInt `json:"limit"`
+}
+
+// GetMinScore returns the value of MinScore.
+func (s *FindPersonDuplicatesRequest) GetMinScore() OptFloat64 {
+	return s.MinScore
+}
+
+// GetNameThreshold returns the value of NameThreshold.
+func (s *FindPersonDuplicatesRequest) GetNameThreshold() OptFloat64 {
+	return s.NameThreshold
+}
+
+// GetLimit returns the value of Limit.
+func (s *FindPersonDuplicatesRequest) GetLimit() OptInt {
+	return s.Limit
+}
+
+// SetMinScore sets the value of MinScore.
+func (s *FindPersonDuplicatesRequest) SetMinScore(val OptFloat64) {
+	s.MinScore = val
+}
+
+// SetNameThreshold sets the value of NameThreshold.
+func (s *FindPersonDuplicatesRequest) SetNameThreshold(val OptFloat64) {
+	s.NameThreshold = val
+}
+
+// SetLimit sets the value of Limit.
+func (s *FindPersonDuplicatesRequest) SetLimit(val OptInt) {
+	s.Limit = val
+}
+
+// Ref: #/components/schemas/GetOrganizationHistoryRequest
+type GetOrganizationHistoryRequest struct {
 	ID string `json:"id"`
 }
 
 // GetID returns the value of ID.
-func (s *GetPersonRequest) GetID() string {
+func (s *GetOrganizationHistoryRequest) GetID() string {
 	return s.ID
 }
 
 // SetID sets the value of ID.
-func (s *GetPersonRequest) SetID(val string) {
+func (s *GetOrganizationHistoryRequest) SetID(val string) {
 	s.ID = val
 }
 
-// NewOptBool returns new OptBool with value set to v.
-func NewOptBool(v bool) OptBool {
-	return OptBool{
-		Value: v,
-		Set:   true,
-	}
+// Ref: #/components/schemas/GetOrganizationLineageRequest
+type GetOrganizationLineageRequest struct {
+	ID        string                                    `json:"id"`
+	Direction OptGetOrganizationLineageRequestDirection `json:"direction"`
+	// Only follow relations that took effect at this time (default now).
+	AsOf OptDateTime `json:"as_of"`
+	// Maximum number of steps, 0 follows all steps.
+	MaxDepth OptInt `json:"max_depth"`
 }
 
-// OptBool is optional bool.
-type OptBool struct {
-	Value bool
-	Set   bool
+// GetID returns the value of ID.
+func (s *GetOrganizationLineageRequest) GetID() string {
+	return s.ID
 }
 
-// IsSet returns true if OptBool was set.
-func (o OptBool) IsSet() bool { return o.Set }
+// GetDirection returns the value of Direction.
+func (s *GetOrganizationLineageRequest) GetDirection() OptGetOrganizationLineageRequestDirection {
+	return s.Direction
+}
 
-// Reset unsets value.
-func (o *OptBool) Reset() {
-	var v bool
-	o.Value = v
-	o.Set = false
+// GetAsOf returns the value of AsOf.
+func (s *GetOrganizationLineageRequest) GetAsOf() OptDateTime {
+	return s.AsOf
 }
 
-// SetTo sets value to v.
-func (o *OptBool) SetTo(v bool) {
-	o.Set = true
-	o.Value = v
+// GetMaxDepth returns the value of MaxDepth.
+func (s *GetOrganizationLineageRequest) GetMaxDepth() OptInt {
+	return s.MaxDepth
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptBool) Get() (v bool, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetID sets the value of ID.
+func (s *GetOrganizationLineageRequest) SetID(val string) {
+	s.ID = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptBool) Or(d bool) bool {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetDirection sets the value of Direction.
+func (s *GetOrganizationLineageRequest) SetDirection(val OptGetOrganizationLineageRequestDirection) {
+	s.Direction = val
 }
 
-// NewOptDateTime returns new OptDateTime with value set to v.
-func NewOptDateTime(v time.Time) OptDateTime {
-	return OptDateTime{
-		Value: v,
-		Set:   true,
-	}
+// SetAsOf sets the value of AsOf.
+func (s *GetOrganizationLineageRequest) SetAsOf(val OptDateTime) {
+	s.AsOf = val
 }
 
-// OptDateTime is optional time.Time.
-type OptDateTime struct {
-	Value time.Time
-	Set   bool
+// SetMaxDepth sets the value of MaxDepth.
+func (s *GetOrganizationLineageRequest) SetMaxDepth(val OptInt) {
+	s.MaxDepth = val
 }
 
-// IsSet returns true if OptDateTime was set.
-func (o OptDateTime) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptDateTime) Reset() {
-	var v time.Time
-	o.Value = v
-	o.Set = false
-}
+type GetOrganizationLineageRequestDirection string
 
-// SetTo sets value to v.
-func (o *OptDateTime) SetTo(v time.Time) {
-	o.Set = true
-	o.Value = v
-}
+const (
+	GetOrganizationLineageRequestDirectionSuccessors   GetOrganizationLineageRequestDirection = "successors"
+	GetOrganizationLineageRequestDirectionPredecessors GetOrganizationLineageRequestDirection = "predecessors"
+)
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptDateTime) Get() (v time.Time, ok bool) {
-	if !o.Set {
-		return v, false
+// AllValues returns all GetOrganizationLineageRequestDirection values.
+func (GetOrganizationLineageRequestDirection) AllValues() []GetOrganizationLineageRequestDirection {
+	return []GetOrganizationLineageRequestDirection{
+		GetOrganizationLineageRequestDirectionSuccessors,
+		GetOrganizationLineageRequestDirectionPredecessors,
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptDateTime) Or(d time.Time) time.Time {
-	if v, ok := o.Get(); ok {
-		return v
+// MarshalText implements encoding.TextMarshaler.
+func (s GetOrganizationLineageRequestDirection) MarshalText() ([]byte, error) {
+	switch s {
+	case GetOrganizationLineageRequestDirectionSuccessors:
+		return []byte(s), nil
+	case GetOrganizationLineageRequestDirectionPredecessors:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
-	return d
 }
 
-// NewOptInt returns new OptInt with value set to v.
-func NewOptInt(v int) OptInt {
-	return OptInt{
-		Value: v,
-		Set:   true,
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GetOrganizationLineageRequestDirection) UnmarshalText(data []byte) error {
+	switch GetOrganizationLineageRequestDirection(data) {
+	case GetOrganizationLineageRequestDirectionSuccessors:
+		*s = GetOrganizationLineageRequestDirectionSuccessors
+		return nil
+	case GetOrganizationLineageRequestDirectionPredecessors:
+		*s = GetOrganizationLineageRequestDirectionPredecessors
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// OptInt is optional int.
-type OptInt struct {
-	Value int
-	Set   bool
+// Ref: #/components/schemas/GetOrganizationMembersRequest
+type GetOrganizationMembersRequest struct {
+	ID string `json:"id"`
+	// Also return members of organizations below this organization.
+	IncludeDescendants OptBool `json:"include_descendants"`
+	// Only return memberships valid at this time (default now), and follow parent relations valid at
+	// this time.
+	AsOf OptDateTime `json:"as_of"`
+	// Also return past and future members, can't be combined with as_of.
+	Historic OptBool `json:"historic"`
+	Active   []bool  `json:"active"`
+	// Only return person ids.
+	IdsOnly OptBool   `json:"ids_only"`
+	Cursor  OptString `json:"cursor"`
+	// Page size (default 200).
+	Limit OptInt `json:"limit"`
 }
 
-// IsSet returns true if OptInt was set.
-func (o OptInt) IsSet() bool { return o.Set }
+// GetID returns the value of ID.
+func (s *GetOrganizationMembersRequest) GetID() string {
+	return s.ID
+}
 
-// Reset unsets value.
-func (o *OptInt) Reset() {
-	var v int
-	o.Value = v
-	o.Set = false
+// GetIncludeDescendants returns the value of IncludeDescendants.
+func (s *GetOrganizationMembersRequest) GetIncludeDescendants() OptBool {
+	return s.IncludeDescendants
 }
 
-// SetTo sets value to v.
-func (o *OptInt) SetTo(v int) {
-	o.Set = true
-	o.Value = v
+// GetAsOf returns the value of AsOf.
+func (s *GetOrganizationMembersRequest) GetAsOf() OptDateTime {
+	return s.AsOf
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptInt) Get() (v int, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetHistoric returns the value of Historic.
+func (s *GetOrganizationMembersRequest) GetHistoric() OptBool {
+	return s.Historic
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptInt) Or(d int) int {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetActive returns the value of Active.
+func (s *GetOrganizationMembersRequest) GetActive() []bool {
+	return s.Active
 }
 
-// NewOptPersonSettings returns new OptPersonSettings with value set to v.
-func NewOptPersonSettings(v PersonSettings) OptPersonSettings {
-	return OptPersonSettings{
-		Value: v,
-		Set:   true,
-	}
+// GetIdsOnly returns the value of IdsOnly.
+func (s *GetOrganizationMembersRequest) GetIdsOnly() OptBool {
+	return s.IdsOnly
 }
 
-// OptPersonSettings is optional PersonSettings.
-type OptPersonSettings struct {
-	Value PersonSettings
-	Set   bool
+// GetCursor returns the value of Cursor.
+func (s *GetOrganizationMembersRequest) GetCursor() OptString {
+	return s.Cursor
 }
 
-// IsSet returns true if OptPersonSettings was set.
-func (o OptPersonSettings) IsSet() bool { return o.Set }
+// GetLimit returns the value of Limit.
+func (s *GetOrganizationMembersRequest) GetLimit() OptInt {
+	return s.Limit
+}
 
-// Reset unsets value.
-func (o *OptPersonSettings) Reset() {
-	var v PersonSettings
-	o.Value = v
-	o.Set = false
+// SetID sets the value of ID.
+func (s *GetOrganizationMembersRequest) SetID(val string) {
+	s.ID = val
 }
 
-// SetTo sets value to v.
-func (o *OptPersonSettings) SetTo(v PersonSettings) {
-	o.Set = true
-	o.Value = v
+// SetIncludeDescendants sets the value of IncludeDescendants.
+func (s *GetOrganizationMembersRequest) SetIncludeDescendants(val OptBool) {
+	s.IncludeDescendants = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptPersonSettings) Get() (v PersonSettings, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetAsOf sets the value of AsOf.
+func (s *GetOrganizationMembersRequest) SetAsOf(val OptDateTime) {
+	s.AsOf = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptPersonSettings) Or(d PersonSettings) PersonSettings {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetHistoric sets the value of Historic.
+func (s *GetOrganizationMembersRequest) SetHistoric(val OptBool) {
+	s.Historic = val
 }
 
-// NewOptString returns new OptString with value set to v.
-func NewOptString(v string) OptString {
-	return OptString{
-		Value: v,
-		Set:   true,
-	}
+// SetActive sets the value of Active.
+func (s *GetOrganizationMembersRequest) SetActive(val []bool) {
+	s.Active = val
 }
 
-// OptString is optional string.
-type OptString struct {
-	Value string
-	Set   bool
+// SetIdsOnly sets the value of IdsOnly.
+func (s *GetOrganizationMembersRequest) SetIdsOnly(val OptBool) {
+	s.IdsOnly = val
 }
 
-// IsSet returns true if OptString was set.
-func (o OptString) IsSet() bool { return o.Set }
+// SetCursor sets the value of Cursor.
+func (s *GetOrganizationMembersRequest) SetCursor(val OptString) {
+	s.Cursor = val
+}
 
-// Reset unsets value.
-func (o *OptString) Reset() {
-	var v string
-	o.Value = v
-	o.Set = false
+// SetLimit sets the value of Limit.
+func (s *GetOrganizationMembersRequest) SetLimit(val OptInt) {
+	s.Limit = val
 }
 
-// SetTo sets value to v.
-func (o *OptString) SetTo(v string) {
-	o.Set = true
-	o.Value = v
+// Ref: #/components/schemas/GetOrganizationRequest
+type GetOrganizationRequest struct {
+	ID string `json:"id"`
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptString) Get() (v string, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetID returns the value of ID.
+func (s *GetOrganizationRequest) GetID() string {
+	return s.ID
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptString) Or(d string) string {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetID sets the value of ID.
+func (s *GetOrganizationRequest) SetID(val string) {
+	s.ID = val
 }
 
-// NewOptStringMap returns new OptStringMap with value set to v.
-func NewOptStringMap(v StringMap) OptStringMap {
-	return OptStringMap{
-		Value: v,
-		Set:   true,
-	}
+// Ref: #/components/schemas/GetOrganizationTreeRequest
+type GetOrganizationTreeRequest struct {
+	ID string `json:"id"`
+	// Only follow parent relations valid at this time (default now).
+	AsOf OptDateTime `json:"as_of"`
+	// Maximum number of levels, 0 follows all levels.
+	MaxDepth OptInt `json:"max_depth"`
 }
 
-// OptStringMap is optional StringMap.
-type OptStringMap struct {
-	Value StringMap
-	Set   bool
+// GetID returns the value of ID.
+func (s *GetOrganizationTreeRequest) GetID() string {
+	return s.ID
 }
 
-// IsSet returns true if OptStringMap was set.
-func (o OptStringMap) IsSet() bool { return o.Set }
+// GetAsOf returns the value of AsOf.
+func (s *GetOrganizationTreeRequest) GetAsOf() OptDateTime {
+	return s.AsOf
+}
 
-// Reset unsets value.
-func (o *OptStringMap) Reset() {
-	var v StringMap
-	o.Value = v
-	o.Set = false
+// GetMaxDepth returns the value of MaxDepth.
+func (s *GetOrganizationTreeRequest) GetMaxDepth() OptInt {
+	return s.MaxDepth
 }
 
-// SetTo sets value to v.
-func (o *OptStringMap) SetTo(v StringMap) {
-	o.Set = true
-	o.Value = v
+// SetID sets the value of ID.
+func (s *GetOrganizationTreeRequest) SetID(val string) {
+	s.ID = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptStringMap) Get() (v StringMap, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetAsOf sets the value of AsOf.
+func (s *GetOrganizationTreeRequest) SetAsOf(val OptDateTime) {
+	s.AsOf = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptStringMap) Or(d StringMap) StringMap {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetMaxDepth sets the value of MaxDepth.
+func (s *GetOrganizationTreeRequest) SetMaxDepth(val OptInt) {
+	s.MaxDepth = val
 }
 
-// Ref: #/components/schemas/Organization
-type Organization struct {
-	ID          OptString            `json:"id"`
-	DateCreated OptDateTime          `json:"date_created"`
-	DateUpdated OptDateTime          `json:"date_updated"`
-	Type        OptString            `json:"type"`
-	Acronym     OptString            `json:"acronym"`
-	NameDut     OptString            `json:"name_dut"`
-	NameEng     OptString            `json:"name_eng"`
-	Parent      []OrganizationParent `json:"parent"`
-	Identifier  []string             `json:"identifier"`
+// Ref: #/components/schemas/GetOrganizationsByIdRequest
+type GetOrganizationsByIdRequest struct {
+	ID []string `json:"id"`
 }
 
 // GetID returns the value of ID.
-func (s *Organization) GetID() OptString {
+func (s *GetOrganizationsByIdRequest) GetID() []string {
 	return s.ID
 }
 
-// GetDateCreated returns the value of DateCreated.
-func (s *Organization) GetDateCreated() OptDateTime {
-	return s.DateCreated
+// SetID sets the value of ID.
+func (s *GetOrganizationsByIdRequest) SetID(val []string) {
+	s.ID = val
 }
 
-// GetDateUpdated returns the value of DateUpdated.
-func (s *Organization) GetDateUpdated() OptDateTime {
-	return s.DateUpdated
+// Ref: #/components/schemas/GetOrganizationsByIdentifierRequest
+type GetOrganizationsByIdentifierRequest struct {
+	Identifier []string `json:"identifier"`
 }
 
-// GetType returns the value of Type.
-func (s *Organization) GetType() OptString {
-	return s.Type
+// GetIdentifier returns the value of Identifier.
+func (s *GetOrganizationsByIdentifierRequest) GetIdentifier() []string {
+	return s.Identifier
 }
 
-// GetAcronym returns the value of Acronym.
-func (s *Organization) GetAcronym() OptString {
-	return s.Acronym
+// SetIdentifier sets the value of Identifier.
+func (s *GetOrganizationsByIdentifierRequest) SetIdentifier(val []string) {
+	s.Identifier = val
 }
 
-// GetNameDut returns the value of NameDut.
-func (s *Organization) GetNameDut() OptString {
-	return s.NameDut
+// Ref: #/components/schemas/GetOrganizationsRequest
+type GetOrganizationsRequest struct {
+	Cursor OptString `json:"cursor"`
+	// Only return organization records that changed since this time, deleted records included. Send it
+	// again with every cursor.
+	UpdatedSince OptDateTime `json:"updated_since"`
 }
 
-// GetNameEng returns the value of NameEng.
-func (s *Organization) GetNameEng() OptString {
-	return s.NameEng
+// GetCursor returns the value of Cursor.
+func (s *GetOrganizationsRequest) GetCursor() OptString {
+	return s.Cursor
 }
 
-// GetParent returns the value of Parent.
-func (s *Organization) GetParent() []OrganizationParent {
-	return s.Parent
+// GetUpdatedSince returns the value of UpdatedSince.
+func (s *GetOrganizationsRequest) GetUpdatedSince() OptDateTime {
+	return s.UpdatedSince
 }
 
-// GetIdentifier returns the value of Identifier.
-func (s *Organization) GetIdentifier() []string {
-	return s.Identifier
+// SetCursor sets the value of Cursor.
+func (s *GetOrganizationsRequest) SetCursor(val OptString) {
+	s.Cursor = val
 }
 
-// SetID sets the value of ID.
-func (s *Organization) SetID(val OptString) {
-	s.ID = val
+// SetUpdatedSince sets the value of UpdatedSince.
+func (s *GetOrganizationsRequest) SetUpdatedSince(val OptDateTime) {
+	s.UpdatedSince = val
 }
 
-// SetDateCreated sets the value of DateCreated.
-func (s *Organization) SetDateCreated(val OptDateTime) {
+// Ref: #/components/schemas/GetPeopleByIdRequest
+type GetPeopleByIdRequest struct {
+	ID []string `json:"id"`
+}
+
+// GetID returns the value of ID.
+func (s *GetPeopleByIdRequest) GetID() []string {
+	return s.ID
+}
+
+// SetID sets the value of ID.
+func (s *GetPeopleByIdRequest) SetID(val []string) {
+	s.ID = val
+}
+
+// Ref: #/components/schemas/GetPeopleByIdentifierRequest
+type GetPeopleByIdentifierRequest struct {
+	Identifier []string `json:"identifier"`
+}
+
+// GetIdentifier returns the value of Identifier.
+func (s *GetPeopleByIdentifierRequest) GetIdentifier() []string {
+	return s.Identifier
+}
+
+// SetIdentifier sets the value of Identifier.
+func (s *GetPeopleByIdentifierRequest) SetIdentifier(val []string) {
+	s.Identifier = val
+}
+
+// Ref: #/components/schemas/GetPeopleRequest
+type GetPeopleRequest struct {
+	Cursor OptString `json:"cursor"`
+	// Only return person records that changed since this time, deleted records included. Send it again
+	// with every cursor.
+	UpdatedSince OptDateTime `json:"updated_since"`
+}
+
+// GetCursor returns the value of Cursor.
+func (s *GetPeopleRequest) GetCursor() OptString {
+	return s.Cursor
+}
+
+// GetUpdatedSince returns the value of UpdatedSince.
+func (s *GetPeopleRequest) GetUpdatedSince() OptDateTime {
+	return s.UpdatedSince
+}
+
+// SetCursor sets the value of Cursor.
+func (s *GetPeopleRequest) SetCursor(val OptString) {
+	s.Cursor = val
+}
+
+// SetUpdatedSince sets the value of UpdatedSince.
+func (s *GetPeopleRequest) SetUpdatedSince(val OptDateTime) {
+	s.UpdatedSince = val
+}
+
+// Ref: #/components/schemas/GetPersonHistoryRequest
+type GetPersonHistoryRequest struct {
+	ID string `json:"id"`
+}
+
+// GetID returns the value of ID.
+func (s *GetPersonHistoryRequest) GetID() string {
+	return s.ID
+}
+
+// SetID sets the value of ID.
+func (s *GetPersonHistoryRequest) SetID(val string) {
+	s.ID = val
+}
+
+// Ref: #/components/schemas/GetPersonRequest
+type GetPersonRequest struct {
+	ID string `json:"id"`
+}
+
+// GetID returns the value of ID.
+func (s *GetPersonRequest) GetID() string {
+	return s.ID
+}
+
+// SetID sets the value of ID.
+func (s *GetPersonRequest) SetID(val string) {
+	s.ID = val
+}
+
+// Ref: #/components/schemas/GetWebhookDeliveriesRequest
+type GetWebhookDeliveriesRequest struct {
+	SubscriptionID string `json:"subscription_id"`
+	Limit          OptInt `json:"limit"`
+}
+
+// GetSubscriptionID returns the value of SubscriptionID.
+func (s *GetWebhookDeliveriesRequest) GetSubscriptionID() string {
+	return s.SubscriptionID
+}
+
+// GetLimit returns the value of Limit.
+func (s *GetWebhookDeliveriesRequest) GetLimit() OptInt {
+	return s.Limit
+}
+
+// SetSubscriptionID sets the value of SubscriptionID.
+func (s *GetWebhookDeliveriesRequest) SetSubscriptionID(val string) {
+	s.SubscriptionID = val
+}
+
+// SetLimit sets the value of Limit.
+func (s *GetWebhookDeliveriesRequest) SetLimit(val OptInt) {
+	s.Limit = val
+}
+
+// Ref: #/components/schemas/GetWebhookSubscriptionsRequest
+type GetWebhookSubscriptionsRequest struct{}
+
+// Ref: #/components/schemas/Match
+type Match struct {
+	// Score the hits are ordered by.
+	Rank   float64      `json:"rank"`
+	Fields []FieldMatch `json:"fields"`
+}
+
+// GetRank returns the value of Rank.
+func (s *Match) GetRank() float64 {
+	return s.Rank
+}
+
+// GetFields returns the value of Fields.
+func (s *Match) GetFields() []FieldMatch {
+	return s.Fields
+}
+
+// SetRank sets the value of Rank.
+func (s *Match) SetRank(val float64) {
+	s.Rank = val
+}
+
+// SetFields sets the value of Fields.
+func (s *Match) SetFields(val []FieldMatch) {
+	s.Fields = val
+}
+
+// Ref: #/components/schemas/MergePeopleRequest
+type MergePeopleRequest struct {
+	// Id of the surviving person record.
+	ID string `json:"id"`
+	// Ids of the person records to merge and delete.
+	MergeID    []string                        `json:"merge_id"`
+	OnConflict OptMergePeopleRequestOnConflict `json:"on_conflict"`
+}
+
+// GetID returns the value of ID.
+func (s *MergePeopleRequest) GetID() string {
+	return s.ID
+}
+
+// GetMergeID returns the value of MergeID.
+func (s *MergePeopleRequest) GetMergeID() []string {
+	return s.MergeID
+}
+
+// GetOnConflict returns the value of OnConflict.
+func (s *MergePeopleRequest) GetOnConflict() OptMergePeopleRequestOnConflict {
+	return s.OnConflict
+}
+
+// SetID sets the value of ID.
+func (s *MergePeopleRequest) SetID(val string) {
+	s.ID = val
+}
+
+// SetMergeID sets the value of MergeID.
+func (s *MergePeopleRequest) SetMergeID(val []string) {
+	s.MergeID = val
+}
+
+// SetOnConflict sets the value of OnConflict.
+func (s *MergePeopleRequest) SetOnConflict(val OptMergePeopleRequestOnConflict) {
+	s.OnConflict = val
+}
+
+type MergePeopleRequestOnConflict string
+
+const (
+	MergePeopleRequestOnConflictSurvivor MergePeopleRequestOnConflict = "survivor"
+	MergePeopleRequestOnConflictNewest   MergePeopleRequestOnConflict = "newest"
+	MergePeopleRequestOnConflictFail     MergePeopleRequestOnConflict = "fail"
+)
+
+// AllValues returns all MergePeopleRequestOnConflict values.
+func (MergePeopleRequestOnConflict) AllValues() []MergePeopleRequestOnConflict {
+	return []MergePeopleRequestOnConflict{
+		MergePeopleRequestOnConflictSurvivor,
+		MergePeopleRequestOnConflictNewest,
+		MergePeopleRequestOnConflictFail,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s MergePeopleRequestOnConflict) MarshalText() ([]byte, error) {
+	switch s {
+	case MergePeopleRequestOnConflictSurvivor:
+		return []byte(s), nil
+	case MergePeopleRequestOnConflictNewest:
+		return []byte(s), nil
+	case MergePeopleRequestOnConflictFail:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *MergePeopleRequestOnConflict) UnmarshalText(data []byte) error {
+	switch MergePeopleRequestOnConflict(data) {
+	case MergePeopleRequestOnConflictSurvivor:
+		*s = MergePeopleRequestOnConflictSurvivor
+		return nil
+	case MergePeopleRequestOnConflictNewest:
+		*s = MergePeopleRequestOnConflictNewest
+		return nil
+	case MergePeopleRequestOnConflictFail:
+		*s = MergePeopleRequestOnConflictFail
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// NewNilString returns new NilString with value set to v.
+func NewNilString(v string) NilString {
+	return NilString{
+		Value: v,
+	}
+}
+
+// NilString is nullable string.
+type NilString struct {
+	Value string
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilString) SetTo(v string) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilString) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilString) SetToNull() {
+	o.Null = true
+	var v string
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilString) Get() (v string, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptAddOrganizationsMatchOn returns new OptAddOrganizationsMatchOn with value set to v.
+func NewOptAddOrganizationsMatchOn(v AddOrganizationsMatchOn) OptAddOrganizationsMatchOn {
+	return OptAddOrganizationsMatchOn{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAddOrganizationsMatchOn is optional AddOrganizationsMatchOn.
+type OptAddOrganizationsMatchOn struct {
+	Value AddOrganizationsMatchOn
+	Set   bool
+}
+
+// IsSet returns true if OptAddOrganizationsMatchOn was set.
+func (o OptAddOrganizationsMatchOn) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAddOrganizationsMatchOn) Reset() {
+	var v AddOrganizationsMatchOn
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptAddOrganizationsMatchOn) SetTo(v AddOrganizationsMatchOn) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAddOrganizationsMatchOn) Get() (v AddOrganizationsMatchOn, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptAddOrganizationsMatchOn) Or(d AddOrganizationsMatchOn) AddOrganizationsMatchOn {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptAddPeopleMatchOn returns new OptAddPeopleMatchOn with value set to v.
+func NewOptAddPeopleMatchOn(v AddPeopleMatchOn) OptAddPeopleMatchOn {
+	return OptAddPeopleMatchOn{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAddPeopleMatchOn is optional AddPeopleMatchOn.
+type OptAddPeopleMatchOn struct {
+	Value AddPeopleMatchOn
+	Set   bool
+}
+
+// IsSet returns true if OptAddPeopleMatchOn was set.
+func (o OptAddPeopleMatchOn) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAddPeopleMatchOn) Reset() {
+	var v AddPeopleMatchOn
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptAddPeopleMatchOn) SetTo(v AddPeopleMatchOn) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAddPeopleMatchOn) Get() (v AddPeopleMatchOn, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptAddPeopleMatchOn) Or(d AddPeopleMatchOn) AddPeopleMatchOn {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFloat64 returns new OptFloat64 with value set to v.
+func NewOptFloat64(v float64) OptFloat64 {
+	return OptFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFloat64 is optional float64.
+type OptFloat64 struct {
+	Value float64
+	Set   bool
+}
+
+// IsSet returns true if OptFloat64 was set.
+func (o OptFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFloat64) Get() (v float64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptGetOrganizationLineageRequestDirection returns new OptGetOrganizationLineageRequestDirection with value set to v.
+func NewOptGetOrganizationLineageRequestDirection(v GetOrganizationLineageRequestDirection) OptGetOrganizationLineageRequestDirection {
+	return OptGetOrganizationLineageRequestDirection{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptGetOrganizationLineageRequestDirection is optional GetOrganizationLineageRequestDirection.
+type OptGetOrganizationLineageRequestDirection struct {
+	Value GetOrganizationLineageRequestDirection
+	Set   bool
+}
+
+// IsSet returns true if OptGetOrganizationLineageRequestDirection was set.
+func (o OptGetOrganizationLineageRequestDirection) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGetOrganizationLineageRequestDirection) Reset() {
+	var v GetOrganizationLineageRequestDirection
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptGetOrganizationLineageRequestDirection) SetTo(v GetOrganizationLineageRequestDirection) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGetOrganizationLineageRequestDirection) Get() (v GetOrganizationLineageRequestDirection, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptGetOrganizationLineageRequestDirection) Or(d GetOrganizationLineageRequestDirection) GetOrganizationLineageRequestDirection {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptMergePeopleRequestOnConflict returns new OptMergePeopleRequestOnConflict with value set to v.
+func NewOptMergePeopleRequestOnConflict(v MergePeopleRequestOnConflict) OptMergePeopleRequestOnConflict {
+	return OptMergePeopleRequestOnConflict{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptMergePeopleRequestOnConflict is optional MergePeopleRequestOnConflict.
+type OptMergePeopleRequestOnConflict struct {
+	Value MergePeopleRequestOnConflict
+	Set   bool
+}
+
+// IsSet returns true if OptMergePeopleRequestOnConflict was set.
+func (o OptMergePeopleRequestOnConflict) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptMergePeopleRequestOnConflict) Reset() {
+	var v MergePeopleRequestOnConflict
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptMergePeopleRequestOnConflict) SetTo(v MergePeopleRequestOnConflict) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptMergePeopleRequestOnConflict) Get() (v MergePeopleRequestOnConflict, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptMergePeopleRequestOnConflict) Or(d MergePeopleRequestOnConflict) MergePeopleRequestOnConflict {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilBool returns new OptNilBool with value set to v.
+func NewOptNilBool(v bool) OptNilBool {
+	return OptNilBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilBool is optional nullable bool.
+type OptNilBool struct {
+	Value bool
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilBool was set.
+func (o OptNilBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilBool) SetTo(v bool) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilBool) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilBool) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v bool
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilBool) Get() (v bool, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilDateTime returns new OptNilDateTime with value set to v.
+func NewOptNilDateTime(v time.Time) OptNilDateTime {
+	return OptNilDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilDateTime is optional nullable time.Time.
+type OptNilDateTime struct {
+	Value time.Time
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilDateTime was set.
+func (o OptNilDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilDateTime) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilDateTime) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v time.Time
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilDateTime) Get() (v time.Time, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilOrganizationMemberArray returns new OptNilOrganizationMemberArray with value set to v.
+func NewOptNilOrganizationMemberArray(v []OrganizationMember) OptNilOrganizationMemberArray {
+	return OptNilOrganizationMemberArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilOrganizationMemberArray is optional nullable []OrganizationMember.
+type OptNilOrganizationMemberArray struct {
+	Value []OrganizationMember
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilOrganizationMemberArray was set.
+func (o OptNilOrganizationMemberArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilOrganizationMemberArray) Reset() {
+	var v []OrganizationMember
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilOrganizationMemberArray) SetTo(v []OrganizationMember) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilOrganizationMemberArray) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilOrganizationMemberArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []OrganizationMember
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilOrganizationMemberArray) Get() (v []OrganizationMember, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilOrganizationMemberArray) Or(d []OrganizationMember) []OrganizationMember {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilOrganizationParentArray returns new OptNilOrganizationParentArray with value set to v.
+func NewOptNilOrganizationParentArray(v []OrganizationParent) OptNilOrganizationParentArray {
+	return OptNilOrganizationParentArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilOrganizationParentArray is optional nullable []OrganizationParent.
+type OptNilOrganizationParentArray struct {
+	Value []OrganizationParent
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilOrganizationParentArray was set.
+func (o OptNilOrganizationParentArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilOrganizationParentArray) Reset() {
+	var v []OrganizationParent
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilOrganizationParentArray) SetTo(v []OrganizationParent) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilOrganizationParentArray) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilOrganizationParentArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []OrganizationParent
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilOrganizationParentArray) Get() (v []OrganizationParent, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilOrganizationParentArray) Or(d []OrganizationParent) []OrganizationParent {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilOrganizationSuccessorArray returns new OptNilOrganizationSuccessorArray with value set to v.
+func NewOptNilOrganizationSuccessorArray(v []OrganizationSuccessor) OptNilOrganizationSuccessorArray {
+	return OptNilOrganizationSuccessorArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilOrganizationSuccessorArray is optional nullable []OrganizationSuccessor.
+type OptNilOrganizationSuccessorArray struct {
+	Value []OrganizationSuccessor
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilOrganizationSuccessorArray was set.
+func (o OptNilOrganizationSuccessorArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilOrganizationSuccessorArray) Reset() {
+	var v []OrganizationSuccessor
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilOrganizationSuccessorArray) SetTo(v []OrganizationSuccessor) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilOrganizationSuccessorArray) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilOrganizationSuccessorArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []OrganizationSuccessor
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilOrganizationSuccessorArray) Get() (v []OrganizationSuccessor, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilOrganizationSuccessorArray) Or(d []OrganizationSuccessor) []OrganizationSuccessor {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilPersonPatchSettings returns new OptNilPersonPatchSettings with value set to v.
+func NewOptNilPersonPatchSettings(v PersonPatchSettings) OptNilPersonPatchSettings {
+	return OptNilPersonPatchSettings{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilPersonPatchSettings is optional nullable PersonPatchSettings.
+type OptNilPersonPatchSettings struct {
+	Value PersonPatchSettings
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilPersonPatchSettings was set.
+func (o OptNilPersonPatchSettings) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilPersonPatchSettings) Reset() {
+	var v PersonPatchSettings
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilPersonPatchSettings) SetTo(v PersonPatchSettings) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilPersonPatchSettings) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilPersonPatchSettings) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v PersonPatchSettings
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilPersonPatchSettings) Get() (v PersonPatchSettings, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilPersonPatchSettings) Or(d PersonPatchSettings) PersonPatchSettings {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilPersonPatchToken returns new OptNilPersonPatchToken with value set to v.
+func NewOptNilPersonPatchToken(v PersonPatchToken) OptNilPersonPatchToken {
+	return OptNilPersonPatchToken{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilPersonPatchToken is optional nullable PersonPatchToken.
+type OptNilPersonPatchToken struct {
+	Value PersonPatchToken
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilPersonPatchToken was set.
+func (o OptNilPersonPatchToken) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilPersonPatchToken) Reset() {
+	var v PersonPatchToken
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilPersonPatchToken) SetTo(v PersonPatchToken) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilPersonPatchToken) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilPersonPatchToken) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v PersonPatchToken
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilPersonPatchToken) Get() (v PersonPatchToken, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilPersonPatchToken) Or(d PersonPatchToken) PersonPatchToken {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilString returns new OptNilString with value set to v.
+func NewOptNilString(v string) OptNilString {
+	return OptNilString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilString is optional nullable string.
+type OptNilString struct {
+	Value string
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilString was set.
+func (o OptNilString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilString) SetTo(v string) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilString) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilString) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v string
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilString) Get() (v string, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilStringArray returns new OptNilStringArray with value set to v.
+func NewOptNilStringArray(v []string) OptNilStringArray {
+	return OptNilStringArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilStringArray is optional nullable []string.
+type OptNilStringArray struct {
+	Value []string
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilStringArray was set.
+func (o OptNilStringArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilStringArray) Reset() {
+	var v []string
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilStringArray) SetTo(v []string) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilStringArray) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilStringArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []string
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilStringArray) Get() (v []string, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilStringArray) Or(d []string) []string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptOrganization returns new OptOrganization with value set to v.
+func NewOptOrganization(v Organization) OptOrganization {
+	return OptOrganization{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptOrganization is optional Organization.
+type OptOrganization struct {
+	Value Organization
+	Set   bool
+}
+
+// IsSet returns true if OptOrganization was set.
+func (o OptOrganization) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptOrganization) Reset() {
+	var v Organization
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptOrganization) SetTo(v Organization) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptOrganization) Get() (v Organization, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptOrganization) Or(d Organization) Organization {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptOrganizationStatus returns new OptOrganizationStatus with value set to v.
+func NewOptOrganizationStatus(v OrganizationStatus) OptOrganizationStatus {
+	return OptOrganizationStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptOrganizationStatus is optional OrganizationStatus.
+type OptOrganizationStatus struct {
+	Value OrganizationStatus
+	Set   bool
+}
+
+// IsSet returns true if OptOrganizationStatus was set.
+func (o OptOrganizationStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptOrganizationStatus) Reset() {
+	var v OrganizationStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptOrganizationStatus) SetTo(v OrganizationStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptOrganizationStatus) Get() (v OrganizationStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptOrganizationStatus) Or(d OrganizationStatus) OrganizationStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPerson returns new OptPerson with value set to v.
+func NewOptPerson(v Person) OptPerson {
+	return OptPerson{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPerson is optional Person.
+type OptPerson struct {
+	Value Person
+	Set   bool
+}
+
+// IsSet returns true if OptPerson was set.
+func (o OptPerson) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPerson) Reset() {
+	var v Person
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPerson) SetTo(v Person) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPerson) Get() (v Person, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPerson) Or(d Person) Person {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPersonSettings returns new OptPersonSettings with value set to v.
+func NewOptPersonSettings(v PersonSettings) OptPersonSettings {
+	return OptPersonSettings{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPersonSettings is optional PersonSettings.
+type OptPersonSettings struct {
+	Value PersonSettings
+	Set   bool
+}
+
+// IsSet returns true if OptPersonSettings was set.
+func (o OptPersonSettings) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPersonSettings) Reset() {
+	var v PersonSettings
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPersonSettings) SetTo(v PersonSettings) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPersonSettings) Get() (v PersonSettings, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPersonSettings) Or(d PersonSettings) PersonSettings {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptSearchOrganizationsRequestSort returns new OptSearchOrganizationsRequestSort with value set to v.
+func NewOptSearchOrganizationsRequestSort(v SearchOrganizationsRequestSort) OptSearchOrganizationsRequestSort {
+	return OptSearchOrganizationsRequestSort{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSearchOrganizationsRequestSort is optional SearchOrganizationsRequestSort.
+type OptSearchOrganizationsRequestSort struct {
+	Value SearchOrganizationsRequestSort
+	Set   bool
+}
+
+// IsSet returns true if OptSearchOrganizationsRequestSort was set.
+func (o OptSearchOrganizationsRequestSort) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSearchOrganizationsRequestSort) Reset() {
+	var v SearchOrganizationsRequestSort
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSearchOrganizationsRequestSort) SetTo(v SearchOrganizationsRequestSort) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSearchOrganizationsRequestSort) Get() (v SearchOrganizationsRequestSort, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSearchOrganizationsRequestSort) Or(d SearchOrganizationsRequestSort) SearchOrganizationsRequestSort {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptSearchPeopleRequestSort returns new OptSearchPeopleRequestSort with value set to v.
+func NewOptSearchPeopleRequestSort(v SearchPeopleRequestSort) OptSearchPeopleRequestSort {
+	return OptSearchPeopleRequestSort{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSearchPeopleRequestSort is optional SearchPeopleRequestSort.
+type OptSearchPeopleRequestSort struct {
+	Value SearchPeopleRequestSort
+	Set   bool
+}
+
+// IsSet returns true if OptSearchPeopleRequestSort was set.
+func (o OptSearchPeopleRequestSort) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSearchPeopleRequestSort) Reset() {
+	var v SearchPeopleRequestSort
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSearchPeopleRequestSort) SetTo(v SearchPeopleRequestSort) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSearchPeopleRequestSort) Get() (v SearchPeopleRequestSort, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSearchPeopleRequestSort) Or(d SearchPeopleRequestSort) SearchPeopleRequestSort {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptStringMap returns new OptStringMap with value set to v.
+func NewOptStringMap(v StringMap) OptStringMap {
+	return OptStringMap{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptStringMap is optional StringMap.
+type OptStringMap struct {
+	Value StringMap
+	Set   bool
+}
+
+// IsSet returns true if OptStringMap was set.
+func (o OptStringMap) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptStringMap) Reset() {
+	var v StringMap
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptStringMap) SetTo(v StringMap) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptStringMap) Get() (v StringMap, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptStringMap) Or(d StringMap) StringMap {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptTombstone returns new OptTombstone with value set to v.
+func NewOptTombstone(v Tombstone) OptTombstone {
+	return OptTombstone{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptTombstone is optional Tombstone.
+type OptTombstone struct {
+	Value Tombstone
+	Set   bool
+}
+
+// IsSet returns true if OptTombstone was set.
+func (o OptTombstone) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptTombstone) Reset() {
+	var v Tombstone
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptTombstone) SetTo(v Tombstone) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptTombstone) Get() (v Tombstone, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptTombstone) Or(d Tombstone) Tombstone {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Ref: #/components/schemas/Organization
+type Organization struct {
+	ID          OptString            `json:"id"`
+	DateCreated OptDateTime          `json:"date_created"`
+	DateUpdated OptDateTime          `json:"date_updated"`
+	Type        OptString            `json:"type"`
+	Acronym     OptString            `json:"acronym"`
+	NameDut     OptString            `json:"name_dut"`
+	NameEng     OptString            `json:"name_eng"`
+	Parent      []OrganizationParent `json:"parent"`
+	// Organizations that replace this organization after a merge or split.
+	Successor []OrganizationSuccessor `json:"successor"`
+	// Organizations that list this organization as successor; ignored on create and update.
+	Predecessor []OrganizationSuccessor `json:"predecessor"`
+	Identifier  []string                `json:"identifier"`
+	Founded     OptDateTime             `json:"founded"`
+	// Dissolved organizations are left out of suggest and search by default.
+	Dissolved OptDateTime `json:"dissolved"`
+	// Derived from founded and dissolved: planned before founded, dissolved from dissolved on, active
+	// otherwise.
+	Status OptOrganizationStatus `json:"status"`
+	// Version of the stored record. When given on update, the update fails with status 409 if the stored
+	// record has another version.
+	Version OptInt `json:"version"`
+}
+
+// GetID returns the value of ID.
+func (s *Organization) GetID() OptString {
+	return s.ID
+}
+
+// GetDateCreated returns the value of DateCreated.
+func (s *Organization) GetDateCreated() OptDateTime {
+	return s.DateCreated
+}
+
+// GetDateUpdated returns the value of DateUpdated.
+func (s *Organization) GetDateUpdated() OptDateTime {
+	return s.DateUpdated
+}
+
+// GetType returns the value of Type.
+func (s *Organization) GetType() OptString {
+	return s.Type
+}
+
+// GetAcronym returns the value of Acronym.
+func (s *Organization) GetAcronym() OptString {
+	return s.Acronym
+}
+
+// GetNameDut returns the value of NameDut.
+func (s *Organization) GetNameDut() OptString {
+	return s.NameDut
+}
+
+// GetNameEng returns the value of NameEng.
+func (s *Organization) GetNameEng() OptString {
+	return s.NameEng
+}
+
+// GetParent returns the value of Parent.
+func (s *Organization) GetParent() []OrganizationParent {
+	return s.Parent
+}
+
+// GetSuccessor returns the value of Successor.
+func (s *Organization) GetSuccessor() []OrganizationSuccessor {
+	return s.Successor
+}
+
+// GetPredecessor returns the value of Predecessor.
+func (s *Organization) GetPredecessor() []OrganizationSuccessor {
+	return s.Predecessor
+}
+
+// GetIdentifier returns the value of Identifier.
+func (s *Organization) GetIdentifier() []string {
+	return s.Identifier
+}
+
+// GetFounded returns the value of Founded.
+func (s *Organization) GetFounded() OptDateTime {
+	return s.Founded
+}
+
+// GetDissolved returns the value of Dissolved.
+func (s *Organization) GetDissolved() OptDateTime {
+	return s.Dissolved
+}
+
+// GetStatus returns the value of Status.
+func (s *Organization) GetStatus() OptOrganizationStatus {
+	return s.Status
+}
+
+// GetVersion returns the value of Version.
+func (s *Organization) GetVersion() OptInt {
+	return s.Version
+}
+
+// SetID sets the value of ID.
+func (s *Organization) SetID(val OptString) {
+	s.ID = val
+}
+
+// SetDateCreated sets the value of DateCreated.
+func (s *Organization) SetDateCreated(val OptDateTime) {
+	s.DateCreated = val
+}
+
+// SetDateUpdated sets the value of DateUpdated.
+func (s *Organization) SetDateUpdated(val OptDateTime) {
+	s.DateUpdated = val
+}
+
+// SetType sets the value of Type.
+func (s *Organization) SetType(val OptString) {
+	s.Type = val
+}
+
+// SetAcronym sets the value of Acronym.
+func (s *Organization) SetAcronym(val OptString) {
+	s.Acronym = val
+}
+
+// SetNameDut sets the value of NameDut.
+func (s *Organization) SetNameDut(val OptString) {
+	s.NameDut = val
+}
+
+// SetNameEng sets the value of NameEng.
+func (s *Organization) SetNameEng(val OptString) {
+	s.NameEng = val
+}
+
+// SetParent sets the value of Parent.
+func (s *Organization) SetParent(val []OrganizationParent) {
+	s.Parent = val
+}
+
+// SetSuccessor sets the value of Successor.
+func (s *Organization) SetSuccessor(val []OrganizationSuccessor) {
+	s.Successor = val
+}
+
+// SetPredecessor sets the value of Predecessor.
+func (s *Organization) SetPredecessor(val []OrganizationSuccessor) {
+	s.Predecessor = val
+}
+
+// SetIdentifier sets the value of Identifier.
+func (s *Organization) SetIdentifier(val []string) {
+	s.Identifier = val
+}
+
+// SetFounded sets the value of Founded.
+func (s *Organization) SetFounded(val OptDateTime) {
+	s.Founded = val
+}
+
+// SetDissolved sets the value of Dissolved.
+func (s *Organization) SetDissolved(val OptDateTime) {
+	s.Dissolved = val
+}
+
+// SetStatus sets the value of Status.
+func (s *Organization) SetStatus(val OptOrganizationStatus) {
+	s.Status = val
+}
+
+// SetVersion sets the value of Version.
+func (s *Organization) SetVersion(val OptInt) {
+	s.Version = val
+}
+
+// Ref: #/components/schemas/OrganizationFacets
+type OrganizationFacets struct {
+	Type []FacetValue `json:"type"`
+}
+
+// GetType returns the value of Type.
+func (s *OrganizationFacets) GetType() []FacetValue {
+	return s.Type
+}
+
+// SetType sets the value of Type.
+func (s *OrganizationFacets) SetType(val []FacetValue) {
+	s.Type = val
+}
+
+// Ref: #/components/schemas/OrganizationHistoryResponse
+type OrganizationHistoryResponse struct {
+	Data []OrganizationRevision `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *OrganizationHistoryResponse) GetData() []OrganizationRevision {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *OrganizationHistoryResponse) SetData(val []OrganizationRevision) {
+	s.Data = val
+}
+
+// Ref: #/components/schemas/OrganizationLineageNode
+type OrganizationLineageNode struct {
+	Organization Organization `json:"organization"`
+	// Number of relations between the start and this organization.
+	Depth int `json:"depth"`
+	// Organization ids from the start up to and including this organization.
+	Path []string `json:"path"`
+	// Date of the followed relation; not set for the start.
+	Date OptDateTime `json:"date"`
+	// The organization has no successor at as_of.
+	Current bool `json:"current"`
+}
+
+// GetOrganization returns the value of Organization.
+func (s *OrganizationLineageNode) GetOrganization() Organization {
+	return s.Organization
+}
+
+// GetDepth returns the value of Depth.
+func (s *OrganizationLineageNode) GetDepth() int {
+	return s.Depth
+}
+
+// GetPath returns the value of Path.
+func (s *OrganizationLineageNode) GetPath() []string {
+	return s.Path
+}
+
+// GetDate returns the value of Date.
+func (s *OrganizationLineageNode) GetDate() OptDateTime {
+	return s.Date
+}
+
+// GetCurrent returns the value of Current.
+func (s *OrganizationLineageNode) GetCurrent() bool {
+	return s.Current
+}
+
+// SetOrganization sets the value of Organization.
+func (s *OrganizationLineageNode) SetOrganization(val Organization) {
+	s.Organization = val
+}
+
+// SetDepth sets the value of Depth.
+func (s *OrganizationLineageNode) SetDepth(val int) {
+	s.Depth = val
+}
+
+// SetPath sets the value of Path.
+func (s *OrganizationLineageNode) SetPath(val []string) {
+	s.Path = val
+}
+
+// SetDate sets the value of Date.
+func (s *OrganizationLineageNode) SetDate(val OptDateTime) {
+	s.Date = val
+}
+
+// SetCurrent sets the value of Current.
+func (s *OrganizationLineageNode) SetCurrent(val bool) {
+	s.Current = val
+}
+
+// Ref: #/components/schemas/OrganizationLineageResponse
+type OrganizationLineageResponse struct {
+	Data []OrganizationLineageNode `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *OrganizationLineageResponse) GetData() []OrganizationLineageNode {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *OrganizationLineageResponse) SetData(val []OrganizationLineageNode) {
+	s.Data = val
+}
+
+// Ref: #/components/schemas/OrganizationListResponse
+type OrganizationListResponse struct {
+	Data []Organization `json:"data"`
+	// Tombstones of requested records that no longer exist (get by id only).
+	Gone []Tombstone `json:"gone"`
+	// Why every record in data matched, in the same order (suggest with highlight only).
+	Matches []Match `json:"matches"`
+}
+
+// GetData returns the value of Data.
+func (s *OrganizationListResponse) GetData() []Organization {
+	return s.Data
+}
+
+// GetGone returns the value of Gone.
+func (s *OrganizationListResponse) GetGone() []Tombstone {
+	return s.Gone
+}
+
+// GetMatches returns the value of Matches.
+func (s *OrganizationListResponse) GetMatches() []Match {
+	return s.Matches
+}
+
+// SetData sets the value of Data.
+func (s *OrganizationListResponse) SetData(val []Organization) {
+	s.Data = val
+}
+
+// SetGone sets the value of Gone.
+func (s *OrganizationListResponse) SetGone(val []Tombstone) {
+	s.Gone = val
+}
+
+// SetMatches sets the value of Matches.
+func (s *OrganizationListResponse) SetMatches(val []Match) {
+	s.Matches = val
+}
+
+// Membership of an organization during [from, until); without from or until the period is open ended.
+// Ref: #/components/schemas/OrganizationMember
+type OrganizationMember struct {
+	ID          string      `json:"id"`
+	DateCreated OptDateTime `json:"date_created"`
+	DateUpdated OptDateTime `json:"date_updated"`
+	From        OptDateTime `json:"from"`
+	Until       OptDateTime `json:"until"`
+	// Function of the member, e.g. postdoc.
+	Type OptString `json:"type"`
+	// Percentage of a full time position.
+	Fte OptFloat64 `json:"fte"`
+	// System the membership comes from, e.g. ldap.
+	Source OptString `json:"source"`
+}
+
+// GetID returns the value of ID.
+func (s *OrganizationMember) GetID() string {
+	return s.ID
+}
+
+// GetDateCreated returns the value of DateCreated.
+func (s *OrganizationMember) GetDateCreated() OptDateTime {
+	return s.DateCreated
+}
+
+// GetDateUpdated returns the value of DateUpdated.
+func (s *OrganizationMember) GetDateUpdated() OptDateTime {
+	return s.DateUpdated
+}
+
+// GetFrom returns the value of From.
+func (s *OrganizationMember) GetFrom() OptDateTime {
+	return s.From
+}
+
+// GetUntil returns the value of Until.
+func (s *OrganizationMember) GetUntil() OptDateTime {
+	return s.Until
+}
+
+// GetType returns the value of Type.
+func (s *OrganizationMember) GetType() OptString {
+	return s.Type
+}
+
+// GetFte returns the value of Fte.
+func (s *OrganizationMember) GetFte() OptFloat64 {
+	return s.Fte
+}
+
+// GetSource returns the value of Source.
+func (s *OrganizationMember) GetSource() OptString {
+	return s.Source
+}
+
+// SetID sets the value of ID.
+func (s *OrganizationMember) SetID(val string) {
+	s.ID = val
+}
+
+// SetDateCreated sets the value of DateCreated.
+func (s *OrganizationMember) SetDateCreated(val OptDateTime) {
+	s.DateCreated = val
+}
+
+// SetDateUpdated sets the value of DateUpdated.
+func (s *OrganizationMember) SetDateUpdated(val OptDateTime) {
+	s.DateUpdated = val
+}
+
+// SetFrom sets the value of From.
+func (s *OrganizationMember) SetFrom(val OptDateTime) {
+	s.From = val
+}
+
+// SetUntil sets the value of Until.
+func (s *OrganizationMember) SetUntil(val OptDateTime) {
+	s.Until = val
+}
+
+// SetType sets the value of Type.
+func (s *OrganizationMember) SetType(val OptString) {
+	s.Type = val
+}
+
+// SetFte sets the value of Fte.
+func (s *OrganizationMember) SetFte(val OptFloat64) {
+	s.Fte = val
+}
+
+// SetSource sets the value of Source.
+func (s *OrganizationMember) SetSource(val OptString) {
+	s.Source = val
+}
+
+// Ref: #/components/schemas/OrganizationMembersResponse
+type OrganizationMembersResponse struct {
+	Cursor OptString `json:"cursor"`
+	Data   []Person  `json:"data"`
+	// Person ids, if ids_only was set.
+	Ids []string `json:"ids"`
+}
+
+// GetCursor returns the value of Cursor.
+func (s *OrganizationMembersResponse) GetCursor() OptString {
+	return s.Cursor
+}
+
+// GetData returns the value of Data.
+func (s *OrganizationMembersResponse) GetData() []Person {
+	return s.Data
+}
+
+// GetIds returns the value of Ids.
+func (s *OrganizationMembersResponse) GetIds() []string {
+	return s.Ids
+}
+
+// SetCursor sets the value of Cursor.
+func (s *OrganizationMembersResponse) SetCursor(val OptString) {
+	s.Cursor = val
+}
+
+// SetData sets the value of Data.
+func (s *OrganizationMembersResponse) SetData(val []Person) {
+	s.Data = val
+}
+
+// SetIds sets the value of Ids.
+func (s *OrganizationMembersResponse) SetIds(val []string) {
+	s.Ids = val
+}
+
+// Ref: #/components/schemas/OrganizationPagedListResponse
+type OrganizationPagedListResponse struct {
+	Cursor OptString      `json:"cursor"`
+	Data   []Organization `json:"data"`
+	// Tombstones of organization records deleted since updated_since.
+	Gone []Tombstone `json:"gone"`
+}
+
+// GetCursor returns the value of Cursor.
+func (s *OrganizationPagedListResponse) GetCursor() OptString {
+	return s.Cursor
+}
+
+// GetData returns the value of Data.
+func (s *OrganizationPagedListResponse) GetData() []Organization {
+	return s.Data
+}
+
+// GetGone returns the value of Gone.
+func (s *OrganizationPagedListResponse) GetGone() []Tombstone {
+	return s.Gone
+}
+
+// SetCursor sets the value of Cursor.
+func (s *OrganizationPagedListResponse) SetCursor(val OptString) {
+	s.Cursor = val
+}
+
+// SetData sets the value of Data.
+func (s *OrganizationPagedListResponse) SetData(val []Organization) {
+	s.Data = val
+}
+
+// SetGone sets the value of Gone.
+func (s *OrganizationPagedListResponse) SetGone(val []Tombstone) {
+	s.Gone = val
+}
+
+// Ref: #/components/schemas/OrganizationParent
+type OrganizationParent struct {
+	ID          string      `json:"id"`
+	DateCreated OptDateTime `json:"date_created"`
+	DateUpdated OptDateTime `json:"date_updated"`
+	From        time.Time   `json:"from"`
+	Until       OptDateTime `json:"until"`
+}
+
+// GetID returns the value of ID.
+func (s *OrganizationParent) GetID() string {
+	return s.ID
+}
+
+// GetDateCreated returns the value of DateCreated.
+func (s *OrganizationParent) GetDateCreated() OptDateTime {
+	return s.DateCreated
+}
+
+// GetDateUpdated returns the value of DateUpdated.
+func (s *OrganizationParent) GetDateUpdated() OptDateTime {
+	return s.DateUpdated
+}
+
+// GetFrom returns the value of From.
+func (s *OrganizationParent) GetFrom() time.Time {
+	return s.From
+}
+
+// GetUntil returns the value of Until.
+func (s *OrganizationParent) GetUntil() OptDateTime {
+	return s.Until
+}
+
+// SetID sets the value of ID.
+func (s *OrganizationParent) SetID(val string) {
+	s.ID = val
+}
+
+// SetDateCreated sets the value of DateCreated.
+func (s *OrganizationParent) SetDateCreated(val OptDateTime) {
+	s.DateCreated = val
+}
+
+// SetDateUpdated sets the value of DateUpdated.
+func (s *OrganizationParent) SetDateUpdated(val OptDateTime) {
+	s.DateUpdated = val
+}
+
+// SetFrom sets the value of From.
+func (s *OrganizationParent) SetFrom(val time.Time) {
+	s.From = val
+}
+
+// SetUntil sets the value of Until.
+func (s *OrganizationParent) SetUntil(val OptDateTime) {
+	s.Until = val
+}
+
+// Ref: #/components/schemas/OrganizationPatch
+type OrganizationPatch struct {
+	// Null restores the default type organization.
+	Type       OptNilString                     `json:"type"`
+	Acronym    OptNilString                     `json:"acronym"`
+	NameDut    OptNilString                     `json:"name_dut"`
+	NameEng    OptNilString                     `json:"name_eng"`
+	Parent     OptNilOrganizationParentArray    `json:"parent"`
+	Successor  OptNilOrganizationSuccessorArray `json:"successor"`
+	Identifier OptNilStringArray                `json:"identifier"`
+	Founded    OptNilDateTime                   `json:"founded"`
+	Dissolved  OptNilDateTime                   `json:"dissolved"`
+}
+
+// GetType returns the value of Type.
+func (s *OrganizationPatch) GetType() OptNilString {
+	return s.Type
+}
+
+// GetAcronym returns the value of Acronym.
+func (s *OrganizationPatch) GetAcronym() OptNilString {
+	return s.Acronym
+}
+
+// GetNameDut returns the value of NameDut.
+func (s *OrganizationPatch) GetNameDut() OptNilString {
+	return s.NameDut
+}
+
+// GetNameEng returns the value of NameEng.
+func (s *OrganizationPatch) GetNameEng() OptNilString {
+	return s.NameEng
+}
+
+// GetParent returns the value of Parent.
+func (s *OrganizationPatch) GetParent() OptNilOrganizationParentArray {
+	return s.Parent
+}
+
+// GetSuccessor returns the value of Successor.
+func (s *OrganizationPatch) GetSuccessor() OptNilOrganizationSuccessorArray {
+	return s.Successor
+}
+
+// GetIdentifier returns the value of Identifier.
+func (s *OrganizationPatch) GetIdentifier() OptNilStringArray {
+	return s.Identifier
+}
+
+// GetFounded returns the value of Founded.
+func (s *OrganizationPatch) GetFounded() OptNilDateTime {
+	return s.Founded
+}
+
+// GetDissolved returns the value of Dissolved.
+func (s *OrganizationPatch) GetDissolved() OptNilDateTime {
+	return s.Dissolved
+}
+
+// SetType sets the value of Type.
+func (s *OrganizationPatch) SetType(val OptNilString) {
+	s.Type = val
+}
+
+// SetAcronym sets the value of Acronym.
+func (s *OrganizationPatch) SetAcronym(val OptNilString) {
+	s.Acronym = val
+}
+
+// SetNameDut sets the value of NameDut.
+func (s *OrganizationPatch) SetNameDut(val OptNilString) {
+	s.NameDut = val
+}
+
+// SetNameEng sets the value of NameEng.
+func (s *OrganizationPatch) SetNameEng(val OptNilString) {
+	s.NameEng = val
+}
+
+// SetParent sets the value of Parent.
+func (s *OrganizationPatch) SetParent(val OptNilOrganizationParentArray) {
+	s.Parent = val
+}
+
+// SetSuccessor sets the value of Successor.
+func (s *OrganizationPatch) SetSuccessor(val OptNilOrganizationSuccessorArray) {
+	s.Successor = val
+}
+
+// SetIdentifier sets the value of Identifier.
+func (s *OrganizationPatch) SetIdentifier(val OptNilStringArray) {
+	s.Identifier = val
+}
+
+// SetFounded sets the value of Founded.
+func (s *OrganizationPatch) SetFounded(val OptNilDateTime) {
+	s.Founded = val
+}
+
+// SetDissolved sets the value of Dissolved.
+func (s *OrganizationPatch) SetDissolved(val OptNilDateTime) {
+	s.Dissolved = val
+}
+
+// Ref: #/components/schemas/OrganizationRevision
+type OrganizationRevision struct {
+	Version      int             `json:"version"`
+	DateCreated  time.Time       `json:"date_created"`
+	Type         EventType       `json:"type"`
+	Actor        Actor           `json:"actor"`
+	Organization OptOrganization `json:"organization"`
+	Changes      []FieldChange   `json:"changes"`
+}
+
+// GetVersion returns the value of Version.
+func (s *OrganizationRevision) GetVersion() int {
+	return s.Version
+}
+
+// GetDateCreated returns the value of DateCreated.
+func (s *OrganizationRevision) GetDateCreated() time.Time {
+	return s.DateCreated
+}
+
+// GetType returns the value of Type.
+func (s *OrganizationRevision) GetType() EventType {
+	return s.Type
+}
+
+// GetActor returns the value of Actor.
+func (s *OrganizationRevision) GetActor() Actor {
+	return s.Actor
+}
+
+// GetOrganization returns the value of Organization.
+func (s *OrganizationRevision) GetOrganization() OptOrganization {
+	return s.Organization
+}
+
+// GetChanges returns the value of Changes.
+func (s *OrganizationRevision) GetChanges() []FieldChange {
+	return s.Changes
+}
+
+// SetVersion sets the value of Version.
+func (s *OrganizationRevision) SetVersion(val int) {
+	s.Version = val
+}
+
+// SetDateCreated sets the value of DateCreated.
+func (s *OrganizationRevision) SetDateCreated(val time.Time) {
+	s.DateCreated = val
+}
+
+// SetType sets the value of Type.
+func (s *OrganizationRevision) SetType(val EventType) {
+	s.Type = val
+}
+
+// SetActor sets the value of Actor.
+func (s *OrganizationRevision) SetActor(val Actor) {
+	s.Actor = val
+}
+
+// SetOrganization sets the value of Organization.
+func (s *OrganizationRevision) SetOrganization(val OptOrganization) {
+	s.Organization = val
+}
+
+// SetChanges sets the value of Changes.
+func (s *OrganizationRevision) SetChanges(val []FieldChange) {
+	s.Changes = val
+}
+
+// Ref: #/components/schemas/OrganizationSearchResponse
+type OrganizationSearchResponse struct {
+	Total  int                `json:"total"`
+	Offset int                `json:"offset"`
+	Limit  int                `json:"limit"`
+	Data   []Organization     `json:"data"`
+	Facets OrganizationFacets `json:"facets"`
+}
+
+// GetTotal returns the value of Total.
+func (s *OrganizationSearchResponse) GetTotal() int {
+	return s.Total
+}
+
+// GetOffset returns the value of Offset.
+func (s *OrganizationSearchResponse) GetOffset() int {
+	return s.Offset
+}
+
+// GetLimit returns the value of Limit.
+func (s *OrganizationSearchResponse) GetLimit() int {
+	return s.Limit
+}
+
+// GetData returns the value of Data.
+func (s *OrganizationSearchResponse) GetData() []Organization {
+	return s.Data
+}
+
+// GetFacets returns the value of Facets.
+func (s *OrganizationSearchResponse) GetFacets() OrganizationFacets {
+	return s.Facets
+}
+
+// SetTotal sets the value of Total.
+func (s *OrganizationSearchResponse) SetTotal(val int) {
+	s.Total = val
+}
+
+// SetOffset sets the value of Offset.
+func (s *OrganizationSearchResponse) SetOffset(val int) {
+	s.Offset = val
+}
+
+// SetLimit sets the value of Limit.
+func (s *OrganizationSearchResponse) SetLimit(val int) {
+	s.Limit = val
+}
+
+// SetData sets the value of Data.
+func (s *OrganizationSearchResponse) SetData(val []Organization) {
+	s.Data = val
+}
+
+// SetFacets sets the value of Facets.
+func (s *OrganizationSearchResponse) SetFacets(val OrganizationFacets) {
+	s.Facets = val
+}
+
+// Derived from founded and dissolved: planned before founded, dissolved from dissolved on, active
+// otherwise.
+type OrganizationStatus string
+
+const (
+	OrganizationStatusPlanned   OrganizationStatus = "planned"
+	OrganizationStatusActive    OrganizationStatus = "active"
+	OrganizationStatusDissolved OrganizationStatus = "dissolved"
+)
+
+// AllValues returns all OrganizationStatus values.
+func (OrganizationStatus) AllValues() []OrganizationStatus {
+	return []OrganizationStatus{
+		OrganizationStatusPlanned,
+		OrganizationStatusActive,
+		OrganizationStatusDissolved,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s OrganizationStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case OrganizationStatusPlanned:
+		return []byte(s), nil
+	case OrganizationStatusActive:
+		return []byte(s), nil
+	case OrganizationStatusDissolved:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *OrganizationStatus) UnmarshalText(data []byte) error {
+	switch OrganizationStatus(data) {
+	case OrganizationStatusPlanned:
+		*s = OrganizationStatusPlanned
+		return nil
+	case OrganizationStatusActive:
+		*s = OrganizationStatusActive
+		return nil
+	case OrganizationStatusDissolved:
+		*s = OrganizationStatusDissolved
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/OrganizationSuccessor
+type OrganizationSuccessor struct {
+	ID          string      `json:"id"`
+	DateCreated OptDateTime `json:"date_created"`
+	DateUpdated OptDateTime `json:"date_updated"`
+	// Date on which the successor took over.
+	Date time.Time `json:"date"`
+}
+
+// GetID returns the value of ID.
+func (s *OrganizationSuccessor) GetID() string {
+	return s.ID
+}
+
+// GetDateCreated returns the value of DateCreated.
+func (s *OrganizationSuccessor) GetDateCreated() OptDateTime {
+	return s.DateCreated
+}
+
+// GetDateUpdated returns the value of DateUpdated.
+func (s *OrganizationSuccessor) GetDateUpdated() OptDateTime {
+	return s.DateUpdated
+}
+
+// GetDate returns the value of Date.
+func (s *OrganizationSuccessor) GetDate() time.Time {
+	return s.Date
+}
+
+// SetID sets the value of ID.
+func (s *OrganizationSuccessor) SetID(val string) {
+	s.ID = val
+}
+
+// SetDateCreated sets the value of DateCreated.
+func (s *OrganizationSuccessor) SetDateCreated(val OptDateTime) {
+	s.DateCreated = val
+}
+
+// SetDateUpdated sets the value of DateUpdated.
+func (s *OrganizationSuccessor) SetDateUpdated(val OptDateTime) {
+	s.DateUpdated = val
+}
+
+// SetDate sets the value of Date.
+func (s *OrganizationSuccessor) SetDate(val time.Time) {
+	s.Date = val
+}
+
+// Ref: #/components/schemas/OrganizationTreeNode
+type OrganizationTreeNode struct {
+	Organization Organization `json:"organization"`
+	// Number of parent relations between the start (or the root) and this organization.
+	Depth int `json:"depth"`
+	// Organization ids from the start (or the root) up to and including this organization.
+	Path []string `json:"path"`
+	// Parent of this organization on the path; not set for roots and ancestors.
+	ParentID OptString `json:"parent_id"`
+	// Start of the followed parent relation.
+	From OptDateTime `json:"from"`
+	// End of the followed parent relation.
+	Until OptDateTime `json:"until"`
+}
+
+// GetOrganization returns the value of Organization.
+func (s *OrganizationTreeNode) GetOrganization() Organization {
+	return s.Organization
+}
+
+// GetDepth returns the value of Depth.
+func (s *OrganizationTreeNode) GetDepth() int {
+	return s.Depth
+}
+
+// GetPath returns the value of Path.
+func (s *OrganizationTreeNode) GetPath() []string {
+	return s.Path
+}
+
+// GetParentID returns the value of ParentID.
+func (s *OrganizationTreeNode) GetParentID() OptString {
+	return s.ParentID
+}
+
+// GetFrom returns the value of From.
+func (s *OrganizationTreeNode) GetFrom() OptDateTime {
+	return s.From
+}
+
+// GetUntil returns the value of Until.
+func (s *OrganizationTreeNode) GetUntil() OptDateTime {
+	return s.Until
+}
+
+// SetOrganization sets the value of Organization.
+func (s *OrganizationTreeNode) SetOrganization(val Organization) {
+	s.Organization = val
+}
+
+// SetDepth sets the value of Depth.
+func (s *OrganizationTreeNode) SetDepth(val int) {
+	s.Depth = val
+}
+
+// SetPath sets the value of Path.
+func (s *OrganizationTreeNode) SetPath(val []string) {
+	s.Path = val
+}
+
+// SetParentID sets the value of ParentID.
+func (s *OrganizationTreeNode) SetParentID(val OptString) {
+	s.ParentID = val
+}
+
+// SetFrom sets the value of From.
+func (s *OrganizationTreeNode) SetFrom(val OptDateTime) {
+	s.From = val
+}
+
+// SetUntil sets the value of Until.
+func (s *OrganizationTreeNode) SetUntil(val OptDateTime) {
+	s.Until = val
+}
+
+// Ref: #/components/schemas/OrganizationTreeResponse
+type OrganizationTreeResponse struct {
+	Data []OrganizationTreeNode `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *OrganizationTreeResponse) GetData() []OrganizationTreeNode {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *OrganizationTreeResponse) SetData(val []OrganizationTreeNode) {
+	s.Data = val
+}
+
+// Ref: #/components/schemas/Person
+type Person struct {
+	ID                  OptString            `json:"id"`
+	Active              OptBool              `json:"active"`
+	DateCreated         OptDateTime          `json:"date_created"`
+	DateUpdated         OptDateTime          `json:"date_updated"`
+	Name                OptString            `json:"name"`
+	GivenName           OptString            `json:"given_name"`
+	FamilyName          OptString            `json:"family_name"`
+	Email               OptString            `json:"email"`
+	Token               OptStringMap         `json:"token"`
+	PreferredGivenName  OptString            `json:"preferred_given_name"`
+	PreferredFamilyName OptString            `json:"preferred_family_name"`
+	BirthDate           OptString            `json:"birth_date"`
+	HonorificPrefix     OptString            `json:"honorific_prefix"`
+	Identifier          []string             `json:"identifier"`
+	Organization        []OrganizationMember `json:"organization"`
+	JobCategory         []string             `json:"job_category"`
+	Role                []string             `json:"role"`
+	Settings            OptPersonSettings    `json:"settings"`
+	ObjectClass         []string             `json:"object_class"`
+	// Version of the stored record. When given on update, the update fails with status 409 if the stored
+	// record has another version.
+	Version OptInt `json:"version"`
+}
+
+// GetID returns the value of ID.
+func (s *Person) GetID() OptString {
+	return s.ID
+}
+
+// GetActive returns the value of Active.
+func (s *Person) GetActive() OptBool {
+	return s.Active
+}
+
+// GetDateCreated returns the value of DateCreated.
+func (s *Person) GetDateCreated() OptDateTime {
+	return s.DateCreated
+}
+
+// GetDateUpdated returns the value of DateUpdated.
+func (s *Person) GetDateUpdated() OptDateTime {
+	return s.DateUpdated
+}
+
+// GetName returns the value of Name.
+func (s *Person) GetName() OptString {
+	return s.Name
+}
+
+// GetGivenName returns the value of GivenName.
+func (s *Person) GetGivenName() OptString {
+	return s.GivenName
+}
+
+// GetFamilyName returns the value of FamilyName.
+func (s *Person) GetFamilyName() OptString {
+	return s.FamilyName
+}
+
+// GetEmail returns the value of Email.
+func (s *Person) GetEmail() OptString {
+	return s.Email
+}
+
+// GetToken returns the value of Token.
+func (s *Person) GetToken() OptStringMap {
+	return s.Token
+}
+
+// GetPreferredGivenName returns the value of PreferredGivenName.
+func (s *Person) GetPreferredGivenName() OptString {
+	return s.PreferredGivenName
+}
+
+// GetPreferredFamilyName returns the value of PreferredFamilyName.
+func (s *Person) GetPreferredFamilyName() OptString {
+	return s.PreferredFamilyName
+}
+
+// GetBirthDate returns the value of BirthDate.
+func (s *Person) GetBirthDate() OptString {
+	return s.BirthDate
+}
+
+// GetHonorificPrefix returns the value of HonorificPrefix.
+func (s *Person) GetHonorificPrefix() OptString {
+	return s.HonorificPrefix
+}
+
+// GetIdentifier returns the value of Identifier.
+func (s *Person) GetIdentifier() []string {
+	return s.Identifier
+}
+
+// GetOrganization returns the value of Organization.
+func (s *Person) GetOrganization() []OrganizationMember {
+	return s.Organization
+}
+
+// GetJobCategory returns the value of JobCategory.
+func (s *Person) GetJobCategory() []string {
+	return s.JobCategory
+}
+
+// GetRole returns the value of Role.
+func (s *Person) GetRole() []string {
+	return s.Role
+}
+
+// GetSettings returns the value of Settings.
+func (s *Person) GetSettings() OptPersonSettings {
+	return s.Settings
+}
+
+// GetObjectClass returns the value of ObjectClass.
+func (s *Person) GetObjectClass() []string {
+	return s.ObjectClass
+}
+
+// GetVersion returns the value of Version.
+func (s *Person) GetVersion() OptInt {
+	return s.Version
+}
+
+// SetID sets the value of ID.
+func (s *Person) SetID(val OptString) {
+	s.ID = val
+}
+
+// SetActive sets the value of Active.
+func (s *Person) SetActive(val OptBool) {
+	s.Active = val
+}
+
+// SetDateCreated sets the value of DateCreated.
+func (s *Person) SetDateCreated(val OptDateTime) {
+	s.DateCreated = val
+}
+
+// SetDateUpdated sets the value of DateUpdated.
+func (s *Person) SetDateUpdated(val OptDateTime) {
+	s.DateUpdated = val
+}
+
+// SetName sets the value of Name.
+func (s *Person) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetGivenName sets the value of GivenName.
+func (s *Person) SetGivenName(val OptString) {
+	s.GivenName = val
+}
+
+// SetFamilyName sets the value of FamilyName.
+func (s *Person) SetFamilyName(val OptString) {
+	s.FamilyName = val
+}
+
+// SetEmail sets the value of Email.
+func (s *Person) SetEmail(val OptString) {
+	s.Email = val
+}
+
+// SetToken sets the value of Token.
+func (s *Person) SetToken(val OptStringMap) {
+	s.Token = val
+}
+
+// SetPreferredGivenName sets the value of PreferredGivenName.
+func (s *Person) SetPreferredGivenName(val OptString) {
+	s.PreferredGivenName = val
+}
+
+// SetPreferredFamilyName sets the value of PreferredFamilyName.
+func (s *Person) SetPreferredFamilyName(val OptString) {
+	s.PreferredFamilyName = val
+}
+
+// SetBirthDate sets the value of BirthDate.
+func (s *Person) SetBirthDate(val OptString) {
+	s.BirthDate = val
+}
+
+// SetHonorificPrefix sets the value of HonorificPrefix.
+func (s *Person) SetHonorificPrefix(val OptString) {
+	s.HonorificPrefix = val
+}
+
+// SetIdentifier sets the value of Identifier.
+func (s *Person) SetIdentifier(val []string) {
+	s.Identifier = val
+}
+
+// SetOrganization sets the value of Organization.
+func (s *Person) SetOrganization(val []OrganizationMember) {
+	s.Organization = val
+}
+
+// SetJobCategory sets the value of JobCategory.
+func (s *Person) SetJobCategory(val []string) {
+	s.JobCategory = val
+}
+
+// SetRole sets the value of Role.
+func (s *Person) SetRole(val []string) {
+	s.Role = val
+}
+
+// SetSettings sets the value of Settings.
+func (s *Person) SetSettings(val OptPersonSettings) {
+	s.Settings = val
+}
+
+// SetObjectClass sets the value of ObjectClass.
+func (s *Person) SetObjectClass(val []string) {
+	s.ObjectClass = val
+}
+
+// SetVersion sets the value of Version.
+func (s *Person) SetVersion(val OptInt) {
+	s.Version = val
+}
+
+// Ref: #/components/schemas/PersonDuplicate
+type PersonDuplicate struct {
+	SurvivorID  string   `json:"survivor_id"`
+	DuplicateID string   `json:"duplicate_id"`
+	Score       float64  `json:"score"`
+	Reasons     []string `json:"reasons"`
+}
+
+// GetSurvivorID returns the value of SurvivorID.
+func (s *PersonDuplicate) GetSurvivorID() string {
+	return s.SurvivorID
+}
+
+// GetDuplicateID returns the value of DuplicateID.
+func (s *PersonDuplicate) GetDuplicateID() string {
+	return s.DuplicateID
+}
+
+// GetScore returns the value of Score.
+func (s *PersonDuplicate) GetScore() float64 {
+	return s.Score
+}
+
+// GetReasons returns the value of Reasons.
+func (s *PersonDuplicate) GetReasons() []string {
+	return s.Reasons
+}
+
+// SetSurvivorID sets the value of SurvivorID.
+func (s *PersonDuplicate) SetSurvivorID(val string) {
+	s.SurvivorID = val
+}
+
+// SetDuplicateID sets the value of DuplicateID.
+func (s *PersonDuplicate) SetDuplicateID(val string) {
+	s.DuplicateID = val
+}
+
+// SetScore sets the value of Score.
+func (s *PersonDuplicate) SetScore(val float64) {
+	s.Score = val
+}
+
+// SetReasons sets the value of Reasons.
+func (s *PersonDuplicate) SetReasons(val []string) {
+	s.Reasons = val
+}
+
+// Ref: #/components/schemas/PersonDuplicatesResponse
+type PersonDuplicatesResponse struct {
+	Data []PersonDuplicate `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *PersonDuplicatesResponse) GetData() []PersonDuplicate {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *PersonDuplicatesResponse) SetData(val []PersonDuplicate) {
+	s.Data = val
+}
+
+// Ref: #/components/schemas/PersonFacets
+type PersonFacets struct {
+	Organization        []FacetValue `json:"organization"`
+	JobCategory         []FacetValue `json:"job_category"`
+	Role                []FacetValue `json:"role"`
+	ObjectClass         []FacetValue `json:"object_class"`
+	IdentifierNamespace []FacetValue `json:"identifier_namespace"`
+	Active              []FacetValue `json:"active"`
+}
+
+// GetOrganization returns the value of Organization.
+func (s *PersonFacets) GetOrganization() []FacetValue {
+	return s.Organization
+}
+
+// GetJobCategory returns the value of JobCategory.
+func (s *PersonFacets) GetJobCategory() []FacetValue {
+	return s.JobCategory
+}
+
+// GetRole returns the value of Role.
+func (s *PersonFacets) GetRole() []FacetValue {
+	return s.Role
+}
+
+// GetObjectClass returns the value of ObjectClass.
+func (s *PersonFacets) GetObjectClass() []FacetValue {
+	return s.ObjectClass
+}
+
+// GetIdentifierNamespace returns the value of IdentifierNamespace.
+func (s *PersonFacets) GetIdentifierNamespace() []FacetValue {
+	return s.IdentifierNamespace
+}
+
+// GetActive returns the value of Active.
+func (s *PersonFacets) GetActive() []FacetValue {
+	return s.Active
+}
+
+// SetOrganization sets the value of Organization.
+func (s *PersonFacets) SetOrganization(val []FacetValue) {
+	s.Organization = val
+}
+
+// SetJobCategory sets the value of JobCategory.
+func (s *PersonFacets) SetJobCategory(val []FacetValue) {
+	s.JobCategory = val
+}
+
+// SetRole sets the value of Role.
+func (s *PersonFacets) SetRole(val []FacetValue) {
+	s.Role = val
+}
+
+// SetObjectClass sets the value of ObjectClass.
+func (s *PersonFacets) SetObjectClass(val []FacetValue) {
+	s.ObjectClass = val
+}
+
+// SetIdentifierNamespace sets the value of IdentifierNamespace.
+func (s *PersonFacets) SetIdentifierNamespace(val []FacetValue) {
+	s.IdentifierNamespace = val
+}
+
+// SetActive sets the value of Active.
+func (s *PersonFacets) SetActive(val []FacetValue) {
+	s.Active = val
+}
+
+// Ref: #/components/schemas/PersonHistoryResponse
+type PersonHistoryResponse struct {
+	Data []PersonRevision `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *PersonHistoryResponse) GetData() []PersonRevision {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *PersonHistoryResponse) SetData(val []PersonRevision) {
+	s.Data = val
+}
+
+// Ref: #/components/schemas/PersonListResponse
+type PersonListResponse struct {
+	Data []Person `json:"data"`
+	// Tombstones of requested records that no longer exist (get by id only).
+	Gone []Tombstone `json:"gone"`
+	// Why every record in data matched, in the same order (suggest with highlight only).
+	Matches []Match `json:"matches"`
+}
+
+// GetData returns the value of Data.
+func (s *PersonListResponse) GetData() []Person {
+	return s.Data
+}
+
+// GetGone returns the value of Gone.
+func (s *PersonListResponse) GetGone() []Tombstone {
+	return s.Gone
+}
+
+// GetMatches returns the value of Matches.
+func (s *PersonListResponse) GetMatches() []Match {
+	return s.Matches
+}
+
+// SetData sets the value of Data.
+func (s *PersonListResponse) SetData(val []Person) {
+	s.Data = val
+}
+
+// SetGone sets the value of Gone.
+func (s *PersonListResponse) SetGone(val []Tombstone) {
+	s.Gone = val
+}
+
+// SetMatches sets the value of Matches.
+func (s *PersonListResponse) SetMatches(val []Match) {
+	s.Matches = val
+}
+
+// Ref: #/components/schemas/PersonPagedListResponse
+type PersonPagedListResponse struct {
+	Cursor OptString `json:"cursor"`
+	Data   []Person  `json:"data"`
+	// Tombstones of person records deleted since updated_since.
+	Gone []Tombstone `json:"gone"`
+}
+
+// GetCursor returns the value of Cursor.
+func (s *PersonPagedListResponse) GetCursor() OptString {
+	return s.Cursor
+}
+
+// GetData returns the value of Data.
+func (s *PersonPagedListResponse) GetData() []Person {
+	return s.Data
+}
+
+// GetGone returns the value of Gone.
+func (s *PersonPagedListResponse) GetGone() []Tombstone {
+	return s.Gone
+}
+
+// SetCursor sets the value of Cursor.
+func (s *PersonPagedListResponse) SetCursor(val OptString) {
+	s.Cursor = val
+}
+
+// SetData sets the value of Data.
+func (s *PersonPagedListResponse) SetData(val []Person) {
+	s.Data = val
+}
+
+// SetGone sets the value of Gone.
+func (s *PersonPagedListResponse) SetGone(val []Tombstone) {
+	s.Gone = val
+}
+
+// Ref: #/components/schemas/PersonPatch
+type PersonPatch struct {
+	Active              OptNilBool                    `json:"active"`
+	Name                OptNilString                  `json:"name"`
+	GivenName           OptNilString                  `json:"given_name"`
+	FamilyName          OptNilString                  `json:"family_name"`
+	Email               OptNilString                  `json:"email"`
+	Token               OptNilPersonPatchToken        `json:"token"`
+	PreferredGivenName  OptNilString                  `json:"preferred_given_name"`
+	PreferredFamilyName OptNilString                  `json:"preferred_family_name"`
+	BirthDate           OptNilString                  `json:"birth_date"`
+	HonorificPrefix     OptNilString                  `json:"honorific_prefix"`
+	Identifier          OptNilStringArray             `json:"identifier"`
+	Organization        OptNilOrganizationMemberArray `json:"organization"`
+	JobCategory         OptNilStringArray             `json:"job_category"`
+	Role                OptNilStringArray             `json:"role"`
+	Settings            OptNilPersonPatchSettings     `json:"settings"`
+	ObjectClass         OptNilStringArray             `json:"object_class"`
+}
+
+// GetActive returns the value of Active.
+func (s *PersonPatch) GetActive() OptNilBool {
+	return s.Active
+}
+
+// GetName returns the value of Name.
+func (s *PersonPatch) GetName() OptNilString {
+	return s.Name
+}
+
+// GetGivenName returns the value of GivenName.
+func (s *PersonPatch) GetGivenName() OptNilString {
+	return s.GivenName
+}
+
+// GetFamilyName returns the value of FamilyName.
+func (s *PersonPatch) GetFamilyName() OptNilString {
+	return s.FamilyName
+}
+
+// GetEmail returns the value of Email.
+func (s *PersonPatch) GetEmail() OptNilString {
+	return s.Email
+}
+
+// GetToken returns the value of Token.
+func (s *PersonPatch) GetToken() OptNilPersonPatchToken {
+	return s.Token
+}
+
+// GetPreferredGivenName returns the value of PreferredGivenName.
+func (s *PersonPatch) GetPreferredGivenName() OptNilString {
+	return s.PreferredGivenName
+}
+
+// GetPreferredFamilyName returns the value of PreferredFamilyName.
+func (s *PersonPatch) GetPreferredFamilyName() OptNilString {
+	return s.PreferredFamilyName
+}
+
+// GetBirthDate returns the value of BirthDate.
+func (s *PersonPatch) GetBirthDate() OptNilString {
+	return s.BirthDate
+}
+
+// GetHonorificPrefix returns the value of HonorificPrefix.
+func (s *PersonPatch) GetHonorificPrefix() OptNilString {
+	return s.HonorificPrefix
+}
+
+// GetIdentifier returns the value of Identifier.
+func (s *PersonPatch) GetIdentifier() OptNilStringArray {
+	return s.Identifier
+}
+
+// GetOrganization returns the value of Organization.
+func (s *PersonPatch) GetOrganization() OptNilOrganizationMemberArray {
+	return s.Organization
+}
+
+// GetJobCategory returns the value of JobCategory.
+func (s *PersonPatch) GetJobCategory() OptNilStringArray {
+	return s.JobCategory
+}
+
+// GetRole returns the value of Role.
+func (s *PersonPatch) GetRole() OptNilStringArray {
+	return s.Role
+}
+
+// GetSettings returns the value of Settings.
+func (s *PersonPatch) GetSettings() OptNilPersonPatchSettings {
+	return s.Settings
+}
+
+// GetObjectClass returns the value of ObjectClass.
+func (s *PersonPatch) GetObjectClass() OptNilStringArray {
+	return s.ObjectClass
+}
+
+// SetActive sets the value of Active.
+func (s *PersonPatch) SetActive(val OptNilBool) {
+	s.Active = val
+}
+
+// SetName sets the value of Name.
+func (s *PersonPatch) SetName(val OptNilString) {
+	s.Name = val
+}
+
+// SetGivenName sets the value of GivenName.
+func (s *PersonPatch) SetGivenName(val OptNilString) {
+	s.GivenName = val
+}
+
+// SetFamilyName sets the value of FamilyName.
+func (s *PersonPatch) SetFamilyName(val OptNilString) {
+	s.FamilyName = val
+}
+
+// SetEmail sets the value of Email.
+func (s *PersonPatch) SetEmail(val OptNilString) {
+	s.Email = val
+}
+
+// SetToken sets the value of Token.
+func (s *PersonPatch) SetToken(val OptNilPersonPatchToken) {
+	s.Token = val
+}
+
+// SetPreferredGivenName sets the value of PreferredGivenName.
+func (s *PersonPatch) SetPreferredGivenName(val OptNilString) {
+	s.PreferredGivenName = val
+}
+
+// SetPreferredFamilyName sets the value of PreferredFamilyName.
+func (s *PersonPatch) SetPreferredFamilyName(val OptNilString) {
+	s.PreferredFamilyName = val
+}
+
+// SetBirthDate sets the value of BirthDate.
+func (s *PersonPatch) SetBirthDate(val OptNilString) {
+	s.BirthDate = val
+}
+
+// SetHonorificPrefix sets the value of HonorificPrefix.
+func (s *PersonPatch) SetHonorificPrefix(val OptNilString) {
+	s.HonorificPrefix = val
+}
+
+// SetIdentifier sets the value of Identifier.
+func (s *PersonPatch) SetIdentifier(val OptNilStringArray) {
+	s.Identifier = val
+}
+
+// SetOrganization sets the value of Organization.
+func (s *PersonPatch) SetOrganization(val OptNilOrganizationMemberArray) {
+	s.Organization = val
+}
+
+// SetJobCategory sets the value of JobCategory.
+func (s *PersonPatch) SetJobCategory(val OptNilStringArray) {
+	s.JobCategory = val
+}
+
+// SetRole sets the value of Role.
+func (s *PersonPatch) SetRole(val OptNilStringArray) {
+	s.Role = val
+}
+
+// SetSettings sets the value of Settings.
+func (s *PersonPatch) SetSettings(val OptNilPersonPatchSettings) {
+	s.Settings = val
+}
+
+// SetObjectClass sets the value of ObjectClass.
+func (s *PersonPatch) SetObjectClass(val OptNilStringArray) {
+	s.ObjectClass = val
+}
+
+type PersonPatchSettings map[string]NilString
+
+func (s *PersonPatchSettings) init() PersonPatchSettings {
+	m := *s
+	if m == nil {
+		m = map[string]NilString{}
+		*s = m
+	}
+	return m
+}
+
+type PersonPatchToken map[string]NilString
+
+func (s *PersonPatchToken) init() PersonPatchToken {
+	m := *s
+	if m == nil {
+		m = map[string]NilString{}
+		*s = m
+	}
+	return m
+}
+
+// Ref: #/components/schemas/PersonRevision
+type PersonRevision struct {
+	Version     int           `json:"version"`
+	DateCreated time.Time     `json:"date_created"`
+	Type        EventType     `json:"type"`
+	Actor       Actor         `json:"actor"`
+	Person      OptPerson     `json:"person"`
+	Changes     []FieldChange `json:"changes"`
+}
+
+// GetVersion returns the value of Version.
+func (s *PersonRevision) GetVersion() int {
+	return s.Version
+}
+
+// GetDateCreated returns the value of DateCreated.
+func (s *PersonRevision) GetDateCreated() time.Time {
+	return s.DateCreated
+}
+
+// GetType returns the value of Type.
+func (s *PersonRevision) GetType() EventType {
+	return s.Type
+}
+
+// GetActor returns the value of Actor.
+func (s *PersonRevision) GetActor() Actor {
+	return s.Actor
+}
+
+// GetPerson returns the value of Person.
+func (s *PersonRevision) GetPerson() OptPerson {
+	return s.Person
+}
+
+// GetChanges returns the value of Changes.
+func (s *PersonRevision) GetChanges() []FieldChange {
+	return s.Changes
+}
+
+// SetVersion sets the value of Version.
+func (s *PersonRevision) SetVersion(val int) {
+	s.Version = val
+}
+
+// SetDateCreated sets the value of DateCreated.
+func (s *PersonRevision) SetDateCreated(val time.Time) {
 	s.DateCreated = val
 }
 
-// SetDateUpdated sets the value of DateUpdated.
-func (s *Organization) SetDateUpdated(val OptDateTime) {
-	s.DateUpdated = val
+// SetType sets the value of Type.
+func (s *PersonRevision) SetType(val EventType) {
+	s.Type = val
+}
+
+// SetActor sets the value of Actor.
+func (s *PersonRevision) SetActor(val Actor) {
+	s.Actor = val
+}
+
+// SetPerson sets the value of Person.
+func (s *PersonRevision) SetPerson(val OptPerson) {
+	s.Person = val
+}
+
+// SetChanges sets the value of Changes.
+func (s *PersonRevision) SetChanges(val []FieldChange) {
+	s.Changes = val
+}
+
+// Ref: #/components/schemas/PersonSearchResponse
+type PersonSearchResponse struct {
+	Total  int          `json:"total"`
+	Offset int          `json:"offset"`
+	Limit  int          `json:"limit"`
+	Data   []Person     `json:"data"`
+	Facets PersonFacets `json:"facets"`
+}
+
+// GetTotal returns the value of Total.
+func (s *PersonSearchResponse) GetTotal() int {
+	return s.Total
+}
+
+// GetOffset returns the value of Offset.
+func (s *PersonSearchResponse) GetOffset() int {
+	return s.Offset
+}
+
+// GetLimit returns the value of Limit.
+func (s *PersonSearchResponse) GetLimit() int {
+	return s.Limit
+}
+
+// GetData returns the value of Data.
+func (s *PersonSearchResponse) GetData() []Person {
+	return s.Data
+}
+
+// GetFacets returns the value of Facets.
+func (s *PersonSearchResponse) GetFacets() PersonFacets {
+	return s.Facets
+}
+
+// SetTotal sets the value of Total.
+func (s *PersonSearchResponse) SetTotal(val int) {
+	s.Total = val
+}
+
+// SetOffset sets the value of Offset.
+func (s *PersonSearchResponse) SetOffset(val int) {
+	s.Offset = val
+}
+
+// SetLimit sets the value of Limit.
+func (s *PersonSearchResponse) SetLimit(val int) {
+	s.Limit = val
+}
+
+// SetData sets the value of Data.
+func (s *PersonSearchResponse) SetData(val []Person) {
+	s.Data = val
+}
+
+// SetFacets sets the value of Facets.
+func (s *PersonSearchResponse) SetFacets(val PersonFacets) {
+	s.Facets = val
+}
+
+type PersonSettings map[string]string
+
+func (s *PersonSettings) init() PersonSettings {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
+	}
+	return m
+}
+
+// Ref: #/components/schemas/RevertRequest
+type RevertRequest struct {
+	ID OptString `json:"id"`
+	// Version of the revision to restore, required with id.
+	Version     OptInt      `json:"version"`
+	ActorSource OptString   `json:"actor_source"`
+	ActorID     OptString   `json:"actor_id"`
+	From        OptDateTime `json:"from"`
+	Until       OptDateTime `json:"until"`
+	Force       OptBool     `json:"force"`
+	DryRun      OptBool     `json:"dry_run"`
+}
+
+// GetID returns the value of ID.
+func (s *RevertRequest) GetID() OptString {
+	return s.ID
+}
+
+// GetVersion returns the value of Version.
+func (s *RevertRequest) GetVersion() OptInt {
+	return s.Version
+}
+
+// GetActorSource returns the value of ActorSource.
+func (s *RevertRequest) GetActorSource() OptString {
+	return s.ActorSource
+}
+
+// GetActorID returns the value of ActorID.
+func (s *RevertRequest) GetActorID() OptString {
+	return s.ActorID
+}
+
+// GetFrom returns the value of From.
+func (s *RevertRequest) GetFrom() OptDateTime {
+	return s.From
+}
+
+// GetUntil returns the value of Until.
+func (s *RevertRequest) GetUntil() OptDateTime {
+	return s.Until
+}
+
+// GetForce returns the value of Force.
+func (s *RevertRequest) GetForce() OptBool {
+	return s.Force
+}
+
+// GetDryRun returns the value of DryRun.
+func (s *RevertRequest) GetDryRun() OptBool {
+	return s.DryRun
+}
+
+// SetID sets the value of ID.
+func (s *RevertRequest) SetID(val OptString) {
+	s.ID = val
+}
+
+// SetVersion sets the value of Version.
+func (s *RevertRequest) SetVersion(val OptInt) {
+	s.Version = val
+}
+
+// SetActorSource sets the value of ActorSource.
+func (s *RevertRequest) SetActorSource(val OptString) {
+	s.ActorSource = val
+}
+
+// SetActorID sets the value of ActorID.
+func (s *RevertRequest) SetActorID(val OptString) {
+	s.ActorID = val
+}
+
+// SetFrom sets the value of From.
+func (s *RevertRequest) SetFrom(val OptDateTime) {
+	s.From = val
+}
+
+// SetUntil sets the value of Until.
+func (s *RevertRequest) SetUntil(val OptDateTime) {
+	s.Until = val
+}
+
+// SetForce sets the value of Force.
+func (s *RevertRequest) SetForce(val OptBool) {
+	s.Force = val
+}
+
+// SetDryRun sets the value of DryRun.
+func (s *RevertRequest) SetDryRun(val OptBool) {
+	s.DryRun = val
+}
+
+// Ref: #/components/schemas/RevertResponse
+type RevertResponse struct {
+	DryRun bool           `json:"dry_run"`
+	Data   []RevertResult `json:"data"`
+}
+
+// GetDryRun returns the value of DryRun.
+func (s *RevertResponse) GetDryRun() bool {
+	return s.DryRun
+}
+
+// GetData returns the value of Data.
+func (s *RevertResponse) GetData() []RevertResult {
+	return s.Data
+}
+
+// SetDryRun sets the value of DryRun.
+func (s *RevertResponse) SetDryRun(val bool) {
+	s.DryRun = val
+}
+
+// SetData sets the value of Data.
+func (s *RevertResponse) SetData(val []RevertResult) {
+	s.Data = val
+}
+
+// Ref: #/components/schemas/RevertResult
+type RevertResult struct {
+	ID string `json:"id"`
+	// Version the record was reverted to, 0 if the record was deleted.
+	Version int                `json:"version"`
+	Status  RevertResultStatus `json:"status"`
+	Changes []FieldChange      `json:"changes"`
+	// What the revert cannot restore, e.g. tokens, which are not kept in revisions.
+	Warnings []string  `json:"warnings"`
+	Error    OptString `json:"error"`
+}
+
+// GetID returns the value of ID.
+func (s *RevertResult) GetID() string {
+	return s.ID
+}
+
+// GetVersion returns the value of Version.
+func (s *RevertResult) GetVersion() int {
+	return s.Version
+}
+
+// GetStatus returns the value of Status.
+func (s *RevertResult) GetStatus() RevertResultStatus {
+	return s.Status
+}
+
+// GetChanges returns the value of Changes.
+func (s *RevertResult) GetChanges() []FieldChange {
+	return s.Changes
+}
+
+// GetWarnings returns the value of Warnings.
+func (s *RevertResult) GetWarnings() []string {
+	return s.Warnings
+}
+
+// GetError returns the value of Error.
+func (s *RevertResult) GetError() OptString {
+	return s.Error
+}
+
+// SetID sets the value of ID.
+func (s *RevertResult) SetID(val string) {
+	s.ID = val
+}
+
+// SetVersion sets the value of Version.
+func (s *RevertResult) SetVersion(val int) {
+	s.Version = val
+}
+
+// SetStatus sets the value of Status.
+func (s *RevertResult) SetStatus(val RevertResultStatus) {
+	s.Status = val
+}
+
+// SetChanges sets the value of Changes.
+func (s *RevertResult) SetChanges(val []FieldChange) {
+	s.Changes = val
+}
+
+// SetWarnings sets the value of Warnings.
+func (s *RevertResult) SetWarnings(val []string) {
+	s.Warnings = val
+}
+
+// SetError sets the value of Error.
+func (s *RevertResult) SetError(val OptString) {
+	s.Error = val
+}
+
+type RevertResultStatus string
+
+const (
+	RevertResultStatusReverted  RevertResultStatus = "reverted"
+	RevertResultStatusUnchanged RevertResultStatus = "unchanged"
+	RevertResultStatusSkipped   RevertResultStatus = "skipped"
+	RevertResultStatusError     RevertResultStatus = "error"
+)
+
+// AllValues returns all RevertResultStatus values.
+func (RevertResultStatus) AllValues() []RevertResultStatus {
+	return []RevertResultStatus{
+		RevertResultStatusReverted,
+		RevertResultStatusUnchanged,
+		RevertResultStatusSkipped,
+		RevertResultStatusError,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s RevertResultStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case RevertResultStatusReverted:
+		return []byte(s), nil
+	case RevertResultStatusUnchanged:
+		return []byte(s), nil
+	case RevertResultStatusSkipped:
+		return []byte(s), nil
+	case RevertResultStatusError:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *RevertResultStatus) UnmarshalText(data []byte) error {
+	switch RevertResultStatus(data) {
+	case RevertResultStatusReverted:
+		*s = RevertResultStatusReverted
+		return nil
+	case RevertResultStatusUnchanged:
+		*s = RevertResultStatusUnchanged
+		return nil
+	case RevertResultStatusSkipped:
+		*s = RevertResultStatusSkipped
+		return nil
+	case RevertResultStatusError:
+		*s = RevertResultStatusError
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/SearchOrganizationsRequest
+type SearchOrganizationsRequest struct {
+	Query OptString `json:"query"`
+	Type  []string  `json:"type"`
+	// Organization ids; only organizations below them match.
+	Ancestor []string `json:"ancestor"`
+	Current  OptBool  `json:"current"`
+	// Also return organizations that are dissolved now.
+	IncludeDissolved OptBool                           `json:"include_dissolved"`
+	Sort             OptSearchOrganizationsRequestSort `json:"sort"`
+	Offset           OptInt                            `json:"offset"`
+	Limit            OptInt                            `json:"limit"`
+	// Maximum number of values per facet (default 50).
+	FacetLimit OptInt `json:"facet_limit"`
+}
+
+// GetQuery returns the value of Query.
+func (s *SearchOrganizationsRequest) GetQuery() OptString {
+	return s.Query
+}
+
+// GetType returns the value of Type.
+func (s *SearchOrganizationsRequest) GetType() []string {
+	return s.Type
+}
+
+// GetAncestor returns the value of Ancestor.
+func (s *SearchOrganizationsRequest) GetAncestor() []string {
+	return s.Ancestor
+}
+
+// GetCurrent returns the value of Current.
+func (s *SearchOrganizationsRequest) GetCurrent() OptBool {
+	return s.Current
+}
+
+// GetIncludeDissolved returns the value of IncludeDissolved.
+func (s *SearchOrganizationsRequest) GetIncludeDissolved() OptBool {
+	return s.IncludeDissolved
+}
+
+// GetSort returns the value of Sort.
+func (s *SearchOrganizationsRequest) GetSort() OptSearchOrganizationsRequestSort {
+	return s.Sort
+}
+
+// GetOffset returns the value of Offset.
+func (s *SearchOrganizationsRequest) GetOffset() OptInt {
+	return s.Offset
+}
+
+// GetLimit returns the value of Limit.
+func (s *SearchOrganizationsRequest) GetLimit() OptInt {
+	return s.Limit
+}
+
+// GetFacetLimit returns the value of FacetLimit.
+func (s *SearchOrganizationsRequest) GetFacetLimit() OptInt {
+	return s.FacetLimit
+}
+
+// SetQuery sets the value of Query.
+func (s *SearchOrganizationsRequest) SetQuery(val OptString) {
+	s.Query = val
+}
+
+// SetType sets the value of Type.
+func (s *SearchOrganizationsRequest) SetType(val []string) {
+	s.Type = val
+}
+
+// SetAncestor sets the value of Ancestor.
+func (s *SearchOrganizationsRequest) SetAncestor(val []string) {
+	s.Ancestor = val
+}
+
+// SetCurrent sets the value of Current.
+func (s *SearchOrganizationsRequest) SetCurrent(val OptBool) {
+	s.Current = val
+}
+
+// SetIncludeDissolved sets the value of IncludeDissolved.
+func (s *SearchOrganizationsRequest) SetIncludeDissolved(val OptBool) {
+	s.IncludeDissolved = val
+}
+
+// SetSort sets the value of Sort.
+func (s *SearchOrganizationsRequest) SetSort(val OptSearchOrganizationsRequestSort) {
+	s.Sort = val
+}
+
+// SetOffset sets the value of Offset.
+func (s *SearchOrganizationsRequest) SetOffset(val OptInt) {
+	s.Offset = val
+}
+
+// SetLimit sets the value of Limit.
+func (s *SearchOrganizationsRequest) SetLimit(val OptInt) {
+	s.Limit = val
+}
+
+// SetFacetLimit sets the value of FacetLimit.
+func (s *SearchOrganizationsRequest) SetFacetLimit(val OptInt) {
+	s.FacetLimit = val
+}
+
+type SearchOrganizationsRequestSort string
+
+const (
+	SearchOrganizationsRequestSortRelevance        SearchOrganizationsRequestSort = "relevance"
+	SearchOrganizationsRequestSortName             SearchOrganizationsRequestSort = "name"
+	SearchOrganizationsRequestSortMinusName        SearchOrganizationsRequestSort = "-name"
+	SearchOrganizationsRequestSortDateUpdated      SearchOrganizationsRequestSort = "date_updated"
+	SearchOrganizationsRequestSortMinusDateUpdated SearchOrganizationsRequestSort = "-date_updated"
+)
+
+// AllValues returns all SearchOrganizationsRequestSort values.
+func (SearchOrganizationsRequestSort) AllValues() []SearchOrganizationsRequestSort {
+	return []SearchOrganizationsRequestSort{
+		SearchOrganizationsRequestSortRelevance,
+		SearchOrganizationsRequestSortName,
+		SearchOrganizationsRequestSortMinusName,
+		SearchOrganizationsRequestSortDateUpdated,
+		SearchOrganizationsRequestSortMinusDateUpdated,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SearchOrganizationsRequestSort) MarshalText() ([]byte, error) {
+	switch s {
+	case SearchOrganizationsRequestSortRelevance:
+		return []byte(s), nil
+	case SearchOrganizationsRequestSortName:
+		return []byte(s), nil
+	case SearchOrganizationsRequestSortMinusName:
+		return []byte(s), nil
+	case SearchOrganizationsRequestSortDateUpdated:
+		return []byte(s), nil
+	case SearchOrganizationsRequestSortMinusDateUpdated:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SearchOrganizationsRequestSort) UnmarshalText(data []byte) error {
+	switch SearchOrganizationsRequestSort(data) {
+	case SearchOrganizationsRequestSortRelevance:
+		*s = SearchOrganizationsRequestSortRelevance
+		return nil
+	case SearchOrganizationsRequestSortName:
+		*s = SearchOrganizationsRequestSortName
+		return nil
+	case SearchOrganizationsRequestSortMinusName:
+		*s = SearchOrganizationsRequestSortMinusName
+		return nil
+	case SearchOrganizationsRequestSortDateUpdated:
+		*s = SearchOrganizationsRequestSortDateUpdated
+		return nil
+	case SearchOrganizationsRequestSortMinusDateUpdated:
+		*s = SearchOrganizationsRequestSortMinusDateUpdated
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/SearchPeopleRequest
+type SearchPeopleRequest struct {
+	Query OptString `json:"query"`
+	// Organization ids the person is a member of.
+	Organization []string `json:"organization"`
+	// Also match members of organizations below those in organization.
+	IncludeSubOrganizations OptBool `json:"include_sub_organizations"`
+	// Only match (and count in the organization facet) memberships valid at this time (default now).
+	AsOf OptDateTime `json:"as_of"`
+	// Match (and count) past and future memberships too, can't be combined with as_of.
+	Historic    OptBool  `json:"historic"`
+	JobCategory []string `json:"job_category"`
+	Role        []string `json:"role"`
+	ObjectClass []string `json:"object_class"`
+	// Identifier namespaces the person has an identifier of.
+	IdentifierNamespace []string                   `json:"identifier_namespace"`
+	Active              []bool                     `json:"active"`
+	Sort                OptSearchPeopleRequestSort `json:"sort"`
+	Offset              OptInt                     `json:"offset"`
+	Limit               OptInt                     `json:"limit"`
+	// Maximum number of values per facet (default 50).
+	FacetLimit OptInt `json:"facet_limit"`
+}
+
+// GetQuery returns the value of Query.
+func (s *SearchPeopleRequest) GetQuery() OptString {
+	return s.Query
+}
+
+// GetOrganization returns the value of Organization.
+func (s *SearchPeopleRequest) GetOrganization() []string {
+	return s.Organization
+}
+
+// GetIncludeSubOrganizations returns the value of IncludeSubOrganizations.
+func (s *SearchPeopleRequest) GetIncludeSubOrganizations() OptBool {
+	return s.IncludeSubOrganizations
+}
+
+// GetAsOf returns the value of AsOf.
+func (s *SearchPeopleRequest) GetAsOf() OptDateTime {
+	return s.AsOf
+}
+
+// GetHistoric returns the value of Historic.
+func (s *SearchPeopleRequest) GetHistoric() OptBool {
+	return s.Historic
+}
+
+// GetJobCategory returns the value of JobCategory.
+func (s *SearchPeopleRequest) GetJobCategory() []string {
+	return s.JobCategory
+}
+
+// GetRole returns the value of Role.
+func (s *SearchPeopleRequest) GetRole() []string {
+	return s.Role
+}
+
+// GetObjectClass returns the value of ObjectClass.
+func (s *SearchPeopleRequest) GetObjectClass() []string {
+	return s.ObjectClass
+}
+
+// GetIdentifierNamespace returns the value of IdentifierNamespace.
+func (s *SearchPeopleRequest) GetIdentifierNamespace() []string {
+	return s.IdentifierNamespace
+}
+
+// GetActive returns the value of Active.
+func (s *SearchPeopleRequest) GetActive() []bool {
+	return s.Active
+}
+
+// GetSort returns the value of Sort.
+func (s *SearchPeopleRequest) GetSort() OptSearchPeopleRequestSort {
+	return s.Sort
+}
+
+// GetOffset returns the value of Offset.
+func (s *SearchPeopleRequest) GetOffset() OptInt {
+	return s.Offset
+}
+
+// GetLimit returns the value of Limit.
+func (s *SearchPeopleRequest) GetLimit() OptInt {
+	return s.Limit
+}
+
+// GetFacetLimit returns the value of FacetLimit.
+func (s *SearchPeopleRequest) GetFacetLimit() OptInt {
+	return s.FacetLimit
+}
+
+// SetQuery sets the value of Query.
+func (s *SearchPeopleRequest) SetQuery(val OptString) {
+	s.Query = val
+}
+
+// SetOrganization sets the value of Organization.
+func (s *SearchPeopleRequest) SetOrganization(val []string) {
+	s.Organization = val
+}
+
+// SetIncludeSubOrganizations sets the value of IncludeSubOrganizations.
+func (s *SearchPeopleRequest) SetIncludeSubOrganizations(val OptBool) {
+	s.IncludeSubOrganizations = val
+}
+
+// SetAsOf sets the value of AsOf.
+func (s *SearchPeopleRequest) SetAsOf(val OptDateTime) {
+	s.AsOf = val
+}
+
+// SetHistoric sets the value of Historic.
+func (s *SearchPeopleRequest) SetHistoric(val OptBool) {
+	s.Historic = val
+}
+
+// SetJobCategory sets the value of JobCategory.
+func (s *SearchPeopleRequest) SetJobCategory(val []string) {
+	s.JobCategory = val
+}
+
+// SetRole sets the value of Role.
+func (s *SearchPeopleRequest) SetRole(val []string) {
+	s.Role = val
+}
+
+// SetObjectClass sets the value of ObjectClass.
+func (s *SearchPeopleRequest) SetObjectClass(val []string) {
+	s.ObjectClass = val
+}
+
+// SetIdentifierNamespace sets the value of IdentifierNamespace.
+func (s *SearchPeopleRequest) SetIdentifierNamespace(val []string) {
+	s.IdentifierNamespace = val
+}
+
+// SetActive sets the value of Active.
+func (s *SearchPeopleRequest) SetActive(val []bool) {
+	s.Active = val
+}
+
+// SetSort sets the value of Sort.
+func (s *SearchPeopleRequest) SetSort(val OptSearchPeopleRequestSort) {
+	s.Sort = val
+}
+
+// SetOffset sets the value of Offset.
+func (s *SearchPeopleRequest) SetOffset(val OptInt) {
+	s.Offset = val
+}
+
+// SetLimit sets the value of Limit.
+func (s *SearchPeopleRequest) SetLimit(val OptInt) {
+	s.Limit = val
+}
+
+// SetFacetLimit sets the value of FacetLimit.
+func (s *SearchPeopleRequest) SetFacetLimit(val OptInt) {
+	s.FacetLimit = val
+}
+
+type SearchPeopleRequestSort string
+
+const (
+	SearchPeopleRequestSortRelevance        SearchPeopleRequestSort = "relevance"
+	SearchPeopleRequestSortName             SearchPeopleRequestSort = "name"
+	SearchPeopleRequestSortMinusName        SearchPeopleRequestSort = "-name"
+	SearchPeopleRequestSortDateUpdated      SearchPeopleRequestSort = "date_updated"
+	SearchPeopleRequestSortMinusDateUpdated SearchPeopleRequestSort = "-date_updated"
+)
+
+// AllValues returns all SearchPeopleRequestSort values.
+func (SearchPeopleRequestSort) AllValues() []SearchPeopleRequestSort {
+	return []SearchPeopleRequestSort{
+		SearchPeopleRequestSortRelevance,
+		SearchPeopleRequestSortName,
+		SearchPeopleRequestSortMinusName,
+		SearchPeopleRequestSortDateUpdated,
+		SearchPeopleRequestSortMinusDateUpdated,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SearchPeopleRequestSort) MarshalText() ([]byte, error) {
+	switch s {
+	case SearchPeopleRequestSortRelevance:
+		return []byte(s), nil
+	case SearchPeopleRequestSortName:
+		return []byte(s), nil
+	case SearchPeopleRequestSortMinusName:
+		return []byte(s), nil
+	case SearchPeopleRequestSortDateUpdated:
+		return []byte(s), nil
+	case SearchPeopleRequestSortMinusDateUpdated:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SearchPeopleRequestSort) UnmarshalText(data []byte) error {
+	switch SearchPeopleRequestSort(data) {
+	case SearchPeopleRequestSortRelevance:
+		*s = SearchPeopleRequestSortRelevance
+		return nil
+	case SearchPeopleRequestSortName:
+		*s = SearchPeopleRequestSortName
+		return nil
+	case SearchPeopleRequestSortMinusName:
+		*s = SearchPeopleRequestSortMinusName
+		return nil
+	case SearchPeopleRequestSortDateUpdated:
+		*s = SearchPeopleRequestSortDateUpdated
+		return nil
+	case SearchPeopleRequestSortMinusDateUpdated:
+		*s = SearchPeopleRequestSortMinusDateUpdated
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/SetPersonOrcidRequest
+type SetPersonOrcidRequest struct {
+	ID    string `json:"id"`
+	Orcid string `json:"orcid"`
+	// Only update when the stored record has this version. Fails with status 409 otherwise.
+	ExpectedVersion OptInt `json:"expected_version"`
+}
+
+// GetID returns the value of ID.
+func (s *SetPersonOrcidRequest) GetID() string {
+	return s.ID
+}
+
+// GetOrcid returns the value of Orcid.
+func (s *SetPersonOrcidRequest) GetOrcid() string {
+	return s.Orcid
+}
+
+// GetExpectedVersion returns the value of ExpectedVersion.
+func (s *SetPersonOrcidRequest) GetExpectedVersion() OptInt {
+	return s.ExpectedVersion
+}
+
+// SetID sets the value of ID.
+func (s *SetPersonOrcidRequest) SetID(val string) {
+	s.ID = val
+}
+
+// SetOrcid sets the value of Orcid.
+func (s *SetPersonOrcidRequest) SetOrcid(val string) {
+	s.Orcid = val
+}
+
+// SetExpectedVersion sets the value of ExpectedVersion.
+func (s *SetPersonOrcidRequest) SetExpectedVersion(val OptInt) {
+	s.ExpectedVersion = val
+}
+
+// Ref: #/components/schemas/SetPersonRoleRequest
+type SetPersonRoleRequest struct {
+	ID   string   `json:"id"`
+	Role []string `json:"role"`
+	// Only update when the stored record has this version. Fails with status 409 otherwise.
+	ExpectedVersion OptInt `json:"expected_version"`
+}
+
+// GetID returns the value of ID.
+func (s *SetPersonRoleRequest) GetID() string {
+	return s.ID
+}
+
+// GetRole returns the value of Role.
+func (s *SetPersonRoleRequest) GetRole() []string {
+	return s.Role
+}
+
+// GetExpectedVersion returns the value of ExpectedVersion.
+func (s *SetPersonRoleRequest) GetExpectedVersion() OptInt {
+	return s.ExpectedVersion
+}
+
+// SetID sets the value of ID.
+func (s *SetPersonRoleRequest) SetID(val string) {
+	s.ID = val
+}
+
+// SetRole sets the value of Role.
+func (s *SetPersonRoleRequest) SetRole(val []string) {
+	s.Role = val
+}
+
+// SetExpectedVersion sets the value of ExpectedVersion.
+func (s *SetPersonRoleRequest) SetExpectedVersion(val OptInt) {
+	s.ExpectedVersion = val
+}
+
+// Ref: #/components/schemas/SetPersonSettingsRequest
+type SetPersonSettingsRequest struct {
+	ID       string                           `json:"id"`
+	Settings SetPersonSettingsRequestSettings `json:"settings"`
+	// Only update when the stored record has this version. Fails with status 409 otherwise.
+	ExpectedVersion OptInt `json:"expected_version"`
+}
+
+// GetID returns the value of ID.
+func (s *SetPersonSettingsRequest) GetID() string {
+	return s.ID
+}
+
+// GetSettings returns the value of Settings.
+func (s *SetPersonSettingsRequest) GetSettings() SetPersonSettingsRequestSettings {
+	return s.Settings
+}
+
+// GetExpectedVersion returns the value of ExpectedVersion.
+func (s *SetPersonSettingsRequest) GetExpectedVersion() OptInt {
+	return s.ExpectedVersion
+}
+
+// SetID sets the value of ID.
+func (s *SetPersonSettingsRequest) SetID(val string) {
+	s.ID = val
+}
+
+// SetSettings sets the value of Settings.
+func (s *SetPersonSettingsRequest) SetSettings(val SetPersonSettingsRequestSettings) {
+	s.Settings = val
+}
+
+// SetExpectedVersion sets the value of ExpectedVersion.
+func (s *SetPersonSettingsRequest) SetExpectedVersion(val OptInt) {
+	s.ExpectedVersion = val
+}
+
+type SetPersonSettingsRequestSettings map[string]string
+
+func (s *SetPersonSettingsRequestSettings) init() SetPersonSettingsRequestSettings {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
+	}
+	return m
+}
+
+// Ref: #/components/schemas/SetPersonTokenRequest
+type SetPersonTokenRequest struct {
+	ID    string `json:"id"`
+	Type  string `json:"type"`
+	Token string `json:"token"`
+	// Only update when the stored record has this version. Fails with status 409 otherwise.
+	ExpectedVersion OptInt `json:"expected_version"`
+}
+
+// GetID returns the value of ID.
+func (s *SetPersonTokenRequest) GetID() string {
+	return s.ID
+}
+
+// GetType returns the value of Type.
+func (s *SetPersonTokenRequest) GetType() string {
+	return s.Type
+}
+
+// GetToken returns the value of Token.
+func (s *SetPersonTokenRequest) GetToken() string {
+	return s.Token
+}
+
+// GetExpectedVersion returns the value of ExpectedVersion.
+func (s *SetPersonTokenRequest) GetExpectedVersion() OptInt {
+	return s.ExpectedVersion
+}
+
+// SetID sets the value of ID.
+func (s *SetPersonTokenRequest) SetID(val string) {
+	s.ID = val
+}
+
+// SetType sets the value of Type.
+func (s *SetPersonTokenRequest) SetType(val string) {
+	s.Type = val
+}
+
+// SetToken sets the value of Token.
+func (s *SetPersonTokenRequest) SetToken(val string) {
+	s.Token = val
+}
+
+// SetExpectedVersion sets the value of ExpectedVersion.
+func (s *SetPersonTokenRequest) SetExpectedVersion(val OptInt) {
+	s.ExpectedVersion = val
+}
+
+// Ref: #/components/schemas/StringMap
+type StringMap map[string]string
+
+func (s *StringMap) init() StringMap {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
+	}
+	return m
+}
+
+// Ref: #/components/schemas/SuggestOrganizationsRequest
+type SuggestOrganizationsRequest struct {
+	Limit OptInt `json:"limit"`
+	Query string `json:"query"`
+	// Minimum trigram similarity (0-1) of query and name, lower values tolerate more typos (default 0.5).
+	Threshold OptFloat64 `json:"threshold"`
+	// Return match metadata per hit in attribute matches.
+	Highlight OptBool `json:"highlight"`
+	// Also return organizations that are dissolved now.
+	IncludeDissolved OptBool `json:"include_dissolved"`
 }
 
-// SetType sets the value of Type.
-func (s *Organization) SetType(val OptString) {
-	s.Type = val
+// GetLimit returns the value of Limit.
+func (s *SuggestOrganizationsRequest) GetLimit() OptInt {
+	return s.Limit
 }
 
-// SetAcronym sets the value of Acronym.
-func (s *Organization) SetAcronym(val OptString) {
-	s.Acronym = val
+// GetQuery returns the value of Query.
+func (s *SuggestOrganizationsRequest) GetQuery() string {
+	return s.Query
 }
 
-// SetNameDut sets the value of NameDut.
-func (s *Organization) SetNameDut(val OptString) {
-	s.NameDut = val
+// GetThreshold returns the value of Threshold.
+func (s *SuggestOrganizationsRequest) GetThreshold() OptFloat64 {
+	return s.Threshold
 }
 
-// SetNameEng sets the value of NameEng.
-func (s *Organization) SetNameEng(val OptString) {
-	s.NameEng = val
+// GetHighlight returns the value of Highlight.
+func (s *SuggestOrganizationsRequest) GetHighlight() OptBool {
+	return s.Highlight
 }
 
-// SetParent sets the value of Parent.
-func (s *Organization) SetParent(val []OrganizationParent) {
-	s.Parent = val
+// GetIncludeDissolved returns the value of IncludeDissolved.
+func (s *SuggestOrganizationsRequest) GetIncludeDissolved() OptBool {
+	return s.IncludeDissolved
 }
 
-// SetIdentifier sets the value of Identifier.
-func (s *Organization) SetIdentifier(val []string) {
-	s.Identifier = val
+// SetLimit sets the value of Limit.
+func (s *SuggestOrganizationsRequest) SetLimit(val OptInt) {
+	s.Limit = val
 }
 
-// Ref: #/components/schemas/OrganizationListResponse
-type OrganizationListResponse struct {
-	Data []Organization `json:"data"`
+// SetQuery sets the value of Query.
+func (s *SuggestOrganizationsRequest) SetQuery(val string) {
+	s.Query = val
 }
 
-// GetData returns the value of Data.
-func (s *OrganizationListResponse) GetData() []Organization {
-	return s.Data
+// SetThreshold sets the value of Threshold.
+func (s *SuggestOrganizationsRequest) SetThreshold(val OptFloat64) {
+	s.Threshold = val
 }
 
-// SetData sets the value of Data.
-func (s *OrganizationListResponse) SetData(val []Organization) {
-	s.Data = val
+// SetHighlight sets the value of Highlight.
+func (s *SuggestOrganizationsRequest) SetHighlight(val OptBool) {
+	s.Highlight = val
 }
 
-// Ref: #/components/schemas/OrganizationMember
-type OrganizationMember struct {
-	ID          string      `json:"id"`
-	DateCreated OptDateTime `json:"date_created"`
-	DateUpdated OptDateTime `json:"date_updated"`
+// SetIncludeDissolved sets the value of IncludeDissolved.
+func (s *SuggestOrganizationsRequest) SetIncludeDissolved(val OptBool) {
+	s.IncludeDissolved = val
 }
 
-// GetID returns the value of ID.
-func (s *OrganizationMember) GetID() string {
-	return s.ID
+// Ref: #/components/schemas/SuggestPeopleRequest
+type SuggestPeopleRequest struct {
+	Limit  OptInt `json:"limit"`
+	Query  string `json:"query"`
+	Active []bool `json:"active"`
+	// Minimum trigram similarity (0-1) of query and name, lower values tolerate more typos (default 0.5).
+	Threshold OptFloat64 `json:"threshold"`
+	// Return match metadata per hit in attribute matches.
+	Highlight OptBool `json:"highlight"`
 }
 
-// GetDateCreated returns the value of DateCreated.
-func (s *OrganizationMember) GetDateCreated() OptDateTime {
-	return s.DateCreated
+// GetLimit returns the value of Limit.
+func (s *SuggestPeopleRequest) GetLimit() OptInt {
+	return s.Limit
 }
 
-// GetDateUpdated returns the value of DateUpdated.
-func (s *OrganizationMember) GetDateUpdated() OptDateTime {
-	return s.DateUpdated
+// GetQuery returns the value of Query.
+func (s *SuggestPeopleRequest) GetQuery() string {
+	return s.Query
 }
 
-// SetID sets the value of ID.
-func (s *OrganizationMember) SetID(val string) {
-	s.ID = val
+// GetActive returns the value of Active.
+func (s *SuggestPeopleRequest) GetActive() []bool {
+	return s.Active
 }
 
-// SetDateCreated sets the value of DateCreated.
-func (s *OrganizationMember) SetDateCreated(val OptDateTime) {
-	s.DateCreated = val
+// GetThreshold returns the value of Threshold.
+func (s *SuggestPeopleRequest) GetThreshold() OptFloat64 {
+	return s.Threshold
 }
 
-// SetDateUpdated sets the value of DateUpdated.
-func (s *OrganizationMember) SetDateUpdated(val OptDateTime) {
-	s.DateUpdated = val
+// GetHighlight returns the value of Highlight.
+func (s *SuggestPeopleRequest) GetHighlight() OptBool {
+	return s.Highlight
 }
 
-// Ref: #/components/schemas/OrganizationPagedListResponse
-type OrganizationPagedListResponse struct {
-	Cursor OptString      `json:"cursor"`
-	Data   []Organization `json:"data"`
+// SetLimit sets the value of Limit.
+func (s *SuggestPeopleRequest) SetLimit(val OptInt) {
+	s.Limit = val
 }
 
-// GetCursor returns the value of Cursor.
-func (s *OrganizationPagedListResponse) GetCursor() OptString {
-	return s.Cursor
+// SetQuery sets the value of Query.
+func (s *SuggestPeopleRequest) SetQuery(val string) {
+	s.Query = val
 }
 
-// GetData returns the value of Data.
-func (s *OrganizationPagedListResponse) GetData() []Organization {
-	return s.Data
+// SetActive sets the value of Active.
+func (s *SuggestPeopleRequest) SetActive(val []bool) {
+	s.Active = val
 }
 
-// SetCursor sets the value of Cursor.
-func (s *OrganizationPagedListResponse) SetCursor(val OptString) {
-	s.Cursor = val
+// SetThreshold sets the value of Threshold.
+func (s *SuggestPeopleRequest) SetThreshold(val OptFloat64) {
+	s.Threshold = val
 }
 
-// SetData sets the value of Data.
-func (s *OrganizationPagedListResponse) SetData(val []Organization) {
-	s.Data = val
+// SetHighlight sets the value of Highlight.
+func (s *SuggestPeopleRequest) SetHighlight(val OptBool) {
+	s.Highlight = val
 }
 
-// Ref: #/components/schemas/OrganizationParent
-type OrganizationParent struct {
-	ID          string      `json:"id"`
-	DateCreated OptDateTime `json:"date_created"`
-	DateUpdated OptDateTime `json:"date_updated"`
-	From        time.Time   `json:"from"`
-	Until       OptDateTime `json:"until"`
+// Remains of a deleted record, or of a record that was merged into record successor_id.
+// Ref: #/components/schemas/Tombstone
+type Tombstone struct {
+	ID          string          `json:"id"`
+	DateDeleted time.Time       `json:"date_deleted"`
+	Reason      TombstoneReason `json:"reason"`
+	SuccessorID OptString       `json:"successor_id"`
 }
 
 // GetID returns the value of ID.
-func (s *OrganizationParent) GetID() string {
+func (s *Tombstone) GetID() string {
 	return s.ID
 }
 
-// GetDateCreated returns the value of DateCreated.
-func (s *OrganizationParent) GetDateCreated() OptDateTime {
-	return s.DateCreated
-}
-
-// GetDateUpdated returns the value of DateUpdated.
-func (s *OrganizationParent) GetDateUpdated() OptDateTime {
-	return s.DateUpdated
+// GetDateDeleted returns the value of DateDeleted.
+func (s *Tombstone) GetDateDeleted() time.Time {
+	return s.DateDeleted
 }
 
-// GetFrom returns the value of From.
-func (s *OrganizationParent) GetFrom() time.Time {
-	return s.From
+// GetReason returns the value of Reason.
+func (s *Tombstone) GetReason() TombstoneReason {
+	return s.Reason
 }
 
-// GetUntil returns the value of Until.
-func (s *OrganizationParent) GetUntil() OptDateTime {
-	return s.Until
+// GetSuccessorID returns the value of SuccessorID.
+func (s *Tombstone) GetSuccessorID() OptString {
+	return s.SuccessorID
 }
 
 // SetID sets the value of ID.
-func (s *OrganizationParent) SetID(val string) {
+func (s *Tombstone) SetID(val string) {
 	s.ID = val
 }
 
-// SetDateCreated sets the value of DateCreated.
-func (s *OrganizationParent) SetDateCreated(val OptDateTime) {
-	s.DateCreated = val
+// SetDateDeleted sets the value of DateDeleted.
+func (s *Tombstone) SetDateDeleted(val time.Time) {
+	s.DateDeleted = val
 }
 
-// SetDateUpdated sets the value of DateUpdated.
-func (s *OrganizationParent) SetDateUpdated(val OptDateTime) {
-	s.DateUpdated = val
+// SetReason sets the value of Reason.
+func (s *Tombstone) SetReason(val TombstoneReason) {
+	s.Reason = val
 }
 
-// SetFrom sets the value of From.
-func (s *OrganizationParent) SetFrom(val time.Time) {
-	s.From = val
+// SetSuccessorID sets the value of SuccessorID.
+func (s *Tombstone) SetSuccessorID(val OptString) {
+	s.SuccessorID = val
 }
 
-// SetUntil sets the value of Until.
-func (s *OrganizationParent) SetUntil(val OptDateTime) {
-	s.Until = val
-}
+type TombstoneReason string
 
-// Ref: #/components/schemas/Person
-type Person struct {
-	ID                  OptString            `json:"id"`
-	Active              OptBool              `json:"active"`
-	DateCreated         OptDateTime          `json:"date_created"`
-	DateUpdated         OptDateTime          `json:"date_updated"`
-	Name                OptString            `json:"name"`
-	GivenName           OptString            `json:"given_name"`
-	FamilyName          OptString            `json:"family_name"`
-	Email               OptString            `json:"email"`
-	Token               OptStringMap         `json:"token"`
-	PreferredGivenName  OptString            `json:"preferred_given_name"`
-	PreferredFamilyName OptString            `json:"preferred_family_name"`
-	BirthDate           OptString            `json:"birth_date"`
-	HonorificPrefix     OptString            `json:"honorific_prefix"`
-	Identifier          []string             `json:"identifier"`
-	Organization        []OrganizationMember `json:"organization"`
-	JobCategory         []string             `json:"job_category"`
-	Role                []string             `json:"role"`
-	Settings            OptPersonSettings    `json:"settings"`
-	ObjectClass         []string             `json:"object_class"`
+const (
+	TombstoneReasonDeleted TombstoneReason = "deleted"
+	TombstoneReasonMerged  TombstoneReason = "merged"
+)
+
+// AllValues returns all TombstoneReason values.
+func (TombstoneReason) AllValues() []TombstoneReason {
+	return []TombstoneReason{
+		TombstoneReasonDeleted,
+		TombstoneReasonMerged,
+	}
 }
 
-// GetID returns the value of ID.
-func (s *Person) GetID() OptString {
-	return s.ID
+// MarshalText implements encoding.TextMarshaler.
+func (s TombstoneReason) MarshalText() ([]byte, error) {
+	switch s {
+	case TombstoneReasonDeleted:
+		return []byte(s), nil
+	case TombstoneReasonMerged:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetActive returns the value of Active.
-func (s *Person) GetActive() OptBool {
-	return s.Active
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TombstoneReason) UnmarshalText(data []byte) error {
+	switch TombstoneReason(data) {
+	case TombstoneReasonDeleted:
+		*s = TombstoneReasonDeleted
+		return nil
+	case TombstoneReasonMerged:
+		*s = TombstoneReasonMerged
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetDateCreated returns the value of DateCreated.
-func (s *Person) GetDateCreated() OptDateTime {
-	return s.DateCreated
+// Ref: #/components/schemas/UpdateOrganizationRequest
+type UpdateOrganizationRequest struct {
+	ID           string                                    `json:"id"`
+	UpdateMask   []UpdateOrganizationRequestUpdateMaskItem `json:"update_mask"`
+	Organization OrganizationPatch                         `json:"organization"`
+	// Only update when the stored record has this version. Fails with status 409 otherwise.
+	ExpectedVersion OptInt `json:"expected_version"`
 }
 
-// GetDateUpdated returns the value of DateUpdated.
-func (s *Person) GetDateUpdated() OptDateTime {
-	return s.DateUpdated
+// GetID returns the value of ID.
+func (s *UpdateOrganizationRequest) GetID() string {
+	return s.ID
 }
 
-// GetName returns the value of Name.
-func (s *Person) GetName() OptString {
-	return s.Name
+// GetUpdateMask returns the value of UpdateMask.
+func (s *UpdateOrganizationRequest) GetUpdateMask() []UpdateOrganizationRequestUpdateMaskItem {
+	return s.UpdateMask
 }
 
-// GetGivenName returns the value of GivenName.
-func (s *Person) GetGivenName() OptString {
-	return s.GivenName
+// GetOrganization returns the value of Organization.
+func (s *UpdateOrganizationRequest) GetOrganization() OrganizationPatch {
+	return s.Organization
 }
 
-// GetFamilyName returns the value of FamilyName.
-func (s *Person) GetFamilyName() OptString {
-	return s.FamilyName
+// GetExpectedVersion returns the value of ExpectedVersion.
+func (s *UpdateOrganizationRequest) GetExpectedVersion() OptInt {
+	return s.ExpectedVersion
 }
 
-// GetEmail returns the value of Email.
-func (s *Person) GetEmail() OptString {
-	return s.Email
+// SetID sets the value of ID.
+func (s *UpdateOrganizationRequest) SetID(val string) {
+	s.ID = val
 }
 
-// GetToken returns the value of Token.
-func (s *Person) GetToken() OptStringMap {
-	return s.Token
+// SetUpdateMask sets the value of UpdateMask.
+func (s *UpdateOrganizationRequest) SetUpdateMask(val []UpdateOrganizationRequestUpdateMaskItem) {
+	s.UpdateMask = val
 }
 
-// GetPreferredGivenName returns the value of PreferredGivenName.
-func (s *Person) GetPreferredGivenName() OptString {
-	return s.PreferredGivenName
+// SetOrganization sets the value of Organization.
+func (s *UpdateOrganizationRequest) SetOrganization(val OrganizationPatch) {
+	s.Organization = val
 }
 
-// GetPreferredFamilyName returns the value of PreferredFamilyName.
-func (s *Person) GetPreferredFamilyName() OptString {
-	return s.PreferredFamilyName
+// SetExpectedVersion sets the value of ExpectedVersion.
+func (s *UpdateOrganizationRequest) SetExpectedVersion(val OptInt) {
+	s.ExpectedVersion = val
 }
 
-// GetBirthDate returns the value of BirthDate.
-func (s *Person) GetBirthDate() OptString {
-	return s.BirthDate
+type UpdateOrganizationRequestUpdateMaskItem string
+
+const (
+	UpdateOrganizationRequestUpdateMaskItemType       UpdateOrganizationRequestUpdateMaskItem = "type"
+	UpdateOrganizationRequestUpdateMaskItemAcronym    UpdateOrganizationRequestUpdateMaskItem = "acronym"
+	UpdateOrganizationRequestUpdateMaskItemNameDut    UpdateOrganizationRequestUpdateMaskItem = "name_dut"
+	UpdateOrganizationRequestUpdateMaskItemNameEng    UpdateOrganizationRequestUpdateMaskItem = "name_eng"
+	UpdateOrganizationRequestUpdateMaskItemParent     UpdateOrganizationRequestUpdateMaskItem = "parent"
+	UpdateOrganizationRequestUpdateMaskItemSuccessor  UpdateOrganizationRequestUpdateMaskItem = "successor"
+	UpdateOrganizationRequestUpdateMaskItemIdentifier UpdateOrganizationRequestUpdateMaskItem = "identifier"
+	UpdateOrganizationRequestUpdateMaskItemFounded    UpdateOrganizationRequestUpdateMaskItem = "founded"
+	UpdateOrganizationRequestUpdateMaskItemDissolved  UpdateOrganizationRequestUpdateMaskItem = "dissolved"
+)
+
+// AllValues returns all UpdateOrganizationRequestUpdateMaskItem values.
+func (UpdateOrganizationRequestUpdateMaskItem) AllValues() []UpdateOrganizationRequestUpdateMaskItem {
+	return []UpdateOrganizationRequestUpdateMaskItem{
+		UpdateOrganizationRequestUpdateMaskItemType,
+		UpdateOrganizationRequestUpdateMaskItemAcronym,
+		UpdateOrganizationRequestUpdateMaskItemNameDut,
+		UpdateOrganizationRequestUpdateMaskItemNameEng,
+		UpdateOrganizationRequestUpdateMaskItemParent,
+		UpdateOrganizationRequestUpdateMaskItemSuccessor,
+		UpdateOrganizationRequestUpdateMaskItemIdentifier,
+		UpdateOrganizationRequestUpdateMaskItemFounded,
+		UpdateOrganizationRequestUpdateMaskItemDissolved,
+	}
 }
 
-// GetHonorificPrefix returns the value of HonorificPrefix.
-func (s *Person) GetHonorificPrefix() OptString {
-	return s.HonorificPrefix
+// MarshalText implements encoding.TextMarshaler.
+func (s UpdateOrganizationRequestUpdateMaskItem) MarshalText() ([]byte, error) {
+	switch s {
+	case UpdateOrganizationRequestUpdateMaskItemType:
+		return []byte(s), nil
+	case UpdateOrganizationRequestUpdateMaskItemAcronym:
+		return []byte(s), nil
+	case UpdateOrganizationRequestUpdateMaskItemNameDut:
+		return []byte(s), nil
+	case UpdateOrganizationRequestUpdateMaskItemNameEng:
+		return []byte(s), nil
+	case UpdateOrganizationRequestUpdateMaskItemParent:
+		return []byte(s), nil
+	case UpdateOrganizationRequestUpdateMaskItemSuccessor:
+		return []byte(s), nil
+	case UpdateOrganizationRequestUpdateMaskItemIdentifier:
+		return []byte(s), nil
+	case UpdateOrganizationRequestUpdateMaskItemFounded:
+		return []byte(s), nil
+	case UpdateOrganizationRequestUpdateMaskItemDissolved:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetIdentifier returns the value of Identifier.
-func (s *Person) GetIdentifier() []string {
-	return s.Identifier
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *UpdateOrganizationRequestUpdateMaskItem) UnmarshalText(data []byte) error {
+	switch UpdateOrganizationRequestUpdateMaskItem(data) {
+	case UpdateOrganizationRequestUpdateMaskItemType:
+		*s = UpdateOrganizationRequestUpdateMaskItemType
+		return nil
+	case UpdateOrganizationRequestUpdateMaskItemAcronym:
+		*s = UpdateOrganizationRequestUpdateMaskItemAcronym
+		return nil
+	case UpdateOrganizationRequestUpdateMaskItemNameDut:
+		*s = UpdateOrganizationRequestUpdateMaskItemNameDut
+		return nil
+	case UpdateOrganizationRequestUpdateMaskItemNameEng:
+		*s = UpdateOrganizationRequestUpdateMaskItemNameEng
+		return nil
+	case UpdateOrganizationRequestUpdateMaskItemParent:
+		*s = UpdateOrganizationRequestUpdateMaskItemParent
+		return nil
+	case UpdateOrganizationRequestUpdateMaskItemSuccessor:
+		*s = UpdateOrganizationRequestUpdateMaskItemSuccessor
+		return nil
+	case UpdateOrganizationRequestUpdateMaskItemIdentifier:
+		*s = UpdateOrganizationRequestUpdateMaskItemIdentifier
+		return nil
+	case UpdateOrganizationRequestUpdateMaskItemFounded:
+		*s = UpdateOrganizationRequestUpdateMaskItemFounded
+		return nil
+	case UpdateOrganizationRequestUpdateMaskItemDissolved:
+		*s = UpdateOrganizationRequestUpdateMaskItemDissolved
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetOrganization returns the value of Organization.
-func (s *Person) GetOrganization() []OrganizationMember {
-	return s.Organization
+// Ref: #/components/schemas/UpdatePersonRequest
+type UpdatePersonRequest struct {
+	ID         string                              `json:"id"`
+	UpdateMask []UpdatePersonRequestUpdateMaskItem `json:"update_mask"`
+	Person     PersonPatch                         `json:"person"`
+	// Only update when the stored record has this version. Fails with status 409 otherwise.
+	ExpectedVersion OptInt `json:"expected_version"`
 }
 
-// GetJobCategory returns the value of JobCategory.
-func (s *Person) GetJobCategory() []string {
-	return s.JobCategory
+// GetID returns the value of ID.
+func (s *UpdatePersonRequest) GetID() string {
+	return s.ID
 }
 
-// GetRole returns the value of Role.
-func (s *Person) GetRole() []string {
-	return s.Role
+// GetUpdateMask returns the value of UpdateMask.
+func (s *UpdatePersonRequest) GetUpdateMask() []UpdatePersonRequestUpdateMaskItem {
+	return s.UpdateMask
 }
 
-// GetSettings returns the value of Settings.
-func (s *Person) GetSettings() OptPersonSettings {
-	return s.Settings
+// GetPerson returns the value of Person.
+func (s *UpdatePersonRequest) GetPerson() PersonPatch {
+	return s.Person
 }
 
-// GetObjectClass returns the value of ObjectClass.
-func (s *Person) GetObjectClass() []string {
-	return s.ObjectClass
+// GetExpectedVersion returns the value of ExpectedVersion.
+func (s *UpdatePersonRequest) GetExpectedVersion() OptInt {
+	return s.ExpectedVersion
 }
 
 // SetID sets the value of ID.
-func (s *Person) SetID(val OptString) {
+func (s *UpdatePersonRequest) SetID(val string) {
 	s.ID = val
 }
 
-// SetActive sets the value of Active.
-func (s *Person) SetActive(val OptBool) {
-	s.Active = val
+// SetUpdateMask sets the value of UpdateMask.
+func (s *UpdatePersonRequest) SetUpdateMask(val []UpdatePersonRequestUpdateMaskItem) {
+	s.UpdateMask = val
 }
 
-// SetDateCreated sets the value of DateCreated.
-func (s *Person) SetDateCreated(val OptDateTime) {
-	s.DateCreated = val
+// SetPerson sets the value of Person.
+func (s *UpdatePersonRequest) SetPerson(val PersonPatch) {
+	s.Person = val
 }
 
-// SetDateUpdated sets the value of DateUpdated.
-func (s *Person) SetDateUpdated(val OptDateTime) {
-	s.DateUpdated = val
+// SetExpectedVersion sets the value of ExpectedVersion.
+func (s *UpdatePersonRequest) SetExpectedVersion(val OptInt) {
+	s.ExpectedVersion = val
 }
 
-// SetName sets the value of Name.
-func (s *Person) SetName(val OptString) {
-	s.Name = val
+type UpdatePersonRequestUpdateMaskItem string
+
+const (
+	UpdatePersonRequestUpdateMaskItemActive              UpdatePersonRequestUpdateMaskItem = "active"
+	UpdatePersonRequestUpdateMaskItemName                UpdatePersonRequestUpdateMaskItem = "name"
+	UpdatePersonRequestUpdateMaskItemGivenName           UpdatePersonRequestUpdateMaskItem = "given_name"
+	UpdatePersonRequestUpdateMaskItemFamilyName          UpdatePersonRequestUpdateMaskItem = "family_name"
+	UpdatePersonRequestUpdateMaskItemEmail               UpdatePersonRequestUpdateMaskItem = "email"
+	UpdatePersonRequestUpdateMaskItemToken               UpdatePersonRequestUpdateMaskItem = "token"
+	UpdatePersonRequestUpdateMaskItemPreferredGivenName  UpdatePersonRequestUpdateMaskItem = "preferred_given_name"
+	UpdatePersonRequestUpdateMaskItemPreferredFamilyName UpdatePersonRequestUpdateMaskItem = "preferred_family_name"
+	UpdatePersonRequestUpdateMaskItemBirthDate           UpdatePersonRequestUpdateMaskItem = "birth_date"
+	UpdatePersonRequestUpdateMaskItemHonorificPrefix     UpdatePersonRequestUpdateMaskItem = "honorific_prefix"
+	UpdatePersonRequestUpdateMaskItemIdentifier          UpdatePersonRequestUpdateMaskItem = "identifier"
+	UpdatePersonRequestUpdateMaskItemOrganization        UpdatePersonRequestUpdateMaskItem = "organization"
+	UpdatePersonRequestUpdateMaskItemJobCategory         UpdatePersonRequestUpdateMaskItem = "job_category"
+	UpdatePersonRequestUpdateMaskItemRole                UpdatePersonRequestUpdateMaskItem = "role"
+	UpdatePersonRequestUpdateMaskItemSettings            UpdatePersonRequestUpdateMaskItem = "settings"
+	UpdatePersonRequestUpdateMaskItemObjectClass         UpdatePersonRequestUpdateMaskItem = "object_class"
+)
+
+// AllValues returns all UpdatePersonRequestUpdateMaskItem values.
+func (UpdatePersonRequestUpdateMaskItem) AllValues() []UpdatePersonRequestUpdateMaskItem {
+	return []UpdatePersonRequestUpdateMaskItem{
+		UpdatePersonRequestUpdateMaskItemActive,
+		UpdatePersonRequestUpdateMaskItemName,
+		UpdatePersonRequestUpdateMaskItemGivenName,
+		UpdatePersonRequestUpdateMaskItemFamilyName,
+		UpdatePersonRequestUpdateMaskItemEmail,
+		UpdatePersonRequestUpdateMaskItemToken,
+		UpdatePersonRequestUpdateMaskItemPreferredGivenName,
+		UpdatePersonRequestUpdateMaskItemPreferredFamilyName,
+		UpdatePersonRequestUpdateMaskItemBirthDate,
+		UpdatePersonRequestUpdateMaskItemHonorificPrefix,
+		UpdatePersonRequestUpdateMaskItemIdentifier,
+		UpdatePersonRequestUpdateMaskItemOrganization,
+		UpdatePersonRequestUpdateMaskItemJobCategory,
+		UpdatePersonRequestUpdateMaskItemRole,
+		UpdatePersonRequestUpdateMaskItemSettings,
+		UpdatePersonRequestUpdateMaskItemObjectClass,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s UpdatePersonRequestUpdateMaskItem) MarshalText() ([]byte, error) {
+	switch s {
+	case UpdatePersonRequestUpdateMaskItemActive:
+		return []byte(s), nil
+	case UpdatePersonRequestUpdateMaskItemName:
+		return []byte(s), nil
+	case UpdatePersonRequestUpdateMaskItemGivenName:
+		return []byte(s), nil
+	case UpdatePersonRequestUpdateMaskItemFamilyName:
+		return []byte(s), nil
+	case UpdatePersonRequestUpdateMaskItemEmail:
+		return []byte(s), nil
+	case UpdatePersonRequestUpdateMaskItemToken:
+		return []byte(s), nil
+	case UpdatePersonRequestUpdateMaskItemPreferredGivenName:
+		return []byte(s), nil
+	case UpdatePersonRequestUpdateMaskItemPreferredFamilyName:
+		return []byte(s), nil
+	case UpdatePersonRequestUpdateMaskItemBirthDate:
+		return []byte(s), nil
+	case UpdatePersonRequestUpdateMaskItemHonorificPrefix:
+		return []byte(s), nil
+	case UpdatePersonRequestUpdateMaskItemIdentifier:
+		return []byte(s), nil
+	case UpdatePersonRequestUpdateMaskItemOrganization:
+		return []byte(s), nil
+	case UpdatePersonRequestUpdateMaskItemJobCategory:
+		return []byte(s), nil
+	case UpdatePersonRequestUpdateMaskItemRole:
+		return []byte(s), nil
+	case UpdatePersonRequestUpdateMaskItemSettings:
+		return []byte(s), nil
+	case UpdatePersonRequestUpdateMaskItemObjectClass:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetGivenName sets the value of GivenName.
-func (s *Person) SetGivenName(val OptString) {
-	s.GivenName = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *UpdatePersonRequestUpdateMaskItem) UnmarshalText(data []byte) error {
+	switch UpdatePersonRequestUpdateMaskItem(data) {
+	case UpdatePersonRequestUpdateMaskItemActive:
+		*s = UpdatePersonRequestUpdateMaskItemActive
+		return nil
+	case UpdatePersonRequestUpdateMaskItemName:
+		*s = UpdatePersonRequestUpdateMaskItemName
+		return nil
+	case UpdatePersonRequestUpdateMaskItemGivenName:
+		*s = UpdatePersonRequestUpdateMaskItemGivenName
+		return nil
+	case UpdatePersonRequestUpdateMaskItemFamilyName:
+		*s = UpdatePersonRequestUpdateMaskItemFamilyName
+		return nil
+	case UpdatePersonRequestUpdateMaskItemEmail:
+		*s = UpdatePersonRequestUpdateMaskItemEmail
+		return nil
+	case UpdatePersonRequestUpdateMaskItemToken:
+		*s = UpdatePersonRequestUpdateMaskItemToken
+		return nil
+	case UpdatePersonRequestUpdateMaskItemPreferredGivenName:
+		*s = UpdatePersonRequestUpdateMaskItemPreferredGivenName
+		return nil
+	case UpdatePersonRequestUpdateMaskItemPreferredFamilyName:
+		*s = UpdatePersonRequestUpdateMaskItemPreferredFamilyName
+		return nil
+	case UpdatePersonRequestUpdateMaskItemBirthDate:
+		*s = UpdatePersonRequestUpdateMaskItemBirthDate
+		return nil
+	case UpdatePersonRequestUpdateMaskItemHonorificPrefix:
+		*s = UpdatePersonRequestUpdateMaskItemHonorificPrefix
+		return nil
+	case UpdatePersonRequestUpdateMaskItemIdentifier:
+		*s = UpdatePersonRequestUpdateMaskItemIdentifier
+		return nil
+	case UpdatePersonRequestUpdateMaskItemOrganization:
+		*s = UpdatePersonRequestUpdateMaskItemOrganization
+		return nil
+	case UpdatePersonRequestUpdateMaskItemJobCategory:
+		*s = UpdatePersonRequestUpdateMaskItemJobCategory
+		return nil
+	case UpdatePersonRequestUpdateMaskItemRole:
+		*s = UpdatePersonRequestUpdateMaskItemRole
+		return nil
+	case UpdatePersonRequestUpdateMaskItemSettings:
+		*s = UpdatePersonRequestUpdateMaskItemSettings
+		return nil
+	case UpdatePersonRequestUpdateMaskItemObjectClass:
+		*s = UpdatePersonRequestUpdateMaskItemObjectClass
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetFamilyName sets the value of FamilyName.
-func (s *Person) SetFamilyName(val OptString) {
-	s.FamilyName = val
+// Ref: #/components/schemas/UpsertResponse
+type UpsertResponse struct {
+	Data []UpsertResult `json:"data"`
 }
 
-// SetEmail sets the value of Email.
-func (s *Person) SetEmail(val OptString) {
-	s.Email = val
+// GetData returns the value of Data.
+func (s *UpsertResponse) GetData() []UpsertResult {
+	return s.Data
 }
 
-// SetToken sets the value of Token.
-func (s *Person) SetToken(val OptStringMap) {
-	s.Token = val
+// SetData sets the value of Data.
+func (s *UpsertResponse) SetData(val []UpsertResult) {
+	s.Data = val
 }
 
-// SetPreferredGivenName sets the value of PreferredGivenName.
-func (s *Person) SetPreferredGivenName(val OptString) {
-	s.PreferredGivenName = val
+// Ref: #/components/schemas/UpsertResult
+type UpsertResult struct {
+	// Position of the record in the request.
+	Index  int                `json:"index"`
+	ID     OptString          `json:"id"`
+	Status UpsertResultStatus `json:"status"`
+	Error  OptString          `json:"error"`
 }
 
-// SetPreferredFamilyName sets the value of PreferredFamilyName.
-func (s *Person) SetPreferredFamilyName(val OptString) {
-	s.PreferredFamilyName = val
+// GetIndex returns the value of Index.
+func (s *UpsertResult) GetIndex() int {
+	return s.Index
 }
 
-// SetBirthDate sets the value of BirthDate.
-func (s *Person) SetBirthDate(val OptString) {
-	s.BirthDate = val
+// GetID returns the value of ID.
+func (s *UpsertResult) GetID() OptString {
+	return s.ID
 }
 
-// SetHonorificPrefix sets the value of HonorificPrefix.
-func (s *Person) SetHonorificPrefix(val OptString) {
-	s.HonorificPrefix = val
+// GetStatus returns the value of Status.
+func (s *UpsertResult) GetStatus() UpsertResultStatus {
+	return s.Status
 }
 
-// SetIdentifier sets the value of Identifier.
-func (s *Person) SetIdentifier(val []string) {
-	s.Identifier = val
+// GetError returns the value of Error.
+func (s *UpsertResult) GetError() OptString {
+	return s.Error
 }
 
-// SetOrganization sets the value of Organization.
-func (s *Person) SetOrganization(val []OrganizationMember) {
-	s.Organization = val
+// SetIndex sets the value of Index.
+func (s *UpsertResult) SetIndex(val int) {
+	s.Index = val
 }
 
-// SetJobCategory sets the value of JobCategory.
-func (s *Person) SetJobCategory(val []string) {
-	s.JobCategory = val
+// SetID sets the value of ID.
+func (s *UpsertResult) SetID(val OptString) {
+	s.ID = val
 }
 
-// SetRole sets the value of Role.
-func (s *Person) SetRole(val []string) {
-	s.Role = val
+// SetStatus sets the value of Status.
+func (s *UpsertResult) SetStatus(val UpsertResultStatus) {
+	s.Status = val
 }
 
-// SetSettings sets the value of Settings.
-func (s *Person) SetSettings(val OptPersonSettings) {
-	s.Settings = val
+// SetError sets the value of Error.
+func (s *UpsertResult) SetError(val OptString) {
+	s.Error = val
 }
 
-// SetObjectClass sets the value of ObjectClass.
-func (s *Person) SetObjectClass(val []string) {
-	s.ObjectClass = val
+type UpsertResultStatus string
+
+const (
+	UpsertResultStatusCreated   UpsertResultStatus = "created"
+	UpsertResultStatusUpdated   UpsertResultStatus = "updated"
+	UpsertResultStatusUnchanged UpsertResultStatus = "unchanged"
+	UpsertResultStatusError     UpsertResultStatus = "error"
+	UpsertResultStatusSkipped   UpsertResultStatus = "skipped"
+)
+
+// AllValues returns all UpsertResultStatus values.
+func (UpsertResultStatus) AllValues() []UpsertResultStatus {
+	return []UpsertResultStatus{
+		UpsertResultStatusCreated,
+		UpsertResultStatusUpdated,
+		UpsertResultStatusUnchanged,
+		UpsertResultStatusError,
+		UpsertResultStatusSkipped,
+	}
 }
 
-// Ref: #/components/schemas/PersonListResponse
-type PersonListResponse struct {
-	Data []Person `json:"data"`
+// MarshalText implements encoding.TextMarshaler.
+func (s UpsertResultStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case UpsertResultStatusCreated:
+		return []byte(s), nil
+	case UpsertResultStatusUpdated:
+		return []byte(s), nil
+	case UpsertResultStatusUnchanged:
+		return []byte(s), nil
+	case UpsertResultStatusError:
+		return []byte(s), nil
+	case UpsertResultStatusSkipped:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetData returns the value of Data.
-func (s *PersonListResponse) GetData() []Person {
-	return s.Data
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *UpsertResultStatus) UnmarshalText(data []byte) error {
+	switch UpsertResultStatus(data) {
+	case UpsertResultStatusCreated:
+		*s = UpsertResultStatusCreated
+		return nil
+	case UpsertResultStatusUpdated:
+		*s = UpsertResultStatusUpdated
+		return nil
+	case UpsertResultStatusUnchanged:
+		*s = UpsertResultStatusUnchanged
+		return nil
+	case UpsertResultStatusError:
+		*s = UpsertResultStatusError
+		return nil
+	case UpsertResultStatusSkipped:
+		*s = UpsertResultStatusSkipped
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetData sets the value of Data.
-func (s *PersonListResponse) SetData(val []Person) {
-	s.Data = val
+// Ref: #/components/schemas/WebhookAttempt
+type WebhookAttempt struct {
+	DateAttempted time.Time `json:"date_attempted"`
+	StatusCode    OptInt    `json:"status_code"`
+	Error         OptString `json:"error"`
+	DurationMs    int       `json:"duration_ms"`
 }
 
-// Ref: #/components/schemas/PersonPagedListResponse
-type PersonPagedListResponse struct {
-	Cursor OptString `json:"cursor"`
-	Data   []Person  `json:"data"`
+// GetDateAttempted returns the value of DateAttempted.
+func (s *WebhookAttempt) GetDateAttempted() time.Time {
+	return s.DateAttempted
 }
 
-// GetCursor returns the value of Cursor.
-func (s *PersonPagedListResponse) GetCursor() OptString {
-	return s.Cursor
+// GetStatusCode returns the value of StatusCode.
+func (s *WebhookAttempt) GetStatusCode() OptInt {
+	return s.StatusCode
 }
 
-// GetData returns the value of Data.
-func (s *PersonPagedListResponse) GetData() []Person {
-	return s.Data
+// GetError returns the value of Error.
+func (s *WebhookAttempt) GetError() OptString {
+	return s.Error
 }
 
-// SetCursor sets the value of Cursor.
-func (s *PersonPagedListResponse) SetCursor(val OptString) {
-	s.Cursor = val
+// GetDurationMs returns the value of DurationMs.
+func (s *WebhookAttempt) GetDurationMs() int {
+	return s.DurationMs
 }
 
-// SetData sets the value of Data.
-func (s *PersonPagedListResponse) SetData(val []Person) {
-	s.Data = val
+// SetDateAttempted sets the value of DateAttempted.
+func (s *WebhookAttempt) SetDateAttempted(val time.Time) {
+	s.DateAttempted = val
 }
 
-type PersonSettings map[string]string
+// SetStatusCode sets the value of StatusCode.
+func (s *WebhookAttempt) SetStatusCode(val OptInt) {
+	s.StatusCode = val
+}
 
-func (s *PersonSettings) init() PersonSettings {
-	m := *s
-	if m == nil {
-		m = map[string]string{}
-		*s = m
-	}
-	return m
+// SetError sets the value of Error.
+func (s *WebhookAttempt) SetError(val OptString) {
+	s.Error = val
 }
 
-// Ref: #/components/schemas/SetPersonOrcidRequest
-type SetPersonOrcidRequest struct {
-	ID    string `json:"id"`
-	Orcid string `json:"orcid"`
+// SetDurationMs sets the value of DurationMs.
+func (s *WebhookAttempt) SetDurationMs(val int) {
+	s.DurationMs = val
 }
 
-// GetID returns the value of ID.
-func (s *SetPersonOrcidRequest) GetID() string {
-	return s.ID
+// Ref: #/components/schemas/WebhookDelivery
+type WebhookDelivery struct {
+	EventID       string                `json:"event_id"`
+	EventType     string                `json:"event_type"`
+	DateCreated   time.Time             `json:"date_created"`
+	Status        WebhookDeliveryStatus `json:"status"`
+	Attempts      int                   `json:"attempts"`
+	NextAttempt   OptDateTime           `json:"next_attempt"`
+	DateDelivered OptDateTime           `json:"date_delivered"`
+	AttemptLog    []WebhookAttempt      `json:"attempt_log"`
 }
 
-// GetOrcid returns the value of Orcid.
-func (s *SetPersonOrcidRequest) GetOrcid() string {
-	return s.Orcid
+// GetEventID returns the value of EventID.
+func (s *WebhookDelivery) GetEventID() string {
+	return s.EventID
 }
 
-// SetID sets the value of ID.
-func (s *SetPersonOrcidRequest) SetID(val string) {
-	s.ID = val
+// GetEventType returns the value of EventType.
+func (s *WebhookDelivery) GetEventType() string {
+	return s.EventType
 }
 
-// SetOrcid sets the value of Orcid.
-func (s *SetPersonOrcidRequest) SetOrcid(val string) {
-	s.Orcid = val
+// GetDateCreated returns the value of DateCreated.
+func (s *WebhookDelivery) GetDateCreated() time.Time {
+	return s.DateCreated
 }
 
-// Ref: #/components/schemas/SetPersonRoleRequest
-type SetPersonRoleRequest struct {
-	ID   string   `json:"id"`
-	Role []string `json:"role"`
+// GetStatus returns the value of Status.
+func (s *WebhookDelivery) GetStatus() WebhookDeliveryStatus {
+	return s.Status
 }
 
-// GetID returns the value of ID.
-func (s *SetPersonRoleRequest) GetID() string {
-	return s.ID
+// GetAttempts returns the value of Attempts.
+func (s *WebhookDelivery) GetAttempts() int {
+	return s.Attempts
 }
 
-// GetRole returns the value of Role.
-func (s *SetPersonRoleRequest) GetRole() []string {
-	return s.Role
+// GetNextAttempt returns the value of NextAttempt.
+func (s *WebhookDelivery) GetNextAttempt() OptDateTime {
+	return s.NextAttempt
 }
 
-// SetID sets the value of ID.
-func (s *SetPersonRoleRequest) SetID(val string) {
-	s.ID = val
+// GetDateDelivered returns the value of DateDelivered.
+func (s *WebhookDelivery) GetDateDelivered() OptDateTime {
+	return s.DateDelivered
 }
 
-// SetRole sets the value of Role.
-func (s *SetPersonRoleRequest) SetRole(val []string) {
-	s.Role = val
+// GetAttemptLog returns the value of AttemptLog.
+func (s *WebhookDelivery) GetAttemptLog() []WebhookAttempt {
+	return s.AttemptLog
 }
 
-// Ref: #/components/schemas/SetPersonSettingsRequest
-type SetPersonSettingsRequest struct {
-	ID       string                           `json:"id"`
-	Settings SetPersonSettingsRequestSettings `json:"settings"`
+// SetEventID sets the value of EventID.
+func (s *WebhookDelivery) SetEventID(val string) {
+	s.EventID = val
 }
 
-// GetID returns the value of ID.
-func (s *SetPersonSettingsRequest) GetID() string {
-	return s.ID
+// SetEventType sets the value of EventType.
+func (s *WebhookDelivery) SetEventType(val string) {
+	s.EventType = val
 }
 
-// GetSettings returns the value of Settings.
-func (s *SetPersonSettingsRequest) GetSettings() SetPersonSettingsRequestSettings {
-	return s.Settings
+// SetDateCreated sets the value of DateCreated.
+func (s *WebhookDelivery) SetDateCreated(val time.Time) {
+	s.DateCreated = val
 }
 
-// SetID sets the value of ID.
-func (s *SetPersonSettingsRequest) SetID(val string) {
-	s.ID = val
+// SetStatus sets the value of Status.
+func (s *WebhookDelivery) SetStatus(val WebhookDeliveryStatus) {
+	s.Status = val
 }
 
-// SetSettings sets the value of Settings.
-func (s *SetPersonSettingsRequest) SetSettings(val SetPersonSettingsRequestSettings) {
-	s.Settings = val
+// SetAttempts sets the value of Attempts.
+func (s *WebhookDelivery) SetAttempts(val int) {
+	s.Attempts = val
 }
 
-type SetPersonSettingsRequestSettings map[string]string
+// SetNextAttempt sets the value of NextAttempt.
+func (s *WebhookDelivery) SetNextAttempt(val OptDateTime) {
+	s.NextAttempt = val
+}
 
-func (s *SetPersonSettingsRequestSettings) init() SetPersonSettingsRequestSettings {
-	m := *s
-	if m == nil {
-		m = map[string]string{}
-		*s = m
-	}
-	return m
+// SetDateDelivered sets the value of DateDelivered.
+func (s *WebhookDelivery) SetDateDelivered(val OptDateTime) {
+	s.DateDelivered = val
 }
 
-// Ref: #/components/schemas/SetPersonTokenRequest
-type SetPersonTokenRequest struct {
-	ID    string `json:"id"`
-	Type  string `json:"type"`
-	Token string `json:"token"`
+// SetAttemptLog sets the value of AttemptLog.
+func (s *WebhookDelivery) SetAttemptLog(val []WebhookAttempt) {
+	s.AttemptLog = val
 }
 
-// GetID returns the value of ID.
-func (s *SetPersonTokenRequest) GetID() string {
-	return s.ID
+// Ref: #/components/schemas/WebhookDeliveryListResponse
+type WebhookDeliveryListResponse struct {
+	Data []WebhookDelivery `json:"data"`
 }
 
-// GetType returns the value of Type.
-func (s *SetPersonTokenRequest) GetType() string {
-	return s.Type
+// GetData returns the value of Data.
+func (s *WebhookDeliveryListResponse) GetData() []WebhookDelivery {
+	return s.Data
 }
 
-// GetToken returns the value of Token.
-func (s *SetPersonTokenRequest) GetToken() string {
-	return s.Token
+// SetData sets the value of Data.
+func (s *WebhookDeliveryListResponse) SetData(val []WebhookDelivery) {
+	s.Data = val
 }
 
-// SetID sets the value of ID.
-func (s *SetPersonTokenRequest) SetID(val string) {
-	s.ID = val
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusInFlight  WebhookDeliveryStatus = "in_flight"
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "failed"
+)
+
+// AllValues returns all WebhookDeliveryStatus values.
+func (WebhookDeliveryStatus) AllValues() []WebhookDeliveryStatus {
+	return []WebhookDeliveryStatus{
+		WebhookDeliveryStatusPending,
+		WebhookDeliveryStatusInFlight,
+		WebhookDeliveryStatusDelivered,
+		WebhookDeliveryStatusFailed,
+	}
 }
 
-// SetType sets the value of Type.
-func (s *SetPersonTokenRequest) SetType(val string) {
-	s.Type = val
+// MarshalText implements encoding.TextMarshaler.
+func (s WebhookDeliveryStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case WebhookDeliveryStatusPending:
+		return []byte(s), nil
+	case WebhookDeliveryStatusInFlight:
+		return []byte(s), nil
+	case WebhookDeliveryStatusDelivered:
+		return []byte(s), nil
+	case WebhookDeliveryStatusFailed:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetToken sets the value of Token.
-func (s *SetPersonTokenRequest) SetToken(val string) {
-	s.Token = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *WebhookDeliveryStatus) UnmarshalText(data []byte) error {
+	switch WebhookDeliveryStatus(data) {
+	case WebhookDeliveryStatusPending:
+		*s = WebhookDeliveryStatusPending
+		return nil
+	case WebhookDeliveryStatusInFlight:
+		*s = WebhookDeliveryStatusInFlight
+		return nil
+	case WebhookDeliveryStatusDelivered:
+		*s = WebhookDeliveryStatusDelivered
+		return nil
+	case WebhookDeliveryStatusFailed:
+		*s = WebhookDeliveryStatusFailed
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// Ref: #/components/schemas/StringMap
-type StringMap map[string]string
+// Ref: #/components/schemas/WebhookSubscription
+type WebhookSubscription struct {
+	ID          string      `json:"id"`
+	DateCreated time.Time   `json:"date_created"`
+	DateUpdated time.Time   `json:"date_updated"`
+	URL         string      `json:"url"`
+	EventType   []EventType `json:"event_type"`
+}
 
-func (s *StringMap) init() StringMap {
-	m := *s
-	if m == nil {
-		m = map[string]string{}
-		*s = m
-	}
-	return m
+// GetID returns the value of ID.
+func (s *WebhookSubscription) GetID() string {
+	return s.ID
 }
 
-// Ref: #/components/schemas/SuggestOrganizationsRequest
-type SuggestOrganizationsRequest struct {
-	Limit OptInt `json:"limit"`
-	Query string `json:"query"`
+// GetDateCreated returns the value of DateCreated.
+func (s *WebhookSubscription) GetDateCreated() time.Time {
+	return s.DateCreated
 }
 
-// GetLimit returns the value of Limit.
-func (s *SuggestOrganizationsRequest) GetLimit() OptInt {
-	return s.Limit
+// GetDateUpdated returns the value of DateUpdated.
+func (s *WebhookSubscription) GetDateUpdated() time.Time {
+	return s.DateUpdated
 }
 
-// GetQuery returns the value of Query.
-func (s *SuggestOrganizationsRequest) GetQuery() string {
-	return s.Query
+// GetURL returns the value of URL.
+func (s *WebhookSubscription) GetURL() string {
+	return s.URL
 }
 
-// SetLimit sets the value of Limit.
-func (s *SuggestOrganizationsRequest) SetLimit(val OptInt) {
-	s.Limit = val
+// GetEventType returns the value of EventType.
+func (s *WebhookSubscription) GetEventType() []EventType {
+	return s.EventType
 }
 
-// SetQuery sets the value of Query.
-func (s *SuggestOrganizationsRequest) SetQuery(val string) {
-	s.Query = val
+// SetID sets the value of ID.
+func (s *WebhookSubscription) SetID(val string) {
+	s.ID = val
 }
 
-// Ref: #/components/schemas/SuggestPeopleRequest
-type SuggestPeopleRequest struct {
-	Limit  OptInt `json:"limit"`
-	Query  string `json:"query"`
-	Active []bool `json:"active"`
+// SetDateCreated sets the value of DateCreated.
+func (s *WebhookSubscription) SetDateCreated(val time.Time) {
+	s.DateCreated = val
 }
 
-// GetLimit returns the value of Limit.
-func (s *SuggestPeopleRequest) GetLimit() OptInt {
-	return s.Limit
+// SetDateUpdated sets the value of DateUpdated.
+func (s *WebhookSubscription) SetDateUpdated(val time.Time) {
+	s.DateUpdated = val
 }
 
-// GetQuery returns the value of Query.
-func (s *SuggestPeopleRequest) GetQuery() string {
-	return s.Query
+// SetURL sets the value of URL.
+func (s *WebhookSubscription) SetURL(val string) {
+	s.URL = val
 }
 
-// GetActive returns the value of Active.
-func (s *SuggestPeopleRequest) GetActive() []bool {
-	return s.Active
+// SetEventType sets the value of EventType.
+func (s *WebhookSubscription) SetEventType(val []EventType) {
+	s.EventType = val
 }
 
-// SetLimit sets the value of Limit.
-func (s *SuggestPeopleRequest) SetLimit(val OptInt) {
-	s.Limit = val
+// Ref: #/components/schemas/WebhookSubscriptionListResponse
+type WebhookSubscriptionListResponse struct {
+	Data []WebhookSubscription `json:"data"`
 }
 
-// SetQuery sets the value of Query.
-func (s *SuggestPeopleRequest) SetQuery(val string) {
-	s.Query = val
+// GetData returns the value of Data.
+func (s *WebhookSubscriptionListResponse) GetData() []WebhookSubscription {
+	return s.Data
 }
 
-// SetActive sets the value of Active.
-func (s *SuggestPeopleRequest) SetActive(val []bool) {
-	s.Active = val
+// SetData sets the value of Data.
+func (s *WebhookSubscriptionListResponse) SetData(val []WebhookSubscription) {
+	s.Data = val
 }
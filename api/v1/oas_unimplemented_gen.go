@@ -22,6 +22,40 @@ func (UnimplementedHandler) AddOrganization(ctx context.Context, req *Organizati
 	return r, ht.ErrNotImplemented
 }
 
+// AddOrganizations implements AddOrganizations operation.
+//
+// Insert/update multiple organization records, sent as a JSON array or as a stream of newline
+// delimited JSON records.
+// Each record is matched to a stored record on id (default) or on the identifier namespace in
+// match_on.
+// Records without a match are created, matched records are replaced.
+// A result is returned for every record.
+// Without transactional, every record is stored on its own and failing records do not affect the
+// others.
+// With transactional, either all records are stored or none at all.
+//
+// POST /add-organizations
+func (UnimplementedHandler) AddOrganizations(ctx context.Context, req AddOrganizationsReq, params AddOrganizationsParams) (r *UpsertResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AddPeople implements AddPeople operation.
+//
+// Insert/update multiple person records, sent as a JSON array or as a stream of newline delimited
+// JSON records.
+// Each record is matched to a stored record on id (default) or on the identifier namespace in
+// match_on.
+// Records without a match are created, matched records are replaced.
+// A result is returned for every record.
+// Without transactional, every record is stored on its own and failing records do not affect the
+// others.
+// With transactional, either all records are stored or none at all.
+//
+// POST /add-people
+func (UnimplementedHandler) AddPeople(ctx context.Context, req AddPeopleReq, params AddPeopleParams) (r *UpsertResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // AddPerson implements AddPerson operation.
 //
 // Insert/update a single person record.
@@ -31,18 +65,171 @@ func (UnimplementedHandler) AddPerson(ctx context.Context, req *Person) (r *Pers
 	return r, ht.ErrNotImplemented
 }
 
+// AddWebhookSubscription implements AddWebhookSubscription operation.
+//
+// Register a callback url that receives a POST request for every change event of one of event_type
+// (or of any type when event_type is empty).
+// The request body is the event as JSON. Every request carries the headers
+// X-People-Event (event type), X-People-Delivery (event id, identical for retries),
+// X-People-Timestamp (unix time) and X-People-Signature.
+// The signature is "sha256=" followed by the hex encoded HMAC-SHA256 of the timestamp, a dot and the
+// body,
+// keyed with secret.
+// Any response status other than 2xx counts as failure. Failed deliveries are retried with
+// exponential backoff.
+//
+// POST /add-webhook-subscription
+func (UnimplementedHandler) AddWebhookSubscription(ctx context.Context, req *AddWebhookSubscriptionRequest) (r *WebhookSubscription, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeleteOrganization implements DeleteOrganization operation.
+//
+// Delete a single organization record. Organizations that still have members or child organizations
+// are only deleted when cascade is set.
+//
+// POST /delete-organization
+func (UnimplementedHandler) DeleteOrganization(ctx context.Context, req *DeleteOrganizationRequest) error {
+	return ht.ErrNotImplemented
+}
+
+// DeletePerson implements DeletePerson operation.
+//
+// Delete a single person record.
+//
+// POST /delete-person
+func (UnimplementedHandler) DeletePerson(ctx context.Context, req *DeletePersonRequest) error {
+	return ht.ErrNotImplemented
+}
+
+// DeleteWebhookSubscription implements DeleteWebhookSubscription operation.
+//
+// Delete a webhook subscription and its delivery log.
+//
+// POST /delete-webhook-subscription
+func (UnimplementedHandler) DeleteWebhookSubscription(ctx context.Context, req *DeleteWebhookSubscriptionRequest) error {
+	return ht.ErrNotImplemented
+}
+
+// FindPersonDuplicates implements FindPersonDuplicates operation.
+//
+// Report pairs of person records that probably describe the same person, highest score first.
+// Candidate pairs share an identifier, an email address or a similar name.
+// Every pair is scored (0-1) on name similarity (unaccented and case insensitive), birth date,
+// email and shared identifiers; `reasons` explains the score.
+// `survivor_id` is the record to keep when the pair is passed to `/merge-people`:
+// the active record, or else the oldest.
+//
+// POST /find-person-duplicates
+func (UnimplementedHandler) FindPersonDuplicates(ctx context.Context, req *FindPersonDuplicatesRequest) (r *PersonDuplicatesResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetOrganization implements GetOrganization operation.
 //
 // Get single organization record.
+// When the record was deleted, status 410 is returned
+// with attribute tombstone describing what happened.
 //
 // POST /get-organization
 func (UnimplementedHandler) GetOrganization(ctx context.Context, req *GetOrganizationRequest) (r *Organization, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
+// GetOrganizationAncestors implements GetOrganizationAncestors operation.
+//
+// Get the organizations above an organization, nearest first.
+// Every node holds the path of organization ids from the given organization up to the node.
+// An organization with several parents is returned once per path.
+// Only parent relations that are valid at `as_of` (`from` <= `as_of` < `until`) are followed,
+// `as_of` defaults to now. `max_depth` limits the number of levels.
+//
+// POST /get-organization-ancestors
+func (UnimplementedHandler) GetOrganizationAncestors(ctx context.Context, req *GetOrganizationTreeRequest) (r *OrganizationTreeResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetOrganizationDescendants implements GetOrganizationDescendants operation.
+//
+// Get the organizations below an organization, nearest first.
+// Every node holds its parent and the path of organization ids from the given organization down to
+// the node.
+// An organization with several parents is returned once per path.
+// Only parent relations that are valid at `as_of` (`from` <= `as_of` < `until`) are followed,
+// `as_of` defaults to now. `max_depth` limits the number of levels.
+//
+// POST /get-organization-descendants
+func (UnimplementedHandler) GetOrganizationDescendants(ctx context.Context, req *GetOrganizationTreeRequest) (r *OrganizationTreeResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetOrganizationHistory implements GetOrganizationHistory operation.
+//
+// Retrieve all revisions of a single organization record, oldest first.
+// Every revision holds the record as it was after the change (except for deletions),
+// who made the change (actor), and the attributes that changed since the previous revision.
+// The history also covers records that were deleted since.
+//
+// POST /get-organization-history
+func (UnimplementedHandler) GetOrganizationHistory(ctx context.Context, req *GetOrganizationHistoryRequest) (r *OrganizationHistoryResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetOrganizationLineage implements GetOrganizationLineage operation.
+//
+// Follow the successor relations of an organization, or its predecessor relations with `direction`
+// predecessors.
+// The given organization is returned first with depth 0.
+// Every node holds the path of organization ids from the given organization to the node.
+// An organization reached along several paths is returned once per path.
+// Only relations that took effect at `as_of` (`date` <= `as_of`) are followed,
+// `as_of` defaults to now. `max_depth` limits the number of steps.
+// A node is `current` if its organization has no successor at `as_of`:
+// following successors, the current nodes are the organizations that replace the given organization.
+//
+// POST /get-organization-lineage
+func (UnimplementedHandler) GetOrganizationLineage(ctx context.Context, req *GetOrganizationLineageRequest) (r *OrganizationLineageResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetOrganizationMembers implements GetOrganizationMembers operation.
+//
+// Get the person records that are members of an organization, one page at a time.
+// Send the same request with the cursor of the previous page to get the next page.
+// With `include_descendants`, members of all organizations below it are returned too,
+// following parent relations valid at `as_of` (default now) only.
+// Only people with a membership valid at `as_of` (default now) are returned,
+// with `historic` people with a past or future membership are returned too. With `ids_only`, only
+// person ids are returned in attribute `ids`.
+//
+// POST /get-organization-members
+func (UnimplementedHandler) GetOrganizationMembers(ctx context.Context, req *GetOrganizationMembersRequest) (r *OrganizationMembersResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetOrganizationTree implements GetOrganizationTree operation.
+//
+// Get the roots above an organization (depth 0), with all organizations below them.
+// Every node holds its parent and the path of organization ids from its root down to the node.
+// Only parent relations that are valid at `as_of` (`from` <= `as_of` < `until`) are followed,
+// `as_of` defaults to now. `max_depth` limits the number of levels.
+//
+// POST /get-organization-tree
+func (UnimplementedHandler) GetOrganizationTree(ctx context.Context, req *GetOrganizationTreeRequest) (r *OrganizationTreeResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetOrganizations implements GetOrganizations operation.
 //
-// Get all organization records.
+// Get all organization records, one page at a time.
+// With updated_since, only organization records that changed since then are returned, in the order
+// in which they were committed,
+// and deleted organization records are returned as tombstones in attribute gone.
+// Changes are held back while an older transaction is still in progress, so that no change is ever
+// missed.
+// Every page with changes has a cursor; the feed ends with an empty page without cursor.
+// To continue a finished feed later, send the cursor of the last page with changes, together with
+// the same updated_since.
 //
 // POST /get-organizations
 func (UnimplementedHandler) GetOrganizations(ctx context.Context, req *GetOrganizationsRequest) (r *OrganizationPagedListResponse, _ error) {
@@ -52,6 +239,7 @@ func (UnimplementedHandler) GetOrganizations(ctx context.Context, req *GetOrgani
 // GetOrganizationsById implements GetOrganizationsById operation.
 //
 // Get organization records by their ids.
+// Requested records that were deleted are listed in attribute gone.
 //
 // POST /get-organizations-by-id
 func (UnimplementedHandler) GetOrganizationsById(ctx context.Context, req *GetOrganizationsByIdRequest) (r *OrganizationListResponse, _ error) {
@@ -69,7 +257,15 @@ func (UnimplementedHandler) GetOrganizationsByIdentifier(ctx context.Context, re
 
 // GetPeople implements GetPeople operation.
 //
-// Get all person records.
+// Get all person records, one page at a time.
+// With updated_since, only person records that changed since then are returned, in the order in
+// which they were committed,
+// and deleted person records are returned as tombstones in attribute gone.
+// Changes are held back while an older transaction is still in progress, so that no change is ever
+// missed.
+// Every page with changes has a cursor; the feed ends with an empty page without cursor.
+// To continue a finished feed later, send the cursor of the last page with changes, together with
+// the same updated_since.
 //
 // POST /get-people
 func (UnimplementedHandler) GetPeople(ctx context.Context, req *GetPeopleRequest) (r *PersonPagedListResponse, _ error) {
@@ -79,6 +275,7 @@ func (UnimplementedHandler) GetPeople(ctx context.Context, req *GetPeopleRequest
 // GetPeopleById implements GetPeopleById operation.
 //
 // Retrieve person records by their ids.
+// Requested records that were deleted or merged into another record are listed in attribute gone.
 //
 // POST /get-people-by-id
 func (UnimplementedHandler) GetPeopleById(ctx context.Context, req *GetPeopleByIdRequest) (r *PersonListResponse, _ error) {
@@ -97,12 +294,130 @@ func (UnimplementedHandler) GetPeopleByIdentifier(ctx context.Context, req *GetP
 // GetPerson implements GetPerson operation.
 //
 // Retrieve a single person record.
+// When the record was deleted or merged into another record, status 410 is returned
+// with attribute tombstone describing what happened.
 //
 // POST /get-person
 func (UnimplementedHandler) GetPerson(ctx context.Context, req *GetPersonRequest) (r *Person, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
+// GetPersonHistory implements GetPersonHistory operation.
+//
+// Retrieve all revisions of a single person record, oldest first.
+// Every revision holds the record as it was after the change (except for deletions),
+// who made the change (actor), and the attributes that changed since the previous revision.
+// The history also covers records that were deleted since.
+//
+// POST /get-person-history
+func (UnimplementedHandler) GetPersonHistory(ctx context.Context, req *GetPersonHistoryRequest) (r *PersonHistoryResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetWebhookDeliveries implements GetWebhookDeliveries operation.
+//
+// Retrieve the most recent deliveries of a webhook subscription, newest first, with every attempt.
+//
+// POST /get-webhook-deliveries
+func (UnimplementedHandler) GetWebhookDeliveries(ctx context.Context, req *GetWebhookDeliveriesRequest) (r *WebhookDeliveryListResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetWebhookSubscriptions implements GetWebhookSubscriptions operation.
+//
+// Retrieve all webhook subscriptions.
+//
+// POST /get-webhook-subscriptions
+func (UnimplementedHandler) GetWebhookSubscriptions(ctx context.Context, req *GetWebhookSubscriptionsRequest) (r *WebhookSubscriptionListResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// MergePeople implements MergePeople operation.
+//
+// Merge the person records in merge_id into the person record with id, and delete them afterwards.
+// Identifiers, organization memberships and roles of all records are combined.
+// Other attributes are taken from the surviving record.
+// When records hold a different orcid, token or setting, on_conflict decides the outcome:
+// keep the value of the surviving record (survivor), of the most recently updated record (newest),
+// or fail with status 409 (fail).
+//
+// POST /merge-people
+func (UnimplementedHandler) MergePeople(ctx context.Context, req *MergePeopleRequest) (r *Person, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RevertOrganization implements RevertOrganization operation.
+//
+// Revert organization records to an earlier revision.
+// Either pass `id` and `version` to restore a single record to the state of that revision,
+// or pass `actor_source` (and optionally `actor_id`), `from` and `until` to restore every record
+// that this actor changed within [from, until) to the state before its first change in that window.
+// Records that were created by the actor are deleted again, deleted records are recreated under
+// their old id.
+// Records that were changed by others after the window are skipped unless `force` is set.
+// Pass `dry_run` to see what would change without changing anything.
+// Every record is reverted in its own transaction; the revert itself shows up in the history as a
+// new revision.
+//
+// POST /revert-organization
+func (UnimplementedHandler) RevertOrganization(ctx context.Context, req *RevertRequest) (r *RevertResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RevertPerson implements RevertPerson operation.
+//
+// Revert person records to an earlier revision.
+// Either pass `id` and `version` to restore a single record to the state of that revision,
+// or pass `actor_source` (and optionally `actor_id`), `from` and `until` to restore every record
+// that this actor changed within [from, until) to the state before its first change in that window.
+// Records that were created by the actor are deleted again, deleted records are recreated under
+// their old id.
+// Records that were changed by others after the window are skipped unless `force` is set.
+// Records that were merged into another record are only recreated with `force`, as the surviving
+// record keeps their identifiers.
+// Tokens are not kept in revisions: recreated records have no tokens, and `warnings` reports tokens
+// that a revert deletes.
+// Pass `dry_run` to see what would change without changing anything.
+// Every record is reverted in its own transaction; the revert itself shows up in the history as a
+// new revision.
+//
+// POST /revert-person
+func (UnimplementedHandler) RevertPerson(ctx context.Context, req *RevertRequest) (r *RevertResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// SearchOrganizations implements SearchOrganizations operation.
+//
+// Search organization records. All filters are optional: values within a filter are combined with OR,
+// filters are combined with AND.
+// `ancestor` matches the whole subtree below the given organizations (the organizations themselves
+// excluded).
+// `current` only matches organizations without parent or with a parent relation that is valid now
+// (`from` <= now < `until`), and follows only current parent relations for `ancestor`.
+// The response holds one page of matching records, the total number of matching records,
+// and the number of matching records per type.
+// Records are ranked by relevance if a `query` is given, and sorted by `name` otherwise.
+//
+// POST /search-organizations
+func (UnimplementedHandler) SearchOrganizations(ctx context.Context, req *SearchOrganizationsRequest) (r *OrganizationSearchResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// SearchPeople implements SearchPeople operation.
+//
+// Search person records. All filters are optional: values within a filter are combined with OR,
+// filters are combined with AND.
+// The response holds one page of matching records, the total number of matching records,
+// and the value counts of every facet over all matching records (most frequent values first).
+// Sort by `relevance` requires a `query`; without `query` records are sorted by `name` by default.
+// The `organization` filter and facet only look at memberships valid at `as_of` (default now),
+// unless `historic` is set.
+//
+// POST /search-people
+func (UnimplementedHandler) SearchPeople(ctx context.Context, req *SearchPeopleRequest) (r *PersonSearchResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // SetPersonOrcid implements SetPersonOrcid operation.
 //
 // Update person ORCID.
@@ -157,6 +472,33 @@ func (UnimplementedHandler) SuggestPeople(ctx context.Context, req *SuggestPeopl
 	return r, ht.ErrNotImplemented
 }
 
+// UpdateOrganization implements UpdateOrganization operation.
+//
+// Change only some attributes of a single organization record.
+// Without update_mask, attribute organization is applied as a JSON Merge Patch (RFC 7396):
+// omitted attributes are left untouched and null removes an attribute.
+// With update_mask, only the listed attributes are copied from attribute organization.
+// Listed attributes that are omitted or null are cleared.
+//
+// POST /update-organization
+func (UnimplementedHandler) UpdateOrganization(ctx context.Context, req *UpdateOrganizationRequest) (r *Organization, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdatePerson implements UpdatePerson operation.
+//
+// Change only some attributes of a single person record.
+// Without update_mask, attribute person is applied as a JSON Merge Patch (RFC 7396):
+// omitted attributes are left untouched, null removes an attribute and the maps token and settings
+// are merged key by key.
+// With update_mask, only the listed attributes are copied from attribute person.
+// Listed attributes that are omitted or null are cleared.
+//
+// POST /update-person
+func (UnimplementedHandler) UpdatePerson(ctx context.Context, req *UpdatePersonRequest) (r *Person, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // NewError creates *ErrorStatusCode from error returned by handler.
 //
 // Used for common default response.
@@ -0,0 +1,159 @@
+package api
+
+import (
+	"slices"
+	"time"
+
+	"github.com/ugent-library/people-service/models"
+)
+
+// applyPersonPatch changes the attributes of person that are present in patch.
+//
+// When mask is empty, patch is treated as a JSON Merge Patch (RFC 7396):
+// attributes that are not set are left untouched, null clears an attribute
+// and the maps token and settings are merged key by key.
+//
+// When mask is not empty, only the attributes in mask are copied from patch.
+// Masked attributes that are not set or null are cleared.
+func applyPersonPatch(person *models.Person, patch *PersonPatch, mask []string) error {
+	has := func(field string, set bool) bool {
+		if len(mask) > 0 {
+			return slices.Contains(mask, field)
+		}
+		return set
+	}
+
+	if has("active", patch.Active.Set) {
+		person.Active = patch.Active.Value
+	}
+	if has("name", patch.Name.Set) {
+		person.Name = patch.Name.Value
+	}
+	if has("given_name", patch.GivenName.Set) {
+		person.GivenName = patch.GivenName.Value
+	}
+	if has("family_name", patch.FamilyName.Set) {
+		person.FamilyName = patch.FamilyName.Value
+	}
+	if has("email", patch.Email.Set) {
+		person.SetEmail(patch.Email.Value)
+	}
+	if has("preferred_given_name", patch.PreferredGivenName.Set) {
+		person.PreferredGivenName = patch.PreferredGivenName.Value
+	}
+	if has("preferred_family_name", patch.PreferredFamilyName.Set) {
+		person.PreferredFamilyName = patch.PreferredFamilyName.Value
+	}
+	if has("birth_date", patch.BirthDate.Set) {
+		person.BirthDate = patch.BirthDate.Value
+	}
+	if has("honorific_prefix", patch.HonorificPrefix.Set) {
+		person.HonorificPrefix = patch.HonorificPrefix.Value
+	}
+	if has("identifier", patch.Identifier.Set) {
+		ids, err := mapFromExternalIdentifiers(patch.Identifier.Value)
+		if err != nil {
+			return err
+		}
+		person.SetIdentifier(ids...)
+	}
+	if has("organization", patch.Organization.Set) {
+		person.SetOrganizationMember(mapFromExternalOrganizationMembers(patch.Organization.Value)...)
+	}
+	if has("job_category", patch.JobCategory.Set) {
+		person.SetJobCategory(patch.JobCategory.Value...)
+	}
+	if has("role", patch.Role.Set) {
+		person.SetRole(patch.Role.Value...)
+	}
+	if has("object_class", patch.ObjectClass.Set) {
+		person.SetObjectClass(patch.ObjectClass.Value...)
+	}
+	if has("token", patch.Token.Set) {
+		if len(mask) > 0 || patch.Token.Null || person.Token == nil {
+			person.ClearToken()
+		}
+		for typ, val := range patch.Token.Value {
+			if val.Null {
+				delete(person.Token, typ)
+			} else {
+				person.SetToken(typ, val.Value)
+			}
+		}
+	}
+	if has("settings", patch.Settings.Set) {
+		if len(mask) > 0 || patch.Settings.Null {
+			person.Settings = nil
+		}
+		for key, val := range patch.Settings.Value {
+			if val.Null {
+				delete(person.Settings, key)
+				continue
+			}
+			if person.Settings == nil {
+				person.Settings = map[string]string{}
+			}
+			person.Settings[key] = val.Value
+		}
+	}
+
+	return nil
+}
+
+// applyOrganizationPatch changes the attributes of org that are present in patch.
+// See applyPersonPatch for the meaning of mask.
+func applyOrganizationPatch(org *models.Organization, patch *OrganizationPatch, mask []string) error {
+	has := func(field string, set bool) bool {
+		if len(mask) > 0 {
+			return slices.Contains(mask, field)
+		}
+		return set
+	}
+
+	if has("type", patch.Type.Set) {
+		// an organization always has a type, clearing it restores the default
+		org.Type = patch.Type.Value
+		if org.Type == "" {
+			org.Type = models.DefaultOrganizationType
+		}
+	}
+	if has("acronym", patch.Acronym.Set) {
+		org.Acronym = patch.Acronym.Value
+	}
+	if has("name_dut", patch.NameDut.Set) {
+		org.NameDut = patch.NameDut.Value
+	}
+	if has("name_eng", patch.NameEng.Set) {
+		org.NameEng = patch.NameEng.Value
+	}
+	if has("parent", patch.Parent.Set) {
+		org.SetParent(mapFromExternalOrganizationParents(patch.Parent.Value)...)
+	}
+	if has("successor", patch.Successor.Set) {
+		org.SetSuccessor(mapFromExternalOrganizationSuccessors(patch.Successor.Value)...)
+	}
+	if has("identifier", patch.Identifier.Set) {
+		ids, err := mapFromExternalIdentifiers(patch.Identifier.Value)
+		if err != nil {
+			return err
+		}
+		org.SetIdentifier(ids...)
+	}
+	if has("founded", patch.Founded.Set) {
+		org.Founded = nilDateTime(patch.Founded)
+	}
+	if has("dissolved", patch.Dissolved.Set) {
+		org.Dissolved = nilDateTime(patch.Dissolved)
+	}
+
+	return nil
+}
+
+// nilDateTime returns nil for a missing or null value
+func nilDateTime(v OptNilDateTime) *time.Time {
+	if !v.Set || v.Null {
+		return nil
+	}
+	t := v.Value
+	return &t
+}
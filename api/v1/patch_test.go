@@ -0,0 +1,207 @@
+package api
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ugent-library/people-service/models"
+)
+
+func TestApplyPersonPatch(t *testing.T) {
+	newPerson := func() *models.Person {
+		p := models.NewPerson()
+		p.Name = "Jane Doe"
+		p.GivenName = "Jane"
+		p.Email = "jane@example.org"
+		p.SetIdentifier(models.NewURN("ugent_id", "1"))
+		p.SetRole("admin")
+		p.Token = map[string]string{"biblio": "a", "orcid": "b"}
+		p.Settings = map[string]string{"lang": "nl", "theme": "dark"}
+		return p
+	}
+
+	tests := []struct {
+		name  string
+		patch string
+		mask  []string
+		want  func(*models.Person)
+		err   error
+	}{
+		{
+			name:  "empty patch changes nothing",
+			patch: `{}`,
+			want:  func(p *models.Person) {},
+		},
+		{
+			name:  "sets present attributes only",
+			patch: `{"given_name": "John", "role": ["curator", "admin"]}`,
+			want: func(p *models.Person) {
+				p.GivenName = "John"
+				p.Role = []string{"admin", "curator"}
+			},
+		},
+		{
+			name:  "null clears an attribute",
+			patch: `{"email": null, "identifier": null}`,
+			want: func(p *models.Person) {
+				p.Email = ""
+				p.Identifier = []*models.URN{}
+			},
+		},
+		{
+			name:  "merges maps key by key",
+			patch: `{"token": {"orcid": null, "scopus": "c"}, "settings": {"theme": "light"}}`,
+			want: func(p *models.Person) {
+				p.Token = map[string]string{"biblio": "a", "scopus": "c"}
+				p.Settings = map[string]string{"lang": "nl", "theme": "light"}
+			},
+		},
+		{
+			name:  "null clears a map",
+			patch: `{"token": null, "settings": null}`,
+			want: func(p *models.Person) {
+				p.Token = map[string]string{}
+				p.Settings = nil
+			},
+		},
+		{
+			name:  "mask copies only masked attributes",
+			patch: `{"given_name": "John", "name": "John Doe"}`,
+			mask:  []string{"name"},
+			want: func(p *models.Person) {
+				p.Name = "John Doe"
+			},
+		},
+		{
+			name:  "mask clears masked attributes that are missing",
+			patch: `{}`,
+			mask:  []string{"email", "role"},
+			want: func(p *models.Person) {
+				p.Email = ""
+				p.Role = nil
+			},
+		},
+		{
+			name:  "mask replaces maps",
+			patch: `{"settings": {"theme": "light"}}`,
+			mask:  []string{"settings"},
+			want: func(p *models.Person) {
+				p.Settings = map[string]string{"theme": "light"}
+			},
+		},
+		{
+			name:  "rejects an invalid identifier",
+			patch: `{"identifier": ["ugent_id"]}`,
+			err:   models.ErrInvalidURN,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patch := &PersonPatch{}
+			if err := patch.UnmarshalJSON([]byte(tt.patch)); err != nil {
+				t.Fatalf("invalid patch: %s", err)
+			}
+
+			person := newPerson()
+			err := applyPersonPatch(person, patch, tt.mask)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("got error %v, want %v", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			want := newPerson()
+			tt.want(want)
+			if !reflect.DeepEqual(person, want) {
+				t.Errorf("got %+v, want %+v", person, want)
+			}
+		})
+	}
+}
+
+func TestApplyOrganizationPatch(t *testing.T) {
+	founded := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	dissolved := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	newOrganization := func() *models.Organization {
+		org := models.NewOrganization()
+		org.Type = "department"
+		org.Acronym = "TW"
+		org.NameEng = "Engineering"
+		org.Founded = &founded
+		org.SetIdentifier(models.NewURN("ugent_id", "TW"))
+		return org
+	}
+
+	tests := []struct {
+		name  string
+		patch string
+		mask  []string
+		want  func(*models.Organization)
+	}{
+		{
+			name:  "empty patch changes nothing",
+			patch: `{}`,
+			want:  func(org *models.Organization) {},
+		},
+		{
+			name:  "sets present attributes only",
+			patch: `{"name_dut": "Ingenieurswetenschappen", "dissolved": "2020-01-01T00:00:00Z"}`,
+			want: func(org *models.Organization) {
+				org.NameDut = "Ingenieurswetenschappen"
+				org.Dissolved = &dissolved
+			},
+		},
+		{
+			name:  "null clears an attribute",
+			patch: `{"acronym": null, "founded": null}`,
+			want: func(org *models.Organization) {
+				org.Acronym = ""
+				org.Founded = nil
+			},
+		},
+		{
+			name:  "null restores the default type",
+			patch: `{"type": null}`,
+			want: func(org *models.Organization) {
+				org.Type = models.DefaultOrganizationType
+			},
+		},
+		{
+			name:  "mask clears masked attributes that are missing",
+			patch: `{"acronym": "EA"}`,
+			mask:  []string{"type", "identifier"},
+			want: func(org *models.Organization) {
+				org.Type = models.DefaultOrganizationType
+				org.Identifier = []*models.URN{}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patch := &OrganizationPatch{}
+			if err := patch.UnmarshalJSON([]byte(tt.patch)); err != nil {
+				t.Fatalf("invalid patch: %s", err)
+			}
+
+			org := newOrganization()
+			if err := applyOrganizationPatch(org, patch, tt.mask); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			want := newOrganization()
+			tt.want(want)
+			if !reflect.DeepEqual(org, want) {
+				t.Errorf("got %+v, want %+v", org, want)
+			}
+		})
+	}
+}
@@ -1,23 +1,55 @@
 package api
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"time"
 
+	"github.com/go-faster/jx"
 	"github.com/ugent-library/people-service/models"
 )
 
+const maxNDJSONLineSize = 1024 * 1024
+
 type Service struct {
-	repository models.Repository
+	repository                 models.Repository
+	personSuggestService       models.PersonSuggestService
+	organizationSuggestService models.OrganizationSuggestService
+}
+
+type ServiceOption func(*Service)
+
+// WithPersonSuggestService answers suggest-people from svc instead of the repository
+func WithPersonSuggestService(svc models.PersonSuggestService) ServiceOption {
+	return func(s *Service) {
+		s.personSuggestService = svc
+	}
 }
 
-func NewService(repository models.Repository) *Service {
-	return &Service{
-		repository: repository,
+// WithOrganizationSuggestService answers suggest-organizations from svc instead of the repository
+func WithOrganizationSuggestService(svc models.OrganizationSuggestService) ServiceOption {
+	return func(s *Service) {
+		s.organizationSuggestService = svc
 	}
 }
 
+func NewService(repository models.Repository, opts ...ServiceOption) *Service {
+	s := &Service{
+		repository:                 repository,
+		personSuggestService:       repository,
+		organizationSuggestService: repository,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
 func (s *Service) GetPerson(ctx context.Context, req *GetPersonRequest) (*Person, error) {
 	person, err := s.repository.GetPerson(ctx, req.ID)
 	if err != nil {
@@ -37,6 +69,132 @@ func (s *Service) GetPeopleById(ctx context.Context, req *GetPeopleByIdRequest)
 	for _, person := range people {
 		res.Data = append(res.Data, *mapToExternalPerson(person))
 	}
+	tombstones, err := s.repository.GetPersonTombstones(ctx, req.ID...)
+	if err != nil {
+		return nil, err
+	}
+	for _, tombstone := range tombstones {
+		res.Gone = append(res.Gone, *mapToExternalTombstone(tombstone))
+	}
+	return res, nil
+}
+
+func (s *Service) GetPersonHistory(ctx context.Context, req *GetPersonHistoryRequest) (*PersonHistoryResponse, error) {
+	revisions, err := s.repository.GetPersonRevisions(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	// records that did not change since revisions were introduced have no history
+	if len(revisions) == 0 {
+		if _, err := s.repository.GetPerson(ctx, req.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	res := &PersonHistoryResponse{
+		Data: make([]PersonRevision, 0, len(revisions)),
+	}
+	for i, rev := range revisions {
+		r := PersonRevision{
+			Version:     rev.Version,
+			DateCreated: *rev.DateCreated,
+			Type:        EventType(rev.Type),
+			Actor:       mapToExternalActor(rev.Actor),
+		}
+		if len(rev.Data) > 0 {
+			person := &models.Person{}
+			if err := json.Unmarshal(rev.Data, person); err != nil {
+				return nil, err
+			}
+			r.Person = NewOptPerson(*mapToExternalPerson(person))
+		}
+		if r.Changes, err = mapToExternalFieldChanges(revisions, i); err != nil {
+			return nil, err
+		}
+		res.Data = append(res.Data, r)
+	}
+
+	return res, nil
+}
+
+func (s *Service) GetOrganizationHistory(ctx context.Context, req *GetOrganizationHistoryRequest) (*OrganizationHistoryResponse, error) {
+	revisions, err := s.repository.GetOrganizationRevisions(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	// records that did not change since revisions were introduced have no history
+	if len(revisions) == 0 {
+		if _, err := s.repository.GetOrganization(ctx, req.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	res := &OrganizationHistoryResponse{
+		Data: make([]OrganizationRevision, 0, len(revisions)),
+	}
+	for i, rev := range revisions {
+		r := OrganizationRevision{
+			Version:     rev.Version,
+			DateCreated: *rev.DateCreated,
+			Type:        EventType(rev.Type),
+			Actor:       mapToExternalActor(rev.Actor),
+		}
+		if len(rev.Data) > 0 {
+			org := &models.Organization{}
+			if err := json.Unmarshal(rev.Data, org); err != nil {
+				return nil, err
+			}
+			r.Organization = NewOptOrganization(*mapToExternalOrganization(org))
+		}
+		if r.Changes, err = mapToExternalFieldChanges(revisions, i); err != nil {
+			return nil, err
+		}
+		res.Data = append(res.Data, r)
+	}
+
+	return res, nil
+}
+
+func (s *Service) RevertPerson(ctx context.Context, req *RevertRequest) (*RevertResponse, error) {
+	params := mapFromExternalRevertRequest(req)
+	results, err := s.repository.RevertPeople(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return mapToExternalRevertResponse(params, results), nil
+}
+
+func (s *Service) RevertOrganization(ctx context.Context, req *RevertRequest) (*RevertResponse, error) {
+	params := mapFromExternalRevertRequest(req)
+	results, err := s.repository.RevertOrganizations(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return mapToExternalRevertResponse(params, results), nil
+}
+
+func (s *Service) FindPersonDuplicates(ctx context.Context, req *FindPersonDuplicatesRequest) (*PersonDuplicatesResponse, error) {
+	duplicates, err := s.repository.FindPersonDuplicates(ctx, models.DuplicateParams{
+		MinScore:      req.MinScore.Value,
+		NameThreshold: req.NameThreshold.Value,
+		Limit:         req.Limit.Value,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &PersonDuplicatesResponse{
+		Data: make([]PersonDuplicate, 0, len(duplicates)),
+	}
+	for _, d := range duplicates {
+		res.Data = append(res.Data, PersonDuplicate{
+			SurvivorID:  d.SurvivorID,
+			DuplicateID: d.DuplicateID,
+			Score:       d.Score,
+			Reasons:     d.Reasons,
+		})
+	}
+
 	return res, nil
 }
 
@@ -65,9 +223,16 @@ func (s *Service) GetPeopleByIdentifier(ctx context.Context, req *GetPeopleByIde
 func (s *Service) GetPeople(ctx context.Context, req *GetPeopleRequest) (*PersonPagedListResponse, error) {
 	var people []*models.Person
 	var err error
+	var tombstones []*models.Tombstone
 	var cursor string
 
-	if req.Cursor.Value != "" {
+	if req.UpdatedSince.Set {
+		if req.Cursor.Value != "" {
+			people, tombstones, cursor, err = s.repository.GetMorePeopleChanges(ctx, req.UpdatedSince.Value, req.Cursor.Value)
+		} else {
+			people, tombstones, cursor, err = s.repository.GetPeopleChanges(ctx, req.UpdatedSince.Value)
+		}
+	} else if req.Cursor.Value != "" {
 		people, cursor, err = s.repository.GetMorePeople(ctx, req.Cursor.Value)
 	} else {
 		people, cursor, err = s.repository.GetPeople(ctx)
@@ -86,14 +251,20 @@ func (s *Service) GetPeople(ctx context.Context, req *GetPeopleRequest) (*Person
 		res.Data = append(res.Data, *mapToExternalPerson(person))
 	}
 
+	for _, tombstone := range tombstones {
+		res.Gone = append(res.Gone, *mapToExternalTombstone(tombstone))
+	}
+
 	return res, nil
 }
 
 func (s *Service) SuggestPeople(ctx context.Context, req *SuggestPeopleRequest) (*PersonListResponse, error) {
-	people, err := s.repository.SuggestPeople(ctx, models.PersonSuggestParams{
-		Query:  req.Query,
-		Active: req.Active,
-		Limit:  uint32(req.Limit.Value),
+	people, matches, err := s.personSuggestService.SuggestPeople(ctx, models.PersonSuggestParams{
+		Query:     req.Query,
+		Active:    req.Active,
+		Limit:     uint32(req.Limit.Value),
+		Threshold: req.Threshold.Value,
+		Highlight: req.Highlight.Value,
 	})
 	if err != nil {
 		return nil, err
@@ -105,12 +276,62 @@ func (s *Service) SuggestPeople(ctx context.Context, req *SuggestPeopleRequest)
 	for _, person := range people {
 		res.Data = append(res.Data, *mapToExternalPerson(person))
 	}
+	if req.Highlight.Value {
+		res.Matches = mapToExternalMatches(matches)
+	}
+
+	return res, nil
+}
+
+func (s *Service) SearchPeople(ctx context.Context, req *SearchPeopleRequest) (*PersonSearchResponse, error) {
+	params := models.PersonSearchParams{
+		Query:                   req.Query.Value,
+		Organization:            req.Organization,
+		IncludeSubOrganizations: req.IncludeSubOrganizations.Value,
+		Historic:                req.Historic.Value,
+		JobCategory:             req.JobCategory,
+		Role:                    req.Role,
+		ObjectClass:             req.ObjectClass,
+		IdentifierNamespace:     req.IdentifierNamespace,
+		Active:                  req.Active,
+		Sort:                    string(req.Sort.Value),
+		Offset:                  uint32(req.Offset.Value),
+		Limit:                   uint32(req.Limit.Value),
+		FacetLimit:              uint32(req.FacetLimit.Value),
+	}
+	if req.AsOf.Set {
+		asOf := req.AsOf.Value
+		params.AsOf = &asOf
+	}
+
+	result, err := s.repository.SearchPeople(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &PersonSearchResponse{
+		Total:  result.Total,
+		Offset: int(result.Offset),
+		Limit:  int(result.Limit),
+		Data:   make([]Person, 0, len(result.Hits)),
+		Facets: PersonFacets{
+			Organization:        mapToExternalFacetValues(result.Facets[models.FacetOrganization]),
+			JobCategory:         mapToExternalFacetValues(result.Facets[models.FacetJobCategory]),
+			Role:                mapToExternalFacetValues(result.Facets[models.FacetRole]),
+			ObjectClass:         mapToExternalFacetValues(result.Facets[models.FacetObjectClass]),
+			IdentifierNamespace: mapToExternalFacetValues(result.Facets[models.FacetIdentifierNamespace]),
+			Active:              mapToExternalFacetValues(result.Facets[models.FacetActive]),
+		},
+	}
+	for _, person := range result.Hits {
+		res.Data = append(res.Data, *mapToExternalPerson(person))
+	}
 
 	return res, nil
 }
 
 func (s *Service) SetPersonOrcid(ctx context.Context, req *SetPersonOrcidRequest) (*Person, error) {
-	if err := s.repository.SetPersonOrcid(ctx, req.ID, req.Orcid); err != nil {
+	if err := s.repository.SetPersonOrcid(ctx, req.ID, req.Orcid, req.ExpectedVersion.Value); err != nil {
 		return nil, err
 	}
 	person, err := s.repository.GetPerson(ctx, req.ID)
@@ -121,7 +342,7 @@ func (s *Service) SetPersonOrcid(ctx context.Context, req *SetPersonOrcidRequest
 }
 
 func (s *Service) SetPersonToken(ctx context.Context, req *SetPersonTokenRequest) (*Person, error) {
-	if err := s.repository.SetPersonToken(ctx, req.ID, req.Type, req.Token); err != nil {
+	if err := s.repository.SetPersonToken(ctx, req.ID, req.Type, req.Token, req.ExpectedVersion.Value); err != nil {
 		return nil, err
 	}
 	person, err := s.repository.GetPerson(ctx, req.ID)
@@ -132,7 +353,7 @@ func (s *Service) SetPersonToken(ctx context.Context, req *SetPersonTokenRequest
 }
 
 func (s *Service) SetPersonRole(ctx context.Context, req *SetPersonRoleRequest) (*Person, error) {
-	if err := s.repository.SetPersonRole(ctx, req.ID, req.Role); err != nil {
+	if err := s.repository.SetPersonRole(ctx, req.ID, req.Role, req.ExpectedVersion.Value); err != nil {
 		return nil, err
 	}
 	person, err := s.repository.GetPerson(ctx, req.ID)
@@ -146,7 +367,7 @@ func (s *Service) SetPersonSettings(ctx context.Context, req *SetPersonSettingsR
 	if req.Settings == nil {
 		return nil, fmt.Errorf("%w: attribute settings is missing in request body", models.ErrMissingArgument)
 	}
-	if err := s.repository.SetPersonSettings(ctx, req.ID, req.Settings); err != nil {
+	if err := s.repository.SetPersonSettings(ctx, req.ID, req.Settings, req.ExpectedVersion.Value); err != nil {
 		return nil, err
 	}
 	person, err := s.repository.GetPerson(ctx, req.ID)
@@ -156,6 +377,21 @@ func (s *Service) SetPersonSettings(ctx context.Context, req *SetPersonSettingsR
 	return mapToExternalPerson(person), nil
 }
 
+func (s *Service) MergePeople(ctx context.Context, req *MergePeopleRequest) (*Person, error) {
+	person, err := s.repository.MergePeople(ctx, req.ID, req.MergeID, models.MergeParams{
+		OnConflict: string(req.OnConflict.Value),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapToExternalPerson(person), nil
+}
+
+func (s *Service) DeletePerson(ctx context.Context, req *DeletePersonRequest) error {
+	return s.repository.DeletePerson(ctx, req.ID)
+}
+
 func (s *Service) GetOrganization(ctx context.Context, req *GetOrganizationRequest) (*Organization, error) {
 	org, err := s.repository.GetOrganization(ctx, req.ID)
 	if err != nil {
@@ -165,6 +401,82 @@ func (s *Service) GetOrganization(ctx context.Context, req *GetOrganizationReque
 	return mapToExternalOrganization(org), nil
 }
 
+func (s *Service) GetOrganizationAncestors(ctx context.Context, req *GetOrganizationTreeRequest) (*OrganizationTreeResponse, error) {
+	nodes, err := s.repository.GetOrganizationAncestors(ctx, mapFromExternalOrganizationTreeRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return mapToExternalOrganizationTreeResponse(nodes), nil
+}
+
+func (s *Service) GetOrganizationDescendants(ctx context.Context, req *GetOrganizationTreeRequest) (*OrganizationTreeResponse, error) {
+	nodes, err := s.repository.GetOrganizationDescendants(ctx, mapFromExternalOrganizationTreeRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return mapToExternalOrganizationTreeResponse(nodes), nil
+}
+
+func (s *Service) GetOrganizationTree(ctx context.Context, req *GetOrganizationTreeRequest) (*OrganizationTreeResponse, error) {
+	nodes, err := s.repository.GetOrganizationTree(ctx, mapFromExternalOrganizationTreeRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return mapToExternalOrganizationTreeResponse(nodes), nil
+}
+
+func (s *Service) GetOrganizationLineage(ctx context.Context, req *GetOrganizationLineageRequest) (*OrganizationLineageResponse, error) {
+	nodes, err := s.repository.GetOrganizationLineage(ctx, models.OrganizationLineageParams{
+		ID:        req.ID,
+		Direction: string(req.Direction.Value),
+		AsOf:      req.AsOf.Value,
+		MaxDepth:  req.MaxDepth.Value,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mapToExternalOrganizationLineageResponse(nodes), nil
+}
+
+func (s *Service) GetOrganizationMembers(ctx context.Context, req *GetOrganizationMembersRequest) (*OrganizationMembersResponse, error) {
+	params := models.OrganizationMembersParams{
+		ID:                 req.ID,
+		IncludeDescendants: req.IncludeDescendants.Value,
+		Historic:           req.Historic.Value,
+		Active:             req.Active,
+		IDsOnly:            req.IdsOnly.Value,
+		Limit:              req.Limit.Value,
+	}
+	if req.AsOf.Set {
+		asOf := req.AsOf.Value
+		params.AsOf = &asOf
+	}
+
+	var result *models.OrganizationMembersResult
+	var err error
+	if req.Cursor.Value != "" {
+		result, err = s.repository.GetMoreOrganizationMembers(ctx, params, req.Cursor.Value)
+	} else {
+		result, err = s.repository.GetOrganizationMembers(ctx, params)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := &OrganizationMembersResponse{
+		Data: make([]Person, 0, len(result.People)),
+		Ids:  result.IDs,
+	}
+	if result.Cursor != "" {
+		res.Cursor = NewOptString(result.Cursor)
+	}
+	for _, person := range result.People {
+		res.Data = append(res.Data, *mapToExternalPerson(person))
+	}
+
+	return res, nil
+}
+
 func (s *Service) GetOrganizationsByIdentifier(ctx context.Context, req *GetOrganizationsByIdentifierRequest) (*OrganizationListResponse, error) {
 	urns := make([]*models.URN, 0, len(req.Identifier))
 	for _, id := range req.Identifier {
@@ -198,15 +510,29 @@ func (s *Service) GetOrganizationsById(ctx context.Context, req *GetOrganization
 	for _, org := range orgs {
 		res.Data = append(res.Data, *mapToExternalOrganization(org))
 	}
+	tombstones, err := s.repository.GetOrganizationTombstones(ctx, req.ID...)
+	if err != nil {
+		return nil, err
+	}
+	for _, tombstone := range tombstones {
+		res.Gone = append(res.Gone, *mapToExternalTombstone(tombstone))
+	}
 	return res, nil
 }
 
 func (s *Service) GetOrganizations(ctx context.Context, req *GetOrganizationsRequest) (*OrganizationPagedListResponse, error) {
 	var organizations []*models.Organization
 	var err error
+	var tombstones []*models.Tombstone
 	var cursor string
 
-	if req.Cursor.Value != "" {
+	if req.UpdatedSince.Set {
+		if req.Cursor.Value != "" {
+			organizations, tombstones, cursor, err = s.repository.GetMoreOrganizationsChanges(ctx, req.UpdatedSince.Value, req.Cursor.Value)
+		} else {
+			organizations, tombstones, cursor, err = s.repository.GetOrganizationsChanges(ctx, req.UpdatedSince.Value)
+		}
+	} else if req.Cursor.Value != "" {
 		organizations, cursor, err = s.repository.GetMoreOrganizations(ctx, req.Cursor.Value)
 	} else {
 		organizations, cursor, err = s.repository.GetOrganizations(ctx)
@@ -225,13 +551,52 @@ func (s *Service) GetOrganizations(ctx context.Context, req *GetOrganizationsReq
 		res.Data = append(res.Data, *mapToExternalOrganization(org))
 	}
 
+	for _, tombstone := range tombstones {
+		res.Gone = append(res.Gone, *mapToExternalTombstone(tombstone))
+	}
+
+	return res, nil
+}
+
+func (s *Service) SearchOrganizations(ctx context.Context, req *SearchOrganizationsRequest) (*OrganizationSearchResponse, error) {
+	result, err := s.repository.SearchOrganizations(ctx, models.OrganizationSearchParams{
+		Query:            req.Query.Value,
+		Type:             req.Type,
+		Ancestor:         req.Ancestor,
+		Current:          req.Current.Value,
+		IncludeDissolved: req.IncludeDissolved.Value,
+		Sort:             string(req.Sort.Value),
+		Offset:           uint32(req.Offset.Value),
+		Limit:            uint32(req.Limit.Value),
+		FacetLimit:       uint32(req.FacetLimit.Value),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &OrganizationSearchResponse{
+		Total:  result.Total,
+		Offset: int(result.Offset),
+		Limit:  int(result.Limit),
+		Data:   make([]Organization, 0, len(result.Hits)),
+		Facets: OrganizationFacets{
+			Type: mapToExternalFacetValues(result.Facets[models.FacetType]),
+		},
+	}
+	for _, org := range result.Hits {
+		res.Data = append(res.Data, *mapToExternalOrganization(org))
+	}
+
 	return res, nil
 }
 
 func (s *Service) SuggestOrganizations(ctx context.Context, req *SuggestOrganizationsRequest) (*OrganizationListResponse, error) {
-	orgs, err := s.repository.SuggestOrganizations(ctx, models.OrganizationSuggestParams{
-		Query: req.Query,
-		Limit: uint32(req.Limit.Value),
+	orgs, matches, err := s.organizationSuggestService.SuggestOrganizations(ctx, models.OrganizationSuggestParams{
+		Query:            req.Query,
+		Limit:            uint32(req.Limit.Value),
+		Threshold:        req.Threshold.Value,
+		Highlight:        req.Highlight.Value,
+		IncludeDissolved: req.IncludeDissolved.Value,
 	})
 	if err != nil {
 		return nil, err
@@ -243,6 +608,9 @@ func (s *Service) SuggestOrganizations(ctx context.Context, req *SuggestOrganiza
 	for _, org := range orgs {
 		res.Data = append(res.Data, *mapToExternalOrganization(org))
 	}
+	if req.Highlight.Value {
+		res.Matches = mapToExternalMatches(matches)
+	}
 
 	return res, nil
 }
@@ -258,44 +626,16 @@ func (s *Service) AddPerson(ctx context.Context, p *Person) (*Person, error) {
 			return nil, err
 		}
 		person = oldPerson
+		if p.Version.Set {
+			person.Version = p.Version.Value
+		}
 	} else {
 		person = models.NewPerson()
 	}
 
-	person.Active = p.Active.Value
-	person.BirthDate = p.BirthDate.Value
-	person.SetEmail(p.Email.Value)
-	person.GivenName = p.GivenName.Value
-	person.FamilyName = p.FamilyName.Value
-	person.Name = p.Name.Value
-	person.SetJobCategory(p.JobCategory...)
-	person.SetObjectClass(p.ObjectClass...)
-	person.ClearToken()
-	for typ, token := range p.Token.Value {
-		person.SetToken(typ, token)
-	}
-	person.PreferredGivenName = p.PreferredGivenName.Value
-	person.PreferredFamilyName = p.PreferredFamilyName.Value
-	person.SetRole(p.Role...)
-	person.Settings = p.Settings.Value
-	person.HonorificPrefix = p.HonorificPrefix.Value
-
-	ids := make([]*models.URN, 0, len(p.Identifier))
-	for _, identifier := range p.Identifier {
-		id, err := models.ParseURN(identifier)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse %s: %w", identifier, models.ErrInvalidURN)
-		}
-		ids = append(ids, id)
-	}
-	person.SetIdentifier(ids...)
-
-	orgMembers := []*models.OrganizationMember{}
-	for _, orgMember := range p.Organization {
-		newOrgMember := models.NewOrganizationMember(orgMember.ID)
-		orgMembers = append(orgMembers, newOrgMember)
+	if err := mapFromExternalPerson(person, p); err != nil {
+		return nil, err
 	}
-	person.SetOrganizationMember(orgMembers...)
 
 	if newPerson, err := s.repository.SavePerson(ctx, person); err != nil {
 		return nil, err
@@ -317,49 +657,242 @@ func (s *Service) AddOrganization(ctx context.Context, o *Organization) (*Organi
 			return nil, err
 		}
 		org = oldOrg
+		if o.Version.Set {
+			org.Version = o.Version.Value
+		}
 	} else {
 		org = models.NewOrganization()
 	}
 
-	org.Acronym = o.Acronym.Value
-	org.NameDut = o.NameDut.Value
-	org.NameEng = o.NameEng.Value
-	parents := []*models.OrganizationParent{}
-	for _, parent := range o.Parent {
-		from := parent.From
-		op := models.OrganizationParent{
-			ID:   parent.ID,
-			From: &from,
+	if err := mapFromExternalOrganization(org, o); err != nil {
+		return nil, err
+	}
+
+	if newOrg, err := s.repository.SaveOrganization(ctx, org); err != nil {
+		return nil, err
+	} else {
+		org = newOrg
+	}
+
+	return mapToExternalOrganization(org), nil
+}
+
+func (s *Service) AddPeople(ctx context.Context, req AddPeopleReq, params AddPeopleParams) (*UpsertResponse, error) {
+	var externalPeople []Person
+
+	switch req := req.(type) {
+	case *AddPeopleReqApplicationJSON:
+		externalPeople = *req
+	case *AddPeopleReqApplicationXNdjson:
+		err := decodeNDJSON(req.Data, func(d *jx.Decoder) error {
+			var p Person
+			if err := p.Decode(d); err != nil {
+				return err
+			}
+			// the generated json body validation doesn't cover ndjson
+			if err := p.Validate(); err != nil {
+				return err
+			}
+			externalPeople = append(externalPeople, p)
+			return nil
+		})
+		if err != nil {
+			return nil, err
 		}
-		if parent.Until.Set {
-			until := parent.Until.Value
-			op.Until = &until
+	}
+
+	people := make([]*models.Person, 0, len(externalPeople))
+	for i, p := range externalPeople {
+		person := models.NewPerson()
+		person.ID = p.ID.Value
+		person.Version = p.Version.Value
+		if err := mapFromExternalPerson(person, &p); err != nil {
+			return nil, fmt.Errorf("%w: record %d: %s", models.ErrInvalidArgument, i, err)
 		}
-		parents = append(parents, &op)
+		people = append(people, person)
 	}
-	org.SetParent(parents...)
-	org.Type = o.Type.Value
 
-	ids := make([]*models.URN, 0, len(o.Identifier))
-	for _, identifier := range o.Identifier {
-		id, err := models.ParseURN(identifier)
+	results, err := s.repository.UpsertPeople(ctx, models.UpsertParams{
+		MatchOn:       string(params.MatchOn.Value),
+		Transactional: params.Transactional.Value,
+	}, people...)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapToExternalUpsertResponse(results), nil
+}
+
+func (s *Service) AddOrganizations(ctx context.Context, req AddOrganizationsReq, params AddOrganizationsParams) (*UpsertResponse, error) {
+	var externalOrgs []Organization
+
+	switch req := req.(type) {
+	case *AddOrganizationsReqApplicationJSON:
+		externalOrgs = *req
+	case *AddOrganizationsReqApplicationXNdjson:
+		err := decodeNDJSON(req.Data, func(d *jx.Decoder) error {
+			var o Organization
+			if err := o.Decode(d); err != nil {
+				return err
+			}
+			// the generated json body validation doesn't cover ndjson
+			if err := o.Validate(); err != nil {
+				return err
+			}
+			externalOrgs = append(externalOrgs, o)
+			return nil
+		})
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse %s: %w", identifier, models.ErrInvalidURN)
+			return nil, err
 		}
-		ids = append(ids, id)
 	}
-	org.SetIdentifier(ids...)
 
-	if newOrg, err := s.repository.SaveOrganization(ctx, org); err != nil {
+	orgs := make([]*models.Organization, 0, len(externalOrgs))
+	for i, o := range externalOrgs {
+		org := models.NewOrganization()
+		org.ID = o.ID.Value
+		org.Version = o.Version.Value
+		if err := mapFromExternalOrganization(org, &o); err != nil {
+			return nil, fmt.Errorf("%w: record %d: %s", models.ErrInvalidArgument, i, err)
+		}
+		orgs = append(orgs, org)
+	}
+
+	results, err := s.repository.UpsertOrganizations(ctx, models.UpsertParams{
+		MatchOn:       string(params.MatchOn.Value),
+		Transactional: params.Transactional.Value,
+	}, orgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapToExternalUpsertResponse(results), nil
+}
+
+func (s *Service) DeleteOrganization(ctx context.Context, req *DeleteOrganizationRequest) error {
+	return s.repository.DeleteOrganization(ctx, req.ID, req.Cascade.Value)
+}
+
+func (s *Service) UpdatePerson(ctx context.Context, req *UpdatePersonRequest) (*Person, error) {
+	person, err := s.repository.GetPerson(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	mask := make([]string, 0, len(req.UpdateMask))
+	for _, field := range req.UpdateMask {
+		mask = append(mask, string(field))
+	}
+	if req.ExpectedVersion.Set {
+		person.Version = req.ExpectedVersion.Value
+	}
+	if err := applyPersonPatch(person, &req.Person, mask); err != nil {
+		return nil, err
+	}
+
+	person, err = s.repository.UpdatePerson(ctx, person)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapToExternalPerson(person), nil
+}
+
+func (s *Service) UpdateOrganization(ctx context.Context, req *UpdateOrganizationRequest) (*Organization, error) {
+	org, err := s.repository.GetOrganization(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	mask := make([]string, 0, len(req.UpdateMask))
+	for _, field := range req.UpdateMask {
+		mask = append(mask, string(field))
+	}
+	if req.ExpectedVersion.Set {
+		org.Version = req.ExpectedVersion.Value
+	}
+	if err := applyOrganizationPatch(org, &req.Organization, mask); err != nil {
+		return nil, err
+	}
+
+	org, err = s.repository.UpdateOrganization(ctx, org)
+	if err != nil {
 		return nil, err
-	} else {
-		org = newOrg
 	}
 
 	return mapToExternalOrganization(org), nil
 }
 
+func (s *Service) AddWebhookSubscription(ctx context.Context, req *AddWebhookSubscriptionRequest) (*WebhookSubscription, error) {
+	if (req.URL.Scheme != "http" && req.URL.Scheme != "https") || req.URL.Host == "" {
+		return nil, fmt.Errorf("%w: url must be an absolute http or https url", models.ErrInvalidArgument)
+	}
+
+	sub := &models.WebhookSubscription{
+		URL:       req.URL.String(),
+		EventType: make([]string, 0, len(req.EventType)),
+		Secret:    req.Secret,
+	}
+	for _, eventType := range req.EventType {
+		sub.EventType = append(sub.EventType, string(eventType))
+	}
+
+	sub, err := s.repository.CreateWebhookSubscription(ctx, sub)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapToExternalWebhookSubscription(sub), nil
+}
+
+func (s *Service) GetWebhookSubscriptions(ctx context.Context, req *GetWebhookSubscriptionsRequest) (*WebhookSubscriptionListResponse, error) {
+	subs, err := s.repository.GetWebhookSubscriptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := &WebhookSubscriptionListResponse{
+		Data: make([]WebhookSubscription, 0, len(subs)),
+	}
+	for _, sub := range subs {
+		res.Data = append(res.Data, *mapToExternalWebhookSubscription(sub))
+	}
+	return res, nil
+}
+
+func (s *Service) DeleteWebhookSubscription(ctx context.Context, req *DeleteWebhookSubscriptionRequest) error {
+	return s.repository.DeleteWebhookSubscription(ctx, req.ID)
+}
+
+func (s *Service) GetWebhookDeliveries(ctx context.Context, req *GetWebhookDeliveriesRequest) (*WebhookDeliveryListResponse, error) {
+	limit := 50
+	if req.Limit.Set {
+		limit = req.Limit.Value
+	}
+	deliveries, err := s.repository.GetWebhookDeliveries(ctx, req.SubscriptionID, limit)
+	if err != nil {
+		return nil, err
+	}
+	res := &WebhookDeliveryListResponse{
+		Data: make([]WebhookDelivery, 0, len(deliveries)),
+	}
+	for _, delivery := range deliveries {
+		res.Data = append(res.Data, *mapToExternalWebhookDelivery(delivery))
+	}
+	return res, nil
+}
+
 func (s *Service) NewError(ctx context.Context, err error) *ErrorStatusCode {
+	var goneErr *models.GoneError
+	if errors.As(err, &goneErr) {
+		return &ErrorStatusCode{
+			StatusCode: 410,
+			Response: Error{
+				Code:      410,
+				Message:   err.Error(),
+				Tombstone: NewOptTombstone(*mapToExternalTombstone(goneErr.Tombstone)),
+			},
+		}
+	}
 	if errors.Is(err, models.ErrNotFound) {
 		return &ErrorStatusCode{
 			StatusCode: 404,
@@ -378,6 +911,24 @@ func (s *Service) NewError(ctx context.Context, err error) *ErrorStatusCode {
 			},
 		}
 	}
+	if errors.Is(err, models.ErrConflict) {
+		return &ErrorStatusCode{
+			StatusCode: 409,
+			Response: Error{
+				Code:    409,
+				Message: err.Error(),
+			},
+		}
+	}
+	if errors.Is(err, models.ErrInvalidArgument) {
+		return &ErrorStatusCode{
+			StatusCode: 400,
+			Response: Error{
+				Code:    400,
+				Message: err.Error(),
+			},
+		}
+	}
 	if errors.Is(err, models.ErrInvalidReference) {
 		return &ErrorStatusCode{
 			StatusCode: 400,
@@ -435,6 +986,21 @@ func mapToExternalPerson(person *models.Person) *Person {
 			DateCreated: NewOptDateTime(*orgMember.DateCreated),
 			DateUpdated: NewOptDateTime(*orgMember.DateUpdated),
 		}
+		if orgMember.From != nil {
+			externalOrgMember.From = NewOptDateTime(*orgMember.From)
+		}
+		if orgMember.Until != nil {
+			externalOrgMember.Until = NewOptDateTime(*orgMember.Until)
+		}
+		if orgMember.Type != "" {
+			externalOrgMember.Type = NewOptString(orgMember.Type)
+		}
+		if orgMember.FTE != nil {
+			externalOrgMember.Fte = NewOptFloat64(*orgMember.FTE)
+		}
+		if orgMember.Source != "" {
+			externalOrgMember.Source = NewOptString(orgMember.Source)
+		}
 		p.Organization = append(p.Organization, externalOrgMember)
 	}
 	p.Identifier = make([]string, 0, len(person.Identifier))
@@ -453,6 +1019,7 @@ func mapToExternalPerson(person *models.Person) *Person {
 	if person.HonorificPrefix != "" {
 		p.HonorificPrefix = NewOptString(person.HonorificPrefix)
 	}
+	p.Version = NewOptInt(person.Version)
 
 	return p
 }
@@ -487,7 +1054,401 @@ func mapToExternalOrganization(org *models.Organization) *Organization {
 		}
 		o.Parent = append(o.Parent, op)
 	}
+	o.Successor = mapToExternalOrganizationSuccessors(org.Successor)
+	o.Predecessor = mapToExternalOrganizationSuccessors(org.Predecessor)
+	if org.Founded != nil {
+		o.Founded = NewOptDateTime(*org.Founded)
+	}
+	if org.Dissolved != nil {
+		o.Dissolved = NewOptDateTime(*org.Dissolved)
+	}
+	o.Status = NewOptOrganizationStatus(OrganizationStatus(org.StatusAt(time.Now())))
 	o.Type = NewOptString(org.Type)
+	o.Version = NewOptInt(org.Version)
 
 	return o
 }
+
+func mapFromExternalPerson(person *models.Person, p *Person) error {
+	person.Active = p.Active.Value
+	person.BirthDate = p.BirthDate.Value
+	person.SetEmail(p.Email.Value)
+	person.GivenName = p.GivenName.Value
+	person.FamilyName = p.FamilyName.Value
+	person.Name = p.Name.Value
+	person.SetJobCategory(p.JobCategory...)
+	person.SetObjectClass(p.ObjectClass...)
+	person.ClearToken()
+	for typ, token := range p.Token.Value {
+		person.SetToken(typ, token)
+	}
+	person.PreferredGivenName = p.PreferredGivenName.Value
+	person.PreferredFamilyName = p.PreferredFamilyName.Value
+	person.SetRole(p.Role...)
+	person.Settings = p.Settings.Value
+	person.HonorificPrefix = p.HonorificPrefix.Value
+
+	ids, err := mapFromExternalIdentifiers(p.Identifier)
+	if err != nil {
+		return err
+	}
+	person.SetIdentifier(ids...)
+	person.SetOrganizationMember(mapFromExternalOrganizationMembers(p.Organization)...)
+
+	return nil
+}
+
+func mapFromExternalOrganization(org *models.Organization, o *Organization) error {
+	org.Acronym = o.Acronym.Value
+	org.NameDut = o.NameDut.Value
+	org.NameEng = o.NameEng.Value
+	org.SetParent(mapFromExternalOrganizationParents(o.Parent)...)
+	org.SetSuccessor(mapFromExternalOrganizationSuccessors(o.Successor)...)
+	org.Type = o.Type.Value
+	org.Founded = nil
+	if o.Founded.Set {
+		founded := o.Founded.Value
+		org.Founded = &founded
+	}
+	org.Dissolved = nil
+	if o.Dissolved.Set {
+		dissolved := o.Dissolved.Value
+		org.Dissolved = &dissolved
+	}
+
+	ids, err := mapFromExternalIdentifiers(o.Identifier)
+	if err != nil {
+		return err
+	}
+	org.SetIdentifier(ids...)
+
+	return nil
+}
+
+func mapFromExternalOrganizationTreeRequest(req *GetOrganizationTreeRequest) models.OrganizationTreeParams {
+	return models.OrganizationTreeParams{
+		ID:       req.ID,
+		AsOf:     req.AsOf.Value,
+		MaxDepth: req.MaxDepth.Value,
+	}
+}
+
+func mapToExternalOrganizationTreeResponse(nodes []*models.OrganizationTreeNode) *OrganizationTreeResponse {
+	res := &OrganizationTreeResponse{
+		Data: make([]OrganizationTreeNode, 0, len(nodes)),
+	}
+	for _, node := range nodes {
+		n := OrganizationTreeNode{
+			Organization: *mapToExternalOrganization(node.Organization),
+			Depth:        node.Depth,
+			Path:         node.Path,
+		}
+		if node.ParentID != "" {
+			n.ParentID = NewOptString(node.ParentID)
+		}
+		if node.From != nil {
+			n.From = NewOptDateTime(*node.From)
+		}
+		if node.Until != nil {
+			n.Until = NewOptDateTime(*node.Until)
+		}
+		res.Data = append(res.Data, n)
+	}
+	return res
+}
+
+func mapToExternalOrganizationLineageResponse(nodes []*models.OrganizationLineageNode) *OrganizationLineageResponse {
+	res := &OrganizationLineageResponse{
+		Data: make([]OrganizationLineageNode, 0, len(nodes)),
+	}
+	for _, node := range nodes {
+		n := OrganizationLineageNode{
+			Organization: *mapToExternalOrganization(node.Organization),
+			Depth:        node.Depth,
+			Path:         node.Path,
+			Current:      node.Current,
+		}
+		if node.Date != nil {
+			n.Date = NewOptDateTime(*node.Date)
+		}
+		res.Data = append(res.Data, n)
+	}
+	return res
+}
+
+func mapToExternalOrganizationSuccessors(successors []*models.OrganizationSuccessor) []OrganizationSuccessor {
+	var newSuccessors []OrganizationSuccessor
+	for _, successor := range successors {
+		newSuccessors = append(newSuccessors, OrganizationSuccessor{
+			ID:          successor.ID,
+			DateCreated: NewOptDateTime(*successor.DateCreated),
+			DateUpdated: NewOptDateTime(*successor.DateUpdated),
+			Date:        *successor.Date,
+		})
+	}
+	return newSuccessors
+}
+
+func mapToExternalTombstone(tombstone *models.Tombstone) *Tombstone {
+	t := &Tombstone{
+		ID:          tombstone.ID,
+		DateDeleted: *tombstone.DateDeleted,
+		Reason:      TombstoneReason(tombstone.Reason),
+	}
+	if tombstone.SuccessorID != "" {
+		t.SuccessorID = NewOptString(tombstone.SuccessorID)
+	}
+	return t
+}
+
+func mapToExternalWebhookSubscription(sub *models.WebhookSubscription) *WebhookSubscription {
+	s := &WebhookSubscription{
+		ID:          sub.ID,
+		DateCreated: *sub.DateCreated,
+		DateUpdated: *sub.DateUpdated,
+		URL:         sub.URL,
+		EventType:   make([]EventType, 0, len(sub.EventType)),
+	}
+	for _, eventType := range sub.EventType {
+		s.EventType = append(s.EventType, EventType(eventType))
+	}
+	return s
+}
+
+func mapToExternalWebhookDelivery(delivery *models.WebhookDelivery) *WebhookDelivery {
+	d := &WebhookDelivery{
+		EventID:     delivery.EventID,
+		EventType:   delivery.EventType,
+		DateCreated: *delivery.DateCreated,
+		Status:      WebhookDeliveryStatus(delivery.Status),
+		Attempts:    delivery.Attempts,
+		AttemptLog:  make([]WebhookAttempt, 0, len(delivery.AttemptLog)),
+	}
+	if delivery.NextAttempt != nil {
+		d.NextAttempt = NewOptDateTime(*delivery.NextAttempt)
+	}
+	if delivery.DateDelivered != nil {
+		d.DateDelivered = NewOptDateTime(*delivery.DateDelivered)
+	}
+	for _, attempt := range delivery.AttemptLog {
+		a := WebhookAttempt{
+			DateAttempted: *attempt.DateAttempted,
+			DurationMs:    int(attempt.Duration.Milliseconds()),
+		}
+		if attempt.StatusCode != 0 {
+			a.StatusCode = NewOptInt(attempt.StatusCode)
+		}
+		if attempt.Error != "" {
+			a.Error = NewOptString(attempt.Error)
+		}
+		d.AttemptLog = append(d.AttemptLog, a)
+	}
+	return d
+}
+
+func mapToExternalActor(actor models.Actor) Actor {
+	a := Actor{
+		Source: actor.Source,
+	}
+	if actor.ID != "" {
+		a.ID = NewOptString(actor.ID)
+	}
+	return a
+}
+
+// mapToExternalFieldChanges returns the changes of revisions[i] since the revision before
+func mapToExternalFieldChanges(revisions []*models.Revision, i int) ([]FieldChange, error) {
+	var prev *models.Revision
+	if i > 0 {
+		prev = revisions[i-1]
+	}
+	changes, err := models.DiffRevisions(prev, revisions[i])
+	if err != nil {
+		return nil, err
+	}
+	return mapToExternalChanges(changes), nil
+}
+
+func mapToExternalChanges(changes []*models.FieldChange) []FieldChange {
+	fieldChanges := make([]FieldChange, 0, len(changes))
+	for _, change := range changes {
+		fieldChanges = append(fieldChanges, FieldChange{
+			Field: change.Field,
+			Old:   jx.Raw(change.Old),
+			New:   jx.Raw(change.New),
+		})
+	}
+	return fieldChanges
+}
+
+func mapFromExternalRevertRequest(req *RevertRequest) models.RevertParams {
+	return models.RevertParams{
+		ID:      req.ID.Value,
+		Version: req.Version.Value,
+		Actor: models.Actor{
+			Source: req.ActorSource.Value,
+			ID:     req.ActorID.Value,
+		},
+		From:   req.From.Value,
+		Until:  req.Until.Value,
+		Force:  req.Force.Value,
+		DryRun: req.DryRun.Value,
+	}
+}
+
+func mapToExternalRevertResponse(params models.RevertParams, results []*models.RevertResult) *RevertResponse {
+	res := &RevertResponse{
+		DryRun: params.DryRun,
+		Data:   make([]RevertResult, 0, len(results)),
+	}
+	for _, result := range results {
+		r := RevertResult{
+			ID:       result.ID,
+			Version:  result.Version,
+			Status:   RevertResultStatus(result.Status),
+			Changes:  mapToExternalChanges(result.Changes),
+			Warnings: result.Warnings,
+		}
+		if result.Error != nil {
+			r.Error = NewOptString(result.Error.Error())
+		}
+		res.Data = append(res.Data, r)
+	}
+	return res
+}
+
+func mapToExternalMatches(matches []*models.Match) []Match {
+	extMatches := make([]Match, 0, len(matches))
+	for _, m := range matches {
+		extMatch := Match{
+			Rank:   m.Rank,
+			Fields: make([]FieldMatch, 0, len(m.Fields)),
+		}
+		for _, fm := range m.Fields {
+			extMatch.Fields = append(extMatch.Fields, FieldMatch{
+				Field:     fm.Field,
+				Value:     fm.Value,
+				Highlight: fm.Highlight,
+			})
+		}
+		extMatches = append(extMatches, extMatch)
+	}
+	return extMatches
+}
+
+func mapToExternalFacetValues(values []*models.FacetValue) []FacetValue {
+	facetValues := make([]FacetValue, 0, len(values))
+	for _, v := range values {
+		facetValues = append(facetValues, FacetValue{
+			Value: v.Value,
+			Count: v.Count,
+		})
+	}
+	return facetValues
+}
+
+func mapToExternalUpsertResponse(results []*models.UpsertResult) *UpsertResponse {
+	res := &UpsertResponse{
+		Data: make([]UpsertResult, 0, len(results)),
+	}
+	for _, result := range results {
+		r := UpsertResult{
+			Index:  result.Index,
+			Status: UpsertResultStatus(result.Status),
+		}
+		if result.ID != "" {
+			r.ID = NewOptString(result.ID)
+		}
+		if result.Error != nil {
+			r.Error = NewOptString(result.Error.Error())
+		}
+		res.Data = append(res.Data, r)
+	}
+	return res
+}
+
+// decodeNDJSON calls fn for every non empty line in r.
+// Malformed lines are reported as models.ErrInvalidArgument.
+func decodeNDJSON(r io.Reader, fn func(*jx.Decoder) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxNDJSONLineSize)
+	lineNr := 0
+	for scanner.Scan() {
+		lineNr++
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		if err := fn(jx.DecodeBytes(line)); err != nil {
+			return fmt.Errorf("%w: line %d: %s", models.ErrInvalidArgument, lineNr, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("%w: %s", models.ErrInvalidArgument, err)
+	}
+	return nil
+}
+
+func mapFromExternalIdentifiers(identifiers []string) ([]*models.URN, error) {
+	ids := make([]*models.URN, 0, len(identifiers))
+	for _, identifier := range identifiers {
+		id, err := models.ParseURN(identifier)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", identifier, models.ErrInvalidURN)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func mapFromExternalOrganizationMembers(orgMembers []OrganizationMember) []*models.OrganizationMember {
+	newOrgMembers := make([]*models.OrganizationMember, 0, len(orgMembers))
+	for _, orgMember := range orgMembers {
+		om := models.NewOrganizationMember(orgMember.ID)
+		if orgMember.From.Set {
+			from := orgMember.From.Value
+			om.From = &from
+		}
+		if orgMember.Until.Set {
+			until := orgMember.Until.Value
+			om.Until = &until
+		}
+		om.Type = orgMember.Type.Value
+		if orgMember.Fte.Set {
+			fte := orgMember.Fte.Value
+			om.FTE = &fte
+		}
+		om.Source = orgMember.Source.Value
+		newOrgMembers = append(newOrgMembers, om)
+	}
+	return newOrgMembers
+}
+
+func mapFromExternalOrganizationParents(parents []OrganizationParent) []*models.OrganizationParent {
+	newParents := make([]*models.OrganizationParent, 0, len(parents))
+	for _, parent := range parents {
+		from := parent.From
+		op := &models.OrganizationParent{
+			ID:   parent.ID,
+			From: &from,
+		}
+		if parent.Until.Set {
+			until := parent.Until.Value
+			op.Until = &until
+		}
+		newParents = append(newParents, op)
+	}
+	return newParents
+}
+
+func mapFromExternalOrganizationSuccessors(successors []OrganizationSuccessor) []*models.OrganizationSuccessor {
+	newSuccessors := make([]*models.OrganizationSuccessor, 0, len(successors))
+	for _, successor := range successors {
+		date := successor.Date
+		newSuccessors = append(newSuccessors, &models.OrganizationSuccessor{
+			ID:   successor.ID,
+			Date: &date,
+		})
+	}
+	return newSuccessors
+}
@@ -0,0 +1,82 @@
+package bleveindex
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/blevesearch/bleve/v2"
+	"github.com/ugent-library/people-service/models"
+	"go.uber.org/zap"
+)
+
+// Builder rebuilds the indexes in config.Path from scratch without opening them,
+// so that it can run while a server uses the index. The new index is built next to the old one
+// and renamed when done; the server swaps it in before its next sync, or when it starts.
+type Builder struct {
+	idx *Index
+}
+
+func NewBuilder(repo models.Repository, config Config, l *zap.SugaredLogger) *Builder {
+	return &Builder{idx: newIndex(repo, config, l)}
+}
+
+// RebuildAutocompletePeople builds a new person index.
+// An unfinished rebuild resumes at its last indexed change unless params.Restart is set.
+// params.BatchSize and params.StaleOnly don't apply, the change feed decides the batch size.
+func (b *Builder) RebuildAutocompletePeople(ctx context.Context, params models.RebuildParams) error {
+	return b.idx.people.build(ctx, true, params, func(index bleve.Index, progress func(int)) (int, error) {
+		return b.idx.syncPeople(ctx, index, progress)
+	})
+}
+
+// RebuildAutocompleteOrganizations is the organization equivalent of RebuildAutocompletePeople
+func (b *Builder) RebuildAutocompleteOrganizations(ctx context.Context, params models.RebuildParams) error {
+	return b.idx.organizations.build(ctx, false, params, func(index bleve.Index, progress func(int)) (int, error) {
+		return b.idx.syncOrganizations(ctx, index, progress)
+	})
+}
+
+func (sub *subIndex) build(ctx context.Context, isPeople bool, params models.RebuildParams, fn func(bleve.Index, func(int)) (int, error)) error {
+	params = params.MergeDefault()
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	// the position in the change feed of an unfinished rebuild is kept in its index
+	tmpPath := sub.path + rebuildSuffix
+	if params.Restart {
+		if err := os.RemoveAll(tmpPath); err != nil {
+			return err
+		}
+	}
+	index, err := openOrCreate(tmpPath, sub.fields, isPeople)
+	if err != nil {
+		return err
+	}
+
+	progress := models.RebuildProgress{}
+	start := time.Now()
+	_, err = fn(index, func(n int) {
+		progress.Processed += n
+		progress.Updated += n
+		progress.Elapsed = time.Since(start)
+		if params.Progress != nil {
+			params.Progress(progress)
+		}
+	})
+	if err != nil {
+		index.Close()
+		return err
+	}
+	if err := index.Close(); err != nil {
+		return err
+	}
+
+	// replaces a finished rebuild that was never swapped in
+	rebuiltPath := sub.path + rebuiltSuffix
+	if err := os.RemoveAll(rebuiltPath); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, rebuiltPath)
+}
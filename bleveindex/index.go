@@ -0,0 +1,332 @@
+package bleveindex
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/analysis/analyzer/custom"
+	"github.com/blevesearch/bleve/v2/analysis/char/asciifolding"
+	"github.com/blevesearch/bleve/v2/analysis/token/lowercase"
+	"github.com/blevesearch/bleve/v2/analysis/tokenizer/unicode"
+	"github.com/blevesearch/bleve/v2/mapping"
+	"github.com/blevesearch/bleve/v2/registry"
+	"github.com/blevesearch/bleve/v2/search/highlight"
+	htmlFormatter "github.com/blevesearch/bleve/v2/search/highlight/format/html"
+	simpleFragmenter "github.com/blevesearch/bleve/v2/search/highlight/fragmenter/simple"
+	simpleHighlighter "github.com/blevesearch/bleve/v2/search/highlight/highlighter/simple"
+	"github.com/ugent-library/people-service/models"
+	"github.com/ugent-library/people-service/repository"
+	"go.uber.org/zap"
+)
+
+const (
+	analyzerName    = "folding"
+	highlighterName = "people-html"
+	// suffixes of the path of an index that is being rebuilt and of a finished rebuild
+	rebuildSuffix = ".rebuild"
+	rebuiltSuffix = ".rebuilt"
+	// internal keys that hold the position in the change feed
+	sinceKey  = "since"
+	cursorKey = "cursor"
+)
+
+// field boosts per weight, equal to the default weights of postgres ts_rank
+var weightBoosts = map[string]float64{
+	"A": 1.0,
+	"B": 0.4,
+	"C": 0.2,
+	"D": 0.1,
+}
+
+func init() {
+	// highlight like postgres ts_headline: <b>term</b>
+	registry.RegisterHighlighter(highlighterName, func(config map[string]interface{}, cache *registry.Cache) (highlight.Highlighter, error) {
+		fragmenter, err := cache.FragmenterNamed(simpleFragmenter.Name)
+		if err != nil {
+			return nil, err
+		}
+		return simpleHighlighter.NewHighlighter(
+			fragmenter,
+			htmlFormatter.NewFragmentFormatter("<b>", "</b>"),
+			simpleHighlighter.DefaultSeparator,
+		), nil
+	})
+}
+
+type Config struct {
+	// Path is the directory that holds the index
+	Path string
+	// PersonFields and OrganizationFields are the indexed attributes with their weight,
+	// cf. repository.Config
+	PersonFields       []repository.TsField
+	OrganizationFields []repository.TsField
+	// SyncInterval is the time to wait between polls of the change feed
+	SyncInterval time.Duration
+}
+
+// Index is an embedded Bleve index that implements models.PersonSuggestService and
+// models.OrganizationSuggestService. It follows the change feed of the repository,
+// so that suggestions do not query the primary database.
+type Index struct {
+	repository    models.Repository
+	config        Config
+	logger        *zap.SugaredLogger
+	people        *subIndex
+	organizations *subIndex
+}
+
+// subIndex is the index of one record type. The lock guards swapping in a rebuilt index.
+type subIndex struct {
+	mu      sync.RWMutex
+	path    string
+	fields  []repository.TsField
+	index   bleve.Index
+	syncing sync.Mutex
+}
+
+// New opens the index in config.Path, or creates it if it does not exist yet.
+// A finished rebuild is swapped in first.
+// Only one process can open the index at a time.
+func New(repo models.Repository, config Config, l *zap.SugaredLogger) (*Index, error) {
+	idx := newIndex(repo, config, l)
+
+	for _, sub := range []*subIndex{idx.people, idx.organizations} {
+		if err := sub.install(); err != nil {
+			return nil, err
+		}
+		index, err := openOrCreate(sub.path, sub.fields, sub == idx.people)
+		if err != nil {
+			idx.Close()
+			return nil, err
+		}
+		sub.index = index
+	}
+
+	return idx, nil
+}
+
+func newIndex(repo models.Repository, config Config, l *zap.SugaredLogger) *Index {
+	if len(config.PersonFields) == 0 {
+		config.PersonFields = repository.DefaultPersonTsFields
+	}
+	if len(config.OrganizationFields) == 0 {
+		config.OrganizationFields = repository.DefaultOrganizationTsFields
+	}
+	if config.SyncInterval <= 0 {
+		config.SyncInterval = 5 * time.Second
+	}
+
+	return &Index{
+		repository: repo,
+		config:     config,
+		logger:     l,
+		people: &subIndex{
+			path:   filepath.Join(config.Path, "people"),
+			fields: config.PersonFields,
+		},
+		organizations: &subIndex{
+			path:   filepath.Join(config.Path, "organizations"),
+			fields: config.OrganizationFields,
+		},
+	}
+}
+
+func (idx *Index) Close() error {
+	var errs []error
+	for _, sub := range []*subIndex{idx.people, idx.organizations} {
+		sub.mu.Lock()
+		if sub.index != nil {
+			errs = append(errs, sub.index.Close())
+			sub.index = nil
+		}
+		sub.mu.Unlock()
+	}
+	return errors.Join(errs...)
+}
+
+// Run keeps the index up to date with the change feed until ctx is cancelled.
+// It swaps in a finished rebuild before every sync.
+func (idx *Index) Run(ctx context.Context) error {
+	for {
+		if err := idx.swap(); err != nil {
+			idx.logger.Errorf("failed to swap in rebuilt search index: %s", err)
+		}
+		if err := idx.Sync(ctx); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			idx.logger.Errorf("failed to sync search index: %s", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(idx.config.SyncInterval):
+		}
+	}
+}
+
+// Sync indexes all changes since the last sync. The first sync indexes all records.
+func (idx *Index) Sync(ctx context.Context) error {
+	if err := idx.people.sync(ctx, func(index bleve.Index) (int, error) {
+		return idx.syncPeople(ctx, index, nil)
+	}); err != nil {
+		return err
+	}
+	return idx.organizations.sync(ctx, func(index bleve.Index) (int, error) {
+		return idx.syncOrganizations(ctx, index, nil)
+	})
+}
+
+// RebuildAutocompletePeople builds a new person index from scratch, like Builder does,
+// and swaps it in when done
+func (idx *Index) RebuildAutocompletePeople(ctx context.Context, params models.RebuildParams) error {
+	if err := NewBuilder(idx.repository, idx.config, idx.logger).RebuildAutocompletePeople(ctx, params); err != nil {
+		return err
+	}
+	return idx.swap()
+}
+
+// RebuildAutocompleteOrganizations is the organization equivalent of RebuildAutocompletePeople
+func (idx *Index) RebuildAutocompleteOrganizations(ctx context.Context, params models.RebuildParams) error {
+	if err := NewBuilder(idx.repository, idx.config, idx.logger).RebuildAutocompleteOrganizations(ctx, params); err != nil {
+		return err
+	}
+	return idx.swap()
+}
+
+func (sub *subIndex) sync(ctx context.Context, fn func(bleve.Index) (int, error)) error {
+	sub.syncing.Lock()
+	defer sub.syncing.Unlock()
+	sub.mu.RLock()
+	defer sub.mu.RUnlock()
+	if sub.index == nil {
+		return errors.New("search index is closed")
+	}
+	_, err := fn(sub.index)
+	return err
+}
+
+// swap replaces the open indexes with finished rebuilds, if there are any
+func (idx *Index) swap() error {
+	for _, sub := range []*subIndex{idx.people, idx.organizations} {
+		if _, err := os.Stat(sub.path + rebuiltSuffix); errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+
+		sub.syncing.Lock()
+		sub.mu.Lock()
+		err := sub.swap(sub == idx.people)
+		sub.mu.Unlock()
+		sub.syncing.Unlock()
+		if err != nil {
+			return err
+		}
+		idx.logger.Infof("swapped in rebuilt search index %s", sub.path)
+	}
+	return nil
+}
+
+func (sub *subIndex) swap(isPeople bool) error {
+	if sub.index != nil {
+		if err := sub.index.Close(); err != nil {
+			return err
+		}
+		sub.index = nil
+	}
+	if err := sub.install(); err != nil {
+		return err
+	}
+	index, err := openOrCreate(sub.path, sub.fields, isPeople)
+	if err != nil {
+		return err
+	}
+	sub.index = index
+	return nil
+}
+
+// install moves a finished rebuild in place of the closed index
+func (sub *subIndex) install() error {
+	rebuiltPath := sub.path + rebuiltSuffix
+	if _, err := os.Stat(rebuiltPath); errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err := os.RemoveAll(sub.path); err != nil {
+		return err
+	}
+	return os.Rename(rebuiltPath, sub.path)
+}
+
+// search runs fn with the current index
+func (sub *subIndex) search(fn func(bleve.Index) error) error {
+	sub.mu.RLock()
+	defer sub.mu.RUnlock()
+	if sub.index == nil {
+		return errors.New("search index is closed")
+	}
+	return fn(sub.index)
+}
+
+func open(path string) (bleve.Index, error) {
+	// fail instead of waiting forever when another process holds the index
+	return bleve.OpenUsing(path, map[string]interface{}{"bolt_timeout": "5s"})
+}
+
+func openOrCreate(path string, fields []repository.TsField, isPeople bool) (bleve.Index, error) {
+	index, err := open(path)
+	if errors.Is(err, bleve.ErrorIndexPathDoesNotExist) {
+		return bleve.New(path, newMapping(fields, isPeople))
+	}
+	return index, err
+}
+
+// newMapping indexes the configured fields unaccented and case insensitive,
+// and stores the full record in field "record"
+func newMapping(fields []repository.TsField, isPeople bool) mapping.IndexMapping {
+	im := bleve.NewIndexMapping()
+	im.AddCustomAnalyzer(analyzerName, map[string]interface{}{
+		"type":          custom.Name,
+		"char_filters":  []string{asciifolding.Name},
+		"tokenizer":     unicode.Name,
+		"token_filters": []string{lowercase.Name},
+	})
+	im.DefaultAnalyzer = analyzerName
+
+	dm := bleve.NewDocumentStaticMapping()
+
+	for _, field := range fields {
+		fm := bleve.NewTextFieldMapping()
+		fm.Analyzer = analyzerName
+		fm.Store = true
+		fm.IncludeTermVectors = true
+		fm.IncludeInAll = false
+		dm.AddFieldMappingsAt(field.Name, fm)
+	}
+
+	if isPeople {
+		fm := bleve.NewBooleanFieldMapping()
+		fm.IncludeInAll = false
+		dm.AddFieldMappingsAt("active", fm)
+	} else {
+		fm := bleve.NewDateTimeFieldMapping()
+		fm.IncludeInAll = false
+		dm.AddFieldMappingsAt("dissolved", fm)
+	}
+
+	recordMapping := bleve.NewTextFieldMapping()
+	recordMapping.Index = false
+	recordMapping.Store = true
+	recordMapping.IncludeInAll = false
+	recordMapping.IncludeTermVectors = false
+	recordMapping.DocValues = false
+	dm.AddFieldMappingsAt("record", recordMapping)
+
+	im.DefaultMapping = dm
+
+	return im
+}
@@ -0,0 +1,78 @@
+package bleveindex
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/blevesearch/bleve/v2"
+	"github.com/ugent-library/people-service/repository"
+	"go.uber.org/zap"
+)
+
+// buildIndex creates an index at path with the given document ids, like a finished rebuild
+func buildIndex(t *testing.T, path string, ids ...string) {
+	t.Helper()
+	index, err := bleve.New(path, newMapping(repository.DefaultPersonTsFields, true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range ids {
+		if err := index.Index(id, map[string]any{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := index.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func docCount(t *testing.T, sub *subIndex) uint64 {
+	t.Helper()
+	var n uint64
+	err := sub.search(func(index bleve.Index) error {
+		var err error
+		n, err = index.DocCount()
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+func TestSwapRebuiltIndex(t *testing.T) {
+	dir := t.TempDir()
+	peoplePath := filepath.Join(dir, "people")
+
+	// a rebuild that finished while no server was running is swapped in on start
+	buildIndex(t, peoplePath+rebuiltSuffix, "1")
+	idx, err := New(nil, Config{Path: dir}, zap.NewNop().Sugar())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer idx.Close()
+	if n := docCount(t, idx.people); n != 1 {
+		t.Errorf("got %d documents after start, want 1", n)
+	}
+
+	// nothing to swap
+	if err := idx.swap(); err != nil {
+		t.Fatal(err)
+	}
+	if n := docCount(t, idx.people); n != 1 {
+		t.Errorf("got %d documents, want 1", n)
+	}
+
+	// a rebuild that finished while the server runs is swapped in before the next sync
+	buildIndex(t, peoplePath+rebuiltSuffix, "1", "2")
+	if err := idx.swap(); err != nil {
+		t.Fatal(err)
+	}
+	if n := docCount(t, idx.people); n != 2 {
+		t.Errorf("got %d documents after swap, want 2", n)
+	}
+	if _, err := os.Stat(peoplePath + rebuiltSuffix); !os.IsNotExist(err) {
+		t.Errorf("rebuilt index was not moved: %v", err)
+	}
+}
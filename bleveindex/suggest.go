@@ -0,0 +1,423 @@
+package bleveindex
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/search"
+	"github.com/blevesearch/bleve/v2/search/query"
+	"github.com/ugent-library/people-service/models"
+)
+
+// terms shorter than this only match as prefix, never fuzzy
+const minFuzzyTermLength = 4
+
+type personDoc struct {
+	Active              bool     `json:"active"`
+	Name                string   `json:"name"`
+	GivenName           string   `json:"given_name"`
+	FamilyName          string   `json:"family_name"`
+	PreferredGivenName  string   `json:"preferred_given_name"`
+	PreferredFamilyName string   `json:"preferred_family_name"`
+	Email               string   `json:"email"`
+	Identifier          []string `json:"identifier"`
+	Record              string   `json:"record"`
+}
+
+type organizationDoc struct {
+	NameDut    string     `json:"name_dut"`
+	NameEng    string     `json:"name_eng"`
+	Acronym    string     `json:"acronym"`
+	Identifier []string   `json:"identifier"`
+	Dissolved  *time.Time `json:"dissolved,omitempty"`
+	Record     string     `json:"record"`
+}
+
+func newPersonDoc(p *models.Person) (*personDoc, error) {
+	// never store secrets in the index
+	rec := p.Dup()
+	rec.Token = nil
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return nil, err
+	}
+	return &personDoc{
+		Active:              p.Active,
+		Name:                p.Name,
+		GivenName:           p.GivenName,
+		FamilyName:          p.FamilyName,
+		PreferredGivenName:  p.PreferredGivenName,
+		PreferredFamilyName: p.PreferredFamilyName,
+		Email:               p.Email,
+		Identifier:          p.GetIdentifierValues(),
+		Record:              string(data),
+	}, nil
+}
+
+func newOrganizationDoc(org *models.Organization) (*organizationDoc, error) {
+	data, err := json.Marshal(org)
+	if err != nil {
+		return nil, err
+	}
+	return &organizationDoc{
+		NameDut:    org.NameDut,
+		NameEng:    org.NameEng,
+		Acronym:    org.Acronym,
+		Identifier: org.GetIdentifierValues(),
+		Dissolved:  org.Dissolved,
+		Record:     string(data),
+	}, nil
+}
+
+// SuggestPeople matches all terms of the query as prefix, or fuzzy to tolerate typos.
+// Hits are ranked by score, with the field weights of the configuration as boost.
+// Records in the index hold no tokens.
+func (idx *Index) SuggestPeople(ctx context.Context, params models.PersonSuggestParams) ([]*models.Person, []*models.Match, error) {
+	params = params.MergeDefault()
+	if err := params.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	var people []*models.Person
+	var matches []*models.Match
+
+	err := idx.people.search(func(index bleve.Index) error {
+		q := idx.people.suggestQuery(index, params.Query, params.Threshold)
+		if q == nil {
+			return nil
+		}
+		if len(params.Active) == 1 {
+			activeQuery := bleve.NewBoolFieldQuery(params.Active[0])
+			activeQuery.SetField("active")
+			q.AddQuery(activeQuery)
+		}
+
+		res, err := index.SearchInContext(ctx, idx.people.suggestRequest(q, params.Limit, params.Highlight))
+		if err != nil {
+			return err
+		}
+
+		for _, hit := range res.Hits {
+			p := &models.Person{}
+			if err := unmarshalRecord(hit, p); err != nil {
+				return err
+			}
+			people = append(people, p)
+			if params.Highlight {
+				matches = append(matches, idx.people.match(hit, p.Identifier))
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return people, matches, nil
+}
+
+// SuggestOrganizations is the organization equivalent of SuggestPeople
+func (idx *Index) SuggestOrganizations(ctx context.Context, params models.OrganizationSuggestParams) ([]*models.Organization, []*models.Match, error) {
+	params = params.MergeDefault()
+	if err := params.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	var orgs []*models.Organization
+	var matches []*models.Match
+
+	err := idx.organizations.search(func(index bleve.Index) error {
+		conj := idx.organizations.suggestQuery(index, params.Query, params.Threshold)
+		if conj == nil {
+			return nil
+		}
+		var q query.Query = conj
+		if !params.IncludeDissolved {
+			inclusive := true
+			dissolvedQuery := bleve.NewDateRangeInclusiveQuery(time.Time{}, time.Now(), nil, &inclusive)
+			dissolvedQuery.SetField("dissolved")
+			boolQuery := bleve.NewBooleanQuery()
+			boolQuery.AddMust(q)
+			boolQuery.AddMustNot(dissolvedQuery)
+			q = boolQuery
+		}
+
+		res, err := index.SearchInContext(ctx, idx.organizations.suggestRequest(q, params.Limit, params.Highlight))
+		if err != nil {
+			return err
+		}
+
+		for _, hit := range res.Hits {
+			org := &models.Organization{}
+			if err := unmarshalRecord(hit, org); err != nil {
+				return err
+			}
+			orgs = append(orgs, org)
+			if params.Highlight {
+				matches = append(matches, idx.organizations.match(hit, org.Identifier))
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return orgs, matches, nil
+}
+
+// suggestQuery requires every term of q to match one of the indexed fields:
+// exact, as prefix or (for longer terms) fuzzy. A lower threshold allows more edits.
+// It returns nil if q holds no terms.
+func (sub *subIndex) suggestQuery(index bleve.Index, q string, threshold float64) *query.ConjunctionQuery {
+	analyzer := index.Mapping().AnalyzerNamed(analyzerName)
+	tokens := analyzer.Analyze([]byte(q))
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	fuzziness := 1
+	if threshold < 0.4 {
+		fuzziness = 2
+	}
+
+	conj := bleve.NewConjunctionQuery()
+	for _, token := range tokens {
+		term := string(token.Term)
+		disj := bleve.NewDisjunctionQuery()
+		for _, field := range sub.fields {
+			boost := weightBoosts[field.Weight]
+
+			// exact hits rank above prefix hits
+			tq := bleve.NewTermQuery(term)
+			tq.SetField(field.Name)
+			tq.SetBoost(2 * boost)
+			disj.AddQuery(tq)
+
+			pq := bleve.NewPrefixQuery(term)
+			pq.SetField(field.Name)
+			pq.SetBoost(boost)
+			disj.AddQuery(pq)
+
+			if len(term) >= minFuzzyTermLength {
+				fq := bleve.NewFuzzyQuery(term)
+				fq.SetField(field.Name)
+				fq.SetFuzziness(fuzziness)
+				fq.SetBoost(boost / 2)
+				disj.AddQuery(fq)
+			}
+		}
+		conj.AddQuery(disj)
+	}
+
+	return conj
+}
+
+func (sub *subIndex) suggestRequest(q query.Query, limit uint32, highlight bool) *bleve.SearchRequest {
+	req := bleve.NewSearchRequestOptions(q, int(limit), 0, false)
+	req.Fields = []string{"record"}
+	if highlight {
+		req.Highlight = bleve.NewHighlightWithStyle(highlighterName)
+		for _, field := range sub.fields {
+			req.Highlight.AddField(field.Name)
+			req.Fields = append(req.Fields, field.Name)
+		}
+	}
+	return req
+}
+
+// match returns the fields of hit that matched, in the order of the configuration.
+// Identifiers are reported as "identifier.<namespace>", cf. models.FieldMatch.
+func (sub *subIndex) match(hit *search.DocumentMatch, identifiers []*models.URN) *models.Match {
+	m := &models.Match{
+		Rank:   hit.Score,
+		Fields: []*models.FieldMatch{},
+	}
+	for _, field := range sub.fields {
+		for _, fragment := range hit.Fragments[field.Name] {
+			// fragments of values without hits are returned too
+			if !strings.Contains(fragment, "<b>") {
+				continue
+			}
+			value := stripHighlight(fragment)
+			if field.Name == "identifier" {
+				for _, urn := range identifiers {
+					if urn.Value == value {
+						m.Fields = append(m.Fields, &models.FieldMatch{
+							Field:     "identifier." + urn.Namespace,
+							Value:     urn.Value,
+							Highlight: fragment,
+						})
+					}
+				}
+				continue
+			}
+			if v, ok := hit.Fields[field.Name].(string); ok {
+				value = v
+			}
+			m.Fields = append(m.Fields, &models.FieldMatch{
+				Field:     field.Name,
+				Value:     value,
+				Highlight: fragment,
+			})
+		}
+	}
+	return m
+}
+
+func stripHighlight(fragment string) string {
+	return strings.NewReplacer("<b>", "", "</b>", "").Replace(fragment)
+}
+
+func unmarshalRecord(hit *search.DocumentMatch, rec any) error {
+	data, _ := hit.Fields["record"].(string)
+	return json.Unmarshal([]byte(data), rec)
+}
+
+// syncPeople indexes all person changes since the position stored in index,
+// and stores the new position. It returns the number of changes.
+// progress, if not nil, is called with the number of changes of every batch.
+func (idx *Index) syncPeople(ctx context.Context, index bleve.Index, progress func(int)) (int, error) {
+	since, cursor, err := getPosition(index)
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+
+	var people []*models.Person
+	var tombstones []*models.Tombstone
+	if cursor == "" {
+		people, tombstones, cursor, err = idx.repository.GetPeopleChanges(ctx, since)
+	} else {
+		people, tombstones, cursor, err = idx.repository.GetMorePeopleChanges(ctx, since, cursor)
+	}
+	for {
+		if err != nil {
+			return n, err
+		}
+
+		batch := index.NewBatch()
+		for _, p := range people {
+			doc, err := newPersonDoc(p)
+			if err != nil {
+				return n, err
+			}
+			if err := batch.Index(p.ID, doc); err != nil {
+				return n, err
+			}
+		}
+		for _, t := range tombstones {
+			batch.Delete(t.ID)
+		}
+		if cursor != "" {
+			setPosition(batch, since, cursor)
+		}
+		if err := index.Batch(batch); err != nil {
+			return n, err
+		}
+		n += len(people) + len(tombstones)
+		if progress != nil {
+			progress(len(people) + len(tombstones))
+		}
+
+		if cursor == "" {
+			break
+		}
+		people, tombstones, cursor, err = idx.repository.GetMorePeopleChanges(ctx, since, cursor)
+	}
+
+	if n > 0 {
+		idx.logger.Infof("indexed %d person changes", n)
+	}
+
+	return n, nil
+}
+
+// syncOrganizations is the organization equivalent of syncPeople
+func (idx *Index) syncOrganizations(ctx context.Context, index bleve.Index, progress func(int)) (int, error) {
+	since, cursor, err := getPosition(index)
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+
+	var orgs []*models.Organization
+	var tombstones []*models.Tombstone
+	if cursor == "" {
+		orgs, tombstones, cursor, err = idx.repository.GetOrganizationsChanges(ctx, since)
+	} else {
+		orgs, tombstones, cursor, err = idx.repository.GetMoreOrganizationsChanges(ctx, since, cursor)
+	}
+	for {
+		if err != nil {
+			return n, err
+		}
+
+		batch := index.NewBatch()
+		for _, org := range orgs {
+			doc, err := newOrganizationDoc(org)
+			if err != nil {
+				return n, err
+			}
+			if err := batch.Index(org.ID, doc); err != nil {
+				return n, err
+			}
+		}
+		for _, t := range tombstones {
+			batch.Delete(t.ID)
+		}
+		if cursor != "" {
+			setPosition(batch, since, cursor)
+		}
+		if err := index.Batch(batch); err != nil {
+			return n, err
+		}
+		n += len(orgs) + len(tombstones)
+		if progress != nil {
+			progress(len(orgs) + len(tombstones))
+		}
+
+		if cursor == "" {
+			break
+		}
+		orgs, tombstones, cursor, err = idx.repository.GetMoreOrganizationsChanges(ctx, since, cursor)
+	}
+
+	if n > 0 {
+		idx.logger.Infof("indexed %d organization changes", n)
+	}
+
+	return n, nil
+}
+
+// getPosition returns the position of the last indexed change in the change feed:
+// the updated_since the feed was started with, and the cursor of the last page.
+// The cursor is empty as long as no page has been indexed after since.
+func getPosition(index bleve.Index) (time.Time, string, error) {
+	var since time.Time
+	val, err := index.GetInternal([]byte(sinceKey))
+	if err != nil {
+		return since, "", err
+	}
+	if val != nil {
+		if err := since.UnmarshalText(val); err != nil {
+			return since, "", err
+		}
+	}
+	cursor, err := index.GetInternal([]byte(cursorKey))
+	if err != nil {
+		return since, "", err
+	}
+	return since, string(cursor), nil
+}
+
+func setPosition(batch *bleve.Batch, since time.Time, cursor string) {
+	val, _ := since.MarshalText()
+	batch.SetInternal([]byte(sinceKey), val)
+	batch.SetInternal([]byte(cursorKey), []byte(cursor))
+}
@@ -2,8 +2,18 @@ package cli
 
 import (
 	"context"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/spf13/cobra"
+	"github.com/ugent-library/people-service/models"
+)
+
+var (
+	rebuildAutocompleteBatchSize int
+	rebuildAutocompleteRestart   bool
+	rebuildAutocompleteStaleOnly bool
 )
 
 var rebuildAutocompleteOrganizationsCmd = &cobra.Command{
@@ -15,10 +25,15 @@ var rebuildAutocompleteOrganizationsCmd = &cobra.Command{
 			return err
 		}
 
-		ctx, cancel := context.WithCancel(context.TODO())
+		svc, err := newRebuildService(repo)
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 		defer cancel()
 
-		return repo.RebuildAutocompleteOrganizations(ctx)
+		return svc.RebuildAutocompleteOrganizations(ctx, rebuildAutocompleteParams("organizations"))
 	},
 }
 
@@ -31,14 +46,40 @@ var rebuildAutocompletePeopleCmd = &cobra.Command{
 			return err
 		}
 
-		ctx, cancel := context.WithCancel(context.TODO())
+		svc, err := newRebuildService(repo)
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 		defer cancel()
 
-		return repo.RebuildAutocompletePeople(ctx)
+		return svc.RebuildAutocompletePeople(ctx, rebuildAutocompleteParams("people"))
 	},
 }
 
+// rebuildAutocompleteParams logs progress after every batch
+func rebuildAutocompleteParams(records string) models.RebuildParams {
+	return models.RebuildParams{
+		BatchSize: rebuildAutocompleteBatchSize,
+		Restart:   rebuildAutocompleteRestart,
+		StaleOnly: rebuildAutocompleteStaleOnly,
+		Progress: func(p models.RebuildProgress) {
+			if p.Total > 0 {
+				logger.Infof("processed %d/%d %s, updated %d (%.0f rows/s)", p.Processed, p.Total, records, p.Updated, p.RowsPerSecond())
+			} else {
+				logger.Infof("processed %d %s, updated %d (%.0f rows/s)", p.Processed, records, p.Updated, p.RowsPerSecond())
+			}
+		},
+	}
+}
+
 func init() {
+	for _, cmd := range []*cobra.Command{rebuildAutocompleteOrganizationsCmd, rebuildAutocompletePeopleCmd} {
+		cmd.Flags().IntVar(&rebuildAutocompleteBatchSize, "batch-size", models.DefaultRebuildBatchSize, "number of records updated per transaction")
+		cmd.Flags().BoolVar(&rebuildAutocompleteRestart, "restart", false, "start over instead of resuming an unfinished rebuild")
+		cmd.Flags().BoolVar(&rebuildAutocompleteStaleOnly, "stale-only", false, "only update records whose autocomplete values changed")
+	}
 	rootCmd.AddCommand(rebuildAutocompleteOrganizationsCmd)
 	rootCmd.AddCommand(rebuildAutocompletePeopleCmd)
 }
@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/ugent-library/people-service/webhooks"
+)
+
+var (
+	dispatchWebhooksConfig        webhooks.Config
+	dispatchWebhooksAllowNetworks []string
+)
+
+var dispatchWebhooksCmd = &cobra.Command{
+	Use:   "dispatch-webhooks",
+	Short: "deliver change events to webhook subscriptions",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		repo, err := newRepository()
+		if err != nil {
+			return err
+		}
+
+		for _, network := range dispatchWebhooksAllowNetworks {
+			prefix, err := netip.ParsePrefix(network)
+			if err != nil {
+				return fmt.Errorf("invalid network %q: %w", network, err)
+			}
+			dispatchWebhooksConfig.AllowNetworks = append(dispatchWebhooksConfig.AllowNetworks, prefix)
+		}
+
+		ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer cancel()
+
+		d := webhooks.NewDispatcher(repo, dispatchWebhooksConfig, logger)
+
+		logger.Info("dispatching webhook deliveries")
+		if err := d.Run(ctx); err != nil {
+			return err
+		}
+		logger.Info("stopped dispatching webhook deliveries")
+		return nil
+	},
+}
+
+func init() {
+	flags := dispatchWebhooksCmd.Flags()
+	flags.IntVar(&dispatchWebhooksConfig.BatchSize, "batch-size", 20, "maximum number of deliveries attempted at once")
+	flags.DurationVar(&dispatchWebhooksConfig.PollInterval, "poll-interval", time.Second, "time to wait when no delivery is due")
+	flags.DurationVar(&dispatchWebhooksConfig.Timeout, "timeout", 10*time.Second, "timeout of a single delivery request")
+	flags.IntVar(&dispatchWebhooksConfig.MaxAttempts, "max-attempts", 10, "number of attempts after which a delivery is marked failed")
+	flags.DurationVar(&dispatchWebhooksConfig.MinBackoff, "min-backoff", 30*time.Second, "delay after the first failed attempt, doubled after every next failure")
+	flags.DurationVar(&dispatchWebhooksConfig.MaxBackoff, "max-backoff", 6*time.Hour, "maximum delay between attempts")
+	flags.StringSliceVar(&dispatchWebhooksAllowNetworks, "allow-network", nil, "network in CIDR notation that can be posted to although it is not public, e.g. 10.0.0.0/8")
+	rootCmd.AddCommand(dispatchWebhooksCmd)
+}
@@ -0,0 +1,98 @@
+package cli
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/ugent-library/people-service/models"
+)
+
+var (
+	findDuplicatesFormat        string
+	findDuplicatesMinScore      float64
+	findDuplicatesNameThreshold float64
+	findDuplicatesLimit         int
+)
+
+type personDuplicate struct {
+	SurvivorID  string   `json:"survivor_id"`
+	DuplicateID string   `json:"duplicate_id"`
+	Score       float64  `json:"score"`
+	Reasons     []string `json:"reasons"`
+}
+
+var findDuplicatesCmd = &cobra.Command{
+	Use:   "find-duplicates",
+	Short: "Report person records that probably describe the same person",
+	Long: `Report pairs of person records that probably describe the same person, highest score first.
+Every pair lists the record to keep first. Pass the output to merge-people --from to merge all pairs.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if findDuplicatesFormat != "json" && findDuplicatesFormat != "csv" {
+			return fmt.Errorf("unknown format %q", findDuplicatesFormat)
+		}
+
+		repo, err := newRepository()
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithCancel(context.TODO())
+		defer cancel()
+
+		duplicates, err := repo.FindPersonDuplicates(ctx, models.DuplicateParams{
+			MinScore:      findDuplicatesMinScore,
+			NameThreshold: findDuplicatesNameThreshold,
+			Limit:         findDuplicatesLimit,
+		})
+		if err != nil {
+			return err
+		}
+
+		if findDuplicatesFormat == "csv" {
+			w := csv.NewWriter(os.Stdout)
+			if err := w.Write([]string{"survivor_id", "duplicate_id", "score", "reasons"}); err != nil {
+				return err
+			}
+			for _, d := range duplicates {
+				if err := w.Write([]string{
+					d.SurvivorID,
+					d.DuplicateID,
+					strconv.FormatFloat(d.Score, 'f', 2, 64),
+					strings.Join(d.Reasons, "; "),
+				}); err != nil {
+					return err
+				}
+			}
+			w.Flush()
+			return w.Error()
+		}
+
+		enc := json.NewEncoder(os.Stdout)
+		for _, d := range duplicates {
+			if err := enc.Encode(personDuplicate{
+				SurvivorID:  d.SurvivorID,
+				DuplicateID: d.DuplicateID,
+				Score:       d.Score,
+				Reasons:     d.Reasons,
+			}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	findDuplicatesCmd.Flags().StringVar(&findDuplicatesFormat, "format", "json", "output format: json (one pair per line) or csv")
+	findDuplicatesCmd.Flags().Float64Var(&findDuplicatesMinScore, "min-score", 0.6, "minimum score of a pair (0-1)")
+	findDuplicatesCmd.Flags().Float64Var(&findDuplicatesNameThreshold, "name-threshold", 0.8, "minimum similarity of two names to compare them (0-1)")
+	findDuplicatesCmd.Flags().IntVar(&findDuplicatesLimit, "limit", 0, "maximum number of pairs, 0 reports all pairs")
+	rootCmd.AddCommand(findDuplicatesCmd)
+}
@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var fsckCmd = &cobra.Command{
+	Use:   "fsck",
+	Short: "Check the organization hierarchy for invalid parent relations",
+	Long: `Report cycles, parent relations that end before they start
+and relations with the same parent with overlapping periods, as JSON lines.
+Exits with an error if any violation is found.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		repo, err := newRepository()
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithCancel(context.TODO())
+		defer cancel()
+
+		violations, err := repo.CheckOrganizationHierarchy(ctx)
+		if err != nil {
+			return err
+		}
+
+		enc := json.NewEncoder(os.Stdout)
+		for _, v := range violations {
+			if err := enc.Encode(v); err != nil {
+				return err
+			}
+		}
+
+		if len(violations) > 0 {
+			return fmt.Errorf("found %d violations", len(violations))
+		}
+		logger.Info("organization hierarchy is valid")
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(fsckCmd)
+}
@@ -0,0 +1,181 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+
+	"github.com/spf13/cobra"
+	"github.com/ugent-library/people-service/models"
+)
+
+var (
+	mergePeopleOnConflict string
+	mergePeopleFrom       string
+)
+
+var mergePeopleCmd = &cobra.Command{
+	Use:   "merge-people <survivor-id> <id>...",
+	Short: "Merge person records into the surviving person record",
+	Long: `Merge person records into the surviving person record.
+With --from, merge every pair reported by find-duplicates (json or csv) instead, e.g.:
+
+  people-service find-duplicates --min-score 0.8 | people-service merge-people --from -`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if mergePeopleFrom != "" {
+			return cobra.NoArgs(cmd, args)
+		}
+		return cobra.MinimumNArgs(2)(cmd, args)
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		repo, err := newRepository()
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithCancel(context.TODO())
+		defer cancel()
+		ctx = models.WithActor(ctx, cliActor())
+
+		params := models.MergeParams{
+			OnConflict: mergePeopleOnConflict,
+		}
+
+		if mergePeopleFrom != "" {
+			return mergePeoplePairs(ctx, repo, params)
+		}
+
+		person, err := repo.MergePeople(ctx, args[0], args[1:], params)
+		if err != nil {
+			return err
+		}
+
+		logger.Infof("merged person records %v into person record %s", args[1:], person.ID)
+
+		return nil
+	},
+}
+
+// mergePeoplePairs merges every pair read from mergePeopleFrom.
+// A record that was merged away by an earlier pair is replaced by its survivor.
+// A failed pair is logged and doesn't stop the other pairs.
+func mergePeoplePairs(ctx context.Context, repo models.Repository, params models.MergeParams) error {
+	var r io.Reader = os.Stdin
+	if mergePeopleFrom != "-" {
+		f, err := os.Open(mergePeopleFrom)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	pairs, err := readDuplicatePairs(r)
+	if err != nil {
+		return err
+	}
+
+	mergedInto := map[string]string{}
+	survivorOf := func(id string) string {
+		for mergedInto[id] != "" {
+			id = mergedInto[id]
+		}
+		return id
+	}
+
+	var failed int
+	for _, pair := range pairs {
+		survivorID, id := survivorOf(pair.SurvivorID), survivorOf(pair.DuplicateID)
+		if survivorID == id {
+			continue
+		}
+		if _, err := repo.MergePeople(ctx, survivorID, []string{id}, params); err != nil {
+			logger.Errorf("could not merge person record %s into person record %s: %s", id, survivorID, err)
+			failed++
+			continue
+		}
+		mergedInto[id] = survivorID
+		logger.Infof("merged person record %s into person record %s", id, survivorID)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d pairs could not be merged", failed, len(pairs))
+	}
+	return nil
+}
+
+// readDuplicatePairs reads the output of find-duplicates: one json object per line,
+// or csv with a header that has columns survivor_id and duplicate_id
+func readDuplicatePairs(r io.Reader) ([]personDuplicate, error) {
+	br := bufio.NewReader(r)
+	first, err := firstNonSpace(br)
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var pairs []personDuplicate
+
+	if first == '{' {
+		dec := json.NewDecoder(br)
+		for {
+			var pair personDuplicate
+			if err := dec.Decode(&pair); errors.Is(err, io.EOF) {
+				break
+			} else if err != nil {
+				return nil, err
+			}
+			pairs = append(pairs, pair)
+		}
+	} else {
+		records, err := csv.NewReader(br).ReadAll()
+		if err != nil {
+			return nil, err
+		}
+		survivorCol := slices.Index(records[0], "survivor_id")
+		duplicateCol := slices.Index(records[0], "duplicate_id")
+		if survivorCol < 0 || duplicateCol < 0 {
+			return nil, errors.New("csv header has no survivor_id and duplicate_id columns")
+		}
+		for _, rec := range records[1:] {
+			pairs = append(pairs, personDuplicate{SurvivorID: rec[survivorCol], DuplicateID: rec[duplicateCol]})
+		}
+	}
+
+	for i, pair := range pairs {
+		if pair.SurvivorID == "" || pair.DuplicateID == "" {
+			return nil, fmt.Errorf("pair %d: survivor_id and duplicate_id are required", i+1)
+		}
+	}
+
+	return pairs, nil
+}
+
+// firstNonSpace peeks at the first character that is not white space
+func firstNonSpace(r *bufio.Reader) (byte, error) {
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		if b != ' ' && b != '\t' && b != '\r' && b != '\n' {
+			return b, r.UnreadByte()
+		}
+	}
+}
+
+func init() {
+	mergePeopleCmd.Flags().StringVar(&mergePeopleOnConflict, "on-conflict", models.MergeKeepSurvivor,
+		"how to resolve a different orcid, token or setting: survivor, newest or fail")
+	mergePeopleCmd.Flags().StringVar(&mergePeopleFrom, "from", "",
+		"file with the output of find-duplicates to merge, - reads from stdin")
+	rootCmd.AddCommand(mergePeopleCmd)
+}
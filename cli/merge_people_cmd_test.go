@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadDuplicatePairs(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []personDuplicate
+		isErr bool
+	}{
+		{
+			name:  "empty",
+			input: "\n",
+		},
+		{
+			name: "json lines",
+			input: `{"survivor_id":"a","duplicate_id":"b","score":0.9,"reasons":["same email"]}
+{"survivor_id":"a","duplicate_id":"c","score":0.8,"reasons":[]}
+`,
+			want: []personDuplicate{
+				{SurvivorID: "a", DuplicateID: "b", Score: 0.9, Reasons: []string{"same email"}},
+				{SurvivorID: "a", DuplicateID: "c", Score: 0.8, Reasons: []string{}},
+			},
+		},
+		{
+			name: "csv",
+			input: `survivor_id,duplicate_id,score,reasons
+a,b,0.90,"name similarity 1.00; same email"
+`,
+			want: []personDuplicate{{SurvivorID: "a", DuplicateID: "b"}},
+		},
+		{
+			name:  "csv without id columns",
+			input: "a,b\nc,d\n",
+			isErr: true,
+		},
+		{
+			name:  "missing id",
+			input: `{"survivor_id":"a"}`,
+			isErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pairs, err := readDuplicatePairs(strings.NewReader(tt.input))
+			if tt.isErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(pairs, tt.want) {
+				t.Errorf("got %+v, want %+v", pairs, tt.want)
+			}
+		})
+	}
+}
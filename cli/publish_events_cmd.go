@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/spf13/cobra"
+	"github.com/ugent-library/people-service/publisher"
+)
+
+var (
+	publishEventsBatchSize    int
+	publishEventsPollInterval time.Duration
+	publishEventsRetention    int
+)
+
+var publishEventsCmd = &cobra.Command{
+	Use:   "publish-events",
+	Short: "relay change events from the outbox to NATS JetStream",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		repo, err := newRepository()
+		if err != nil {
+			return err
+		}
+
+		nc, err := nats.Connect(config.Nats.Url)
+		if err != nil {
+			return err
+		}
+		defer nc.Drain()
+
+		js, err := jetstream.New(nc)
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer cancel()
+
+		p := publisher.NewPublisher(repo, js, publisher.Config{
+			Stream:       config.Nats.Stream,
+			BatchSize:    publishEventsBatchSize,
+			PollInterval: publishEventsPollInterval,
+			Retention:    time.Duration(publishEventsRetention) * 24 * time.Hour,
+		}, logger)
+
+		if err := p.EnsureStream(ctx); err != nil {
+			return err
+		}
+
+		logger.Infof("publishing events to stream %s at %s", config.Nats.Stream, config.Nats.Url)
+		if err := p.Run(ctx); err != nil {
+			return err
+		}
+		logger.Info("stopped publishing events")
+		return nil
+	},
+}
+
+func init() {
+	publishEventsCmd.Flags().IntVar(&publishEventsBatchSize, "batch-size", 100, "maximum number of events taken from the outbox at once")
+	publishEventsCmd.Flags().DurationVar(&publishEventsPollInterval, "poll-interval", time.Second, "time to wait when the outbox is empty")
+	publishEventsCmd.Flags().IntVar(&publishEventsRetention, "retention-days", 30, "number of days published events are kept in the outbox, 0 keeps them forever")
+	rootCmd.AddCommand(publishEventsCmd)
+}
@@ -1,18 +1,114 @@
 package cli
 
 import (
+	"context"
+	"fmt"
+	"os/user"
+
+	"github.com/ugent-library/people-service/bleveindex"
 	"github.com/ugent-library/people-service/models"
 	"github.com/ugent-library/people-service/repository"
 	"github.com/ugent-library/people-service/ugentldap"
 )
 
 func newRepository() (models.Repository, error) {
+	personTsFields, err := repository.ParsePersonTsFields(config.Search.PersonFields)
+	if err != nil {
+		return nil, err
+	}
+	organizationTsFields, err := repository.ParseOrganizationTsFields(config.Search.OrganizationFields)
+	if err != nil {
+		return nil, err
+	}
 	return repository.NewRepository(&repository.Config{
-		DbUrl:  config.Db.Url,
-		AesKey: config.Db.AesKey,
+		DbUrl:                config.Db.Url,
+		AesKey:               config.Db.AesKey,
+		PersonTsFields:       personTsFields,
+		OrganizationTsFields: organizationTsFields,
 	})
 }
 
+// search backends for ConfigSearch.Backend
+const (
+	searchBackendPostgres = "postgres"
+	searchBackendBleve    = "bleve"
+)
+
+type suggestService interface {
+	models.PersonSuggestService
+	models.OrganizationSuggestService
+}
+
+// newSuggestService returns the configured search backend, and a function to release it
+func newSuggestService(repo models.Repository) (suggestService, func() error, error) {
+	switch config.Search.Backend {
+	case searchBackendPostgres:
+		return repo, func() error { return nil }, nil
+	case searchBackendBleve:
+		idx, err := newBleveIndex(repo)
+		if err != nil {
+			return nil, nil, err
+		}
+		return idx, idx.Close, nil
+	}
+	return nil, nil, fmt.Errorf("unknown search backend %q", config.Search.Backend)
+}
+
+type rebuildService interface {
+	RebuildAutocompletePeople(context.Context, models.RebuildParams) error
+	RebuildAutocompleteOrganizations(context.Context, models.RebuildParams) error
+}
+
+// newRebuildService returns the configured search backend for a rebuild.
+// A bleve index is rebuilt without opening it, so that the server can keep using it.
+func newRebuildService(repo models.Repository) (rebuildService, error) {
+	switch config.Search.Backend {
+	case searchBackendPostgres:
+		return repo, nil
+	case searchBackendBleve:
+		bleveConfig, err := newBleveConfig()
+		if err != nil {
+			return nil, err
+		}
+		return bleveindex.NewBuilder(repo, bleveConfig, logger), nil
+	}
+	return nil, fmt.Errorf("unknown search backend %q", config.Search.Backend)
+}
+
+func newBleveIndex(repo models.Repository) (*bleveindex.Index, error) {
+	bleveConfig, err := newBleveConfig()
+	if err != nil {
+		return nil, err
+	}
+	return bleveindex.New(repo, bleveConfig, logger)
+}
+
+func newBleveConfig() (bleveindex.Config, error) {
+	personTsFields, err := repository.ParsePersonTsFields(config.Search.PersonFields)
+	if err != nil {
+		return bleveindex.Config{}, err
+	}
+	organizationTsFields, err := repository.ParseOrganizationTsFields(config.Search.OrganizationFields)
+	if err != nil {
+		return bleveindex.Config{}, err
+	}
+	return bleveindex.Config{
+		Path:               config.Search.IndexPath,
+		PersonFields:       personTsFields,
+		OrganizationFields: organizationTsFields,
+		SyncInterval:       config.Search.SyncInterval,
+	}, nil
+}
+
+// cliActor attributes changes made by a command to the system user that runs it
+func cliActor() models.Actor {
+	actor := models.Actor{Source: models.ActorSourceCLI}
+	if u, err := user.Current(); err == nil {
+		actor.ID = u.Username
+	}
+	return actor
+}
+
 func newUgentLdapClient() *ugentldap.Client {
 	return ugentldap.NewClient(ugentldap.Config{
 		Url:      config.Ldap.Url,
@@ -0,0 +1,125 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/ugent-library/people-service/models"
+)
+
+var (
+	revertType        string
+	revertID          string
+	revertVersion     int
+	revertActorSource string
+	revertActorID     string
+	revertFrom        string
+	revertUntil       string
+	revertForce       bool
+	revertDryRun      bool
+)
+
+type revertResult struct {
+	ID       string                `json:"id"`
+	Version  int                   `json:"version"`
+	Status   string                `json:"status"`
+	Changes  []*models.FieldChange `json:"changes"`
+	Warnings []string              `json:"warnings,omitempty"`
+	Error    string                `json:"error,omitempty"`
+}
+
+var revertCmd = &cobra.Command{
+	Use:   "revert",
+	Short: "Revert records to an earlier revision",
+	Long: `Revert a single record to a given revision (--id and --version),
+or revert every record an actor changed within [--from, --until) to the state before that change
+(--actor-source, --actor-id, --from and --until). Results are printed as JSON lines.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		params := models.RevertParams{
+			ID:      revertID,
+			Version: revertVersion,
+			Actor: models.Actor{
+				Source: revertActorSource,
+				ID:     revertActorID,
+			},
+			Force:  revertForce,
+			DryRun: revertDryRun,
+		}
+		if revertFrom != "" {
+			t, err := time.Parse(time.RFC3339, revertFrom)
+			if err != nil {
+				return fmt.Errorf("invalid --from: %w", err)
+			}
+			params.From = t
+		}
+		if revertUntil != "" {
+			t, err := time.Parse(time.RFC3339, revertUntil)
+			if err != nil {
+				return fmt.Errorf("invalid --until: %w", err)
+			}
+			params.Until = t
+		}
+
+		repo, err := newRepository()
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithCancel(context.TODO())
+		defer cancel()
+		ctx = models.WithActor(ctx, cliActor())
+
+		var results []*models.RevertResult
+		switch revertType {
+		case "person":
+			results, err = repo.RevertPeople(ctx, params)
+		case "organization":
+			results, err = repo.RevertOrganizations(ctx, params)
+		default:
+			return fmt.Errorf("unknown record type %q", revertType)
+		}
+		if err != nil {
+			return err
+		}
+
+		enc := json.NewEncoder(os.Stdout)
+		for _, result := range results {
+			r := revertResult{
+				ID:       result.ID,
+				Version:  result.Version,
+				Status:   result.Status,
+				Changes:  result.Changes,
+				Warnings: result.Warnings,
+			}
+			if result.Error != nil {
+				r.Error = result.Error.Error()
+			}
+			if err := enc.Encode(r); err != nil {
+				return err
+			}
+		}
+
+		if revertDryRun {
+			logger.Infof("dry run: %d records checked, nothing was changed", len(results))
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	revertCmd.Flags().StringVar(&revertType, "type", "person", "record type: person or organization")
+	revertCmd.Flags().StringVar(&revertID, "id", "", "id of the record to revert")
+	revertCmd.Flags().IntVar(&revertVersion, "version", 0, "version of the revision to restore")
+	revertCmd.Flags().StringVar(&revertActorSource, "actor-source", "", "revert changes made by this actor source (api, ldapsync or cli)")
+	revertCmd.Flags().StringVar(&revertActorID, "actor-id", "", "revert changes made by this actor id only")
+	revertCmd.Flags().StringVar(&revertFrom, "from", "", "start of the time window (RFC3339)")
+	revertCmd.Flags().StringVar(&revertUntil, "until", "", "end of the time window, exclusive (RFC3339)")
+	revertCmd.Flags().BoolVar(&revertForce, "force", false, "also revert records that were changed by others afterwards, and restore merged records")
+	revertCmd.Flags().BoolVar(&revertDryRun, "dry-run", false, "report what would change without changing anything")
+	rootCmd.AddCommand(revertCmd)
+}
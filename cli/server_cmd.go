@@ -2,6 +2,8 @@ package cli
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"net"
@@ -16,6 +18,8 @@ import (
 	"github.com/ory/graceful"
 	"github.com/spf13/cobra"
 	"github.com/ugent-library/people-service/api/v1"
+	"github.com/ugent-library/people-service/bleveindex"
+	"github.com/ugent-library/people-service/models"
 	"github.com/ugent-library/people-service/public"
 	"github.com/ugent-library/zaphttp"
 	"github.com/ugent-library/zaphttp/zapchi"
@@ -36,11 +40,20 @@ type apiSecurityHandler struct {
 
 func (s *apiSecurityHandler) HandleApiKey(ctx context.Context, operationName string, t api.ApiKey) (context.Context, error) {
 	if t.APIKey == s.APIKey {
-		return ctx, nil
+		return models.WithActor(ctx, models.Actor{
+			Source: models.ActorSourceAPI,
+			ID:     apiKeyFingerprint(t.APIKey),
+		}), nil
 	}
 	return ctx, errors.New("unauthorized")
 }
 
+// apiKeyFingerprint identifies an api key in the revision history without revealing it
+func apiKeyFingerprint(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:6])
+}
+
 var serverCmd = &cobra.Command{
 	Use:   "server",
 	Short: "start the openapi server",
@@ -86,8 +99,25 @@ var serverCmd = &cobra.Command{
 			})
 		}
 
+		svc, closeSvc, err := newSuggestService(repo)
+		if err != nil {
+			return err
+		}
+		defer closeSvc()
+
+		// keep an embedded search index up to date with the change feed
+		if idx, ok := svc.(*bleveindex.Index); ok {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			go idx.Run(ctx)
+		}
+
 		apiServer, err := api.NewServer(
-			api.NewService(repo),
+			api.NewService(
+				repo,
+				api.WithPersonSuggestService(svc),
+				api.WithOrganizationSuggestService(svc),
+			),
 			&apiSecurityHandler{APIKey: config.Api.Key},
 			api.WithErrorHandler(func(ctx context.Context, w http.ResponseWriter, r *http.Request, err error) {
 				status := ogenerrors.ErrorCode(err)
@@ -0,0 +1,12 @@
+-- record versions, used as precondition for updates
+
+ALTER TABLE "organizations"
+  ADD COLUMN IF NOT EXISTS "version" bigint NOT NULL DEFAULT 1;
+
+ALTER TABLE "people"
+  ADD COLUMN IF NOT EXISTS "version" bigint NOT NULL DEFAULT 1;
+
+---- create above / drop below ----
+
+ALTER TABLE "organizations" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "people" DROP COLUMN IF EXISTS "version";
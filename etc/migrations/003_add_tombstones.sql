@@ -0,0 +1,24 @@
+-- tombstones of deleted and merged records, so that old references can be resolved
+
+CREATE TABLE IF NOT EXISTS "person_tombstones" (
+  "external_id" text PRIMARY KEY,
+  "date_deleted" timestamptz NOT NULL,
+  "reason" text NOT NULL,
+  "successor_id" text
+);
+
+CREATE INDEX IF NOT EXISTS "person_tombstones_successor_id_idx" ON "person_tombstones" ("successor_id");
+
+CREATE TABLE IF NOT EXISTS "organization_tombstones" (
+  "external_id" text PRIMARY KEY,
+  "date_deleted" timestamptz NOT NULL,
+  "reason" text NOT NULL,
+  "successor_id" text
+);
+
+CREATE INDEX IF NOT EXISTS "organization_tombstones_successor_id_idx" ON "organization_tombstones" ("successor_id");
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS "person_tombstones";
+DROP TABLE IF EXISTS "organization_tombstones";
@@ -0,0 +1,13 @@
+-- indexes for paging through changes by ("date_updated", "external_id")
+
+CREATE INDEX IF NOT EXISTS "people_date_updated_idx" ON "people" ("date_updated", "external_id");
+CREATE INDEX IF NOT EXISTS "organizations_date_updated_idx" ON "organizations" ("date_updated", "external_id");
+CREATE INDEX IF NOT EXISTS "person_tombstones_date_deleted_idx" ON "person_tombstones" ("date_deleted", "external_id");
+CREATE INDEX IF NOT EXISTS "organization_tombstones_date_deleted_idx" ON "organization_tombstones" ("date_deleted", "external_id");
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS "people_date_updated_idx";
+DROP INDEX IF EXISTS "organizations_date_updated_idx";
+DROP INDEX IF EXISTS "person_tombstones_date_deleted_idx";
+DROP INDEX IF EXISTS "organization_tombstones_date_deleted_idx";
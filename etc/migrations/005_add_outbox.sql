@@ -0,0 +1,20 @@
+-- transactional outbox of change events, relayed to NATS JetStream
+
+CREATE TABLE IF NOT EXISTS "outbox" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "event_id" text NOT NULL,
+  "type" text NOT NULL,
+  "record_id" text NOT NULL,
+  "date_created" timestamptz NOT NULL,
+  "data" jsonb NOT NULL,
+  "date_published" timestamptz NULL,
+  PRIMARY KEY ("id")
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "outbox_event_id_key" ON "outbox" ("event_id");
+
+CREATE INDEX IF NOT EXISTS "outbox_unpublished_idx" ON "outbox" ("id") WHERE "date_published" IS NULL;
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS "outbox";
@@ -0,0 +1,58 @@
+-- webhook subscriptions, and a delivery per change event and subscription
+
+CREATE TABLE IF NOT EXISTS "webhook_subscriptions" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "external_id" text NOT NULL,
+  "date_created" timestamptz NOT NULL,
+  "date_updated" timestamptz NOT NULL,
+  "url" text NOT NULL,
+  "event_type" jsonb NOT NULL DEFAULT '[]',
+  "secret" text NOT NULL,
+  PRIMARY KEY ("id")
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "webhook_subscriptions_external_id_key" ON "webhook_subscriptions" ("external_id");
+
+CREATE TABLE IF NOT EXISTS "webhook_deliveries" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "subscription_id" bigint NOT NULL,
+  "event_id" text NOT NULL,
+  "event_type" text NOT NULL,
+  "data" jsonb NOT NULL,
+  "date_created" timestamptz NOT NULL,
+  "status" text NOT NULL,
+  "attempts" integer NOT NULL DEFAULT 0,
+  "next_attempt" timestamptz NULL,
+  "date_delivered" timestamptz NULL,
+  PRIMARY KEY ("id")
+);
+
+ALTER TABLE "webhook_deliveries"
+    ADD CONSTRAINT "webhook_deliveries_subscription_id_fkey"
+    FOREIGN KEY ("subscription_id") REFERENCES "webhook_subscriptions" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+
+CREATE UNIQUE INDEX IF NOT EXISTS "webhook_deliveries_key" ON "webhook_deliveries" ("subscription_id", "event_id");
+
+CREATE INDEX IF NOT EXISTS "webhook_deliveries_pending_idx" ON "webhook_deliveries" ("next_attempt") WHERE "status" = 'pending';
+
+CREATE TABLE IF NOT EXISTS "webhook_delivery_attempts" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "delivery_id" bigint NOT NULL,
+  "date_attempted" timestamptz NOT NULL,
+  "status_code" integer NULL,
+  "error" text NULL,
+  "duration_ms" integer NOT NULL,
+  PRIMARY KEY ("id")
+);
+
+ALTER TABLE "webhook_delivery_attempts"
+    ADD CONSTRAINT "webhook_delivery_attempts_delivery_id_fkey"
+    FOREIGN KEY ("delivery_id") REFERENCES "webhook_deliveries" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS "webhook_delivery_attempts_delivery_id_idx" ON "webhook_delivery_attempts" ("delivery_id");
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS "webhook_delivery_attempts";
+DROP TABLE IF EXISTS "webhook_deliveries";
+DROP TABLE IF EXISTS "webhook_subscriptions";
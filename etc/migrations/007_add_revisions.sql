@@ -0,0 +1,35 @@
+-- revision history: a snapshot of the record on every change
+-- P.S. records that are not changed after this migration have no revisions yet
+
+CREATE TABLE IF NOT EXISTS "person_revisions" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "external_id" text NOT NULL,
+  "version" bigint NOT NULL,
+  "date_created" timestamptz NOT NULL,
+  "type" text NOT NULL,
+  "actor_source" text NOT NULL,
+  "actor_id" text NULL,
+  "data" jsonb NULL,
+  PRIMARY KEY ("id")
+);
+
+CREATE INDEX IF NOT EXISTS "person_revisions_external_id_idx" ON "person_revisions" ("external_id", "id");
+
+CREATE TABLE IF NOT EXISTS "organization_revisions" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "external_id" text NOT NULL,
+  "version" bigint NOT NULL,
+  "date_created" timestamptz NOT NULL,
+  "type" text NOT NULL,
+  "actor_source" text NOT NULL,
+  "actor_id" text NULL,
+  "data" jsonb NULL,
+  PRIMARY KEY ("id")
+);
+
+CREATE INDEX IF NOT EXISTS "organization_revisions_external_id_idx" ON "organization_revisions" ("external_id", "id");
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS "person_revisions";
+DROP TABLE IF EXISTS "organization_revisions";
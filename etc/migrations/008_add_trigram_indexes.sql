@@ -0,0 +1,20 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- unaccent is only STABLE (its dictionary can change), so it cannot be used in an index.
+-- This wrapper pins the dictionary and is safe to mark IMMUTABLE.
+CREATE OR REPLACE FUNCTION f_unaccent(text) RETURNS text
+  LANGUAGE sql IMMUTABLE PARALLEL SAFE STRICT
+AS $$ SELECT public.unaccent('public.unaccent'::regdictionary, $1) $$;
+
+CREATE INDEX IF NOT EXISTS "people_name_trgm_idx" ON "people" USING GIN (f_unaccent(lower("name")) gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS "organizations_name_dut_trgm_idx" ON "organizations" USING GIN (f_unaccent(lower("name_dut")) gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS "organizations_name_eng_trgm_idx" ON "organizations" USING GIN (f_unaccent(lower("name_eng")) gin_trgm_ops);
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS "people_name_trgm_idx";
+DROP INDEX IF EXISTS "organizations_name_dut_trgm_idx";
+DROP INDEX IF EXISTS "organizations_name_eng_trgm_idx";
+DROP FUNCTION IF EXISTS f_unaccent(text);
@@ -0,0 +1,56 @@
+-- "ts_vals" becomes an object that holds the values to index per weight: {"A": [...], "B": [...], "C": [...], "D": [...]}
+-- existing values get weight A until the autocomplete index is rebuilt
+
+DROP INDEX IF EXISTS organizations_ts_idx;
+
+ALTER TABLE organizations DROP COLUMN IF EXISTS ts;
+
+UPDATE organizations SET ts_vals = jsonb_build_object('A', ts_vals) WHERE jsonb_typeof(ts_vals) = 'array';
+
+ALTER TABLE organizations
+  ADD COLUMN ts tsvector GENERATED ALWAYS AS
+  (
+    setweight(to_tsvector('usimple', COALESCE(ts_vals->'A', '[]'::jsonb)), 'A') ||
+    setweight(to_tsvector('usimple', COALESCE(ts_vals->'B', '[]'::jsonb)), 'B') ||
+    setweight(to_tsvector('usimple', COALESCE(ts_vals->'C', '[]'::jsonb)), 'C') ||
+    setweight(to_tsvector('usimple', COALESCE(ts_vals->'D', '[]'::jsonb)), 'D')
+  ) STORED;
+
+CREATE INDEX organizations_ts_idx ON organizations USING GIN(ts);
+
+DROP INDEX IF EXISTS people_ts_idx;
+
+ALTER TABLE people DROP COLUMN IF EXISTS ts;
+
+UPDATE people SET ts_vals = jsonb_build_object('A', ts_vals) WHERE jsonb_typeof(ts_vals) = 'array';
+
+ALTER TABLE people
+  ADD COLUMN ts tsvector GENERATED ALWAYS AS
+  (
+    setweight(to_tsvector('usimple', COALESCE(ts_vals->'A', '[]'::jsonb)), 'A') ||
+    setweight(to_tsvector('usimple', COALESCE(ts_vals->'B', '[]'::jsonb)), 'B') ||
+    setweight(to_tsvector('usimple', COALESCE(ts_vals->'C', '[]'::jsonb)), 'C') ||
+    setweight(to_tsvector('usimple', COALESCE(ts_vals->'D', '[]'::jsonb)), 'D')
+  ) STORED;
+
+CREATE INDEX people_ts_idx ON people USING GIN(ts);
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS organizations_ts_idx;
+ALTER TABLE organizations DROP COLUMN IF EXISTS ts;
+UPDATE organizations SET ts_vals = (
+  SELECT COALESCE(jsonb_agg(v), '[]'::jsonb) FROM jsonb_each(ts_vals) AS w, jsonb_array_elements(w.value) AS v
+) WHERE jsonb_typeof(ts_vals) = 'object';
+ALTER TABLE organizations
+  ADD COLUMN ts tsvector GENERATED ALWAYS AS (to_tsvector('usimple', ts_vals)) STORED;
+CREATE INDEX organizations_ts_idx ON organizations USING GIN(ts);
+
+DROP INDEX IF EXISTS people_ts_idx;
+ALTER TABLE people DROP COLUMN IF EXISTS ts;
+UPDATE people SET ts_vals = (
+  SELECT COALESCE(jsonb_agg(v), '[]'::jsonb) FROM jsonb_each(ts_vals) AS w, jsonb_array_elements(w.value) AS v
+) WHERE jsonb_typeof(ts_vals) = 'object';
+ALTER TABLE people
+  ADD COLUMN ts tsvector GENERATED ALWAYS AS (to_tsvector('usimple', ts_vals)) STORED;
+CREATE INDEX people_ts_idx ON people USING GIN(ts);
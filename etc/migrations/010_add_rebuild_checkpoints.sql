@@ -0,0 +1,12 @@
+-- last processed row of an unfinished autocomplete rebuild, so that the next run can resume
+
+CREATE TABLE IF NOT EXISTS "rebuild_checkpoints" (
+  "name" text NOT NULL,
+  "last_id" bigint NOT NULL,
+  "date_updated" timestamptz NOT NULL,
+  PRIMARY KEY ("name")
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS "rebuild_checkpoints";
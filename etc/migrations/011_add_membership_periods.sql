@@ -0,0 +1,30 @@
+-- memberships get a period, type, fte and source.
+-- a person can be a member of the same organization several times, with a different type or start
+
+ALTER TABLE "organization_members"
+  ADD COLUMN IF NOT EXISTS "from" timestamptz NULL,
+  ADD COLUMN IF NOT EXISTS "until" timestamptz NULL,
+  ADD COLUMN IF NOT EXISTS "type" text NULL,
+  ADD COLUMN IF NOT EXISTS "fte" double precision NULL,
+  ADD COLUMN IF NOT EXISTS "source" text NULL;
+
+DROP INDEX IF EXISTS "organization_members_key";
+
+CREATE UNIQUE INDEX "organization_members_key" ON "organization_members"
+  ("person_id", "organization_id", COALESCE("type", ''), COALESCE("from", '-infinity'::timestamptz));
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS "organization_members_key";
+
+DELETE FROM "organization_members" AS "a" USING "organization_members" AS "b"
+WHERE "a"."person_id" = "b"."person_id" AND "a"."organization_id" = "b"."organization_id" AND "a"."id" > "b"."id";
+
+CREATE UNIQUE INDEX "organization_members_key" ON "organization_members" ("person_id", "organization_id");
+
+ALTER TABLE "organization_members"
+  DROP COLUMN IF EXISTS "from",
+  DROP COLUMN IF EXISTS "until",
+  DROP COLUMN IF EXISTS "type",
+  DROP COLUMN IF EXISTS "fte",
+  DROP COLUMN IF EXISTS "source";
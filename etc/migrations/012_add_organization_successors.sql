@@ -0,0 +1,28 @@
+-- organization_successors links an organization that was merged or split
+-- to the organizations that replace it from "date" on
+
+CREATE TABLE "organization_successors" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "date_created" timestamptz NOT NULL,
+  "date_updated" timestamptz NOT NULL,
+  "organization_id" bigint NOT NULL,
+  "successor_organization_id" bigint NOT NULL,
+  "date" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+
+ALTER TABLE "organization_successors"
+    ADD CONSTRAINT "organization_successors_organization_id_fkey"
+    FOREIGN KEY ("organization_id") REFERENCES "organizations" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+
+ALTER TABLE "organization_successors"
+    ADD CONSTRAINT "organization_successors_successor_organization_id_fkey"
+    FOREIGN KEY ("successor_organization_id") REFERENCES "organizations" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+
+CREATE INDEX "organization_successors_successor_organization_id_idx" ON "organization_successors" ("successor_organization_id");
+
+CREATE UNIQUE INDEX "organization_successors_key" ON "organization_successors" ("organization_id", "successor_organization_id");
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS "organization_successors" CASCADE;
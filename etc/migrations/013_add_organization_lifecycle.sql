@@ -0,0 +1,16 @@
+-- organizations get a validity of their own.
+-- dissolved organizations are excluded from suggest and search by default
+
+ALTER TABLE "organizations"
+  ADD COLUMN IF NOT EXISTS "founded" timestamptz NULL,
+  ADD COLUMN IF NOT EXISTS "dissolved" timestamptz NULL;
+
+CREATE INDEX "organizations_dissolved_idx" ON "organizations" ("dissolved") WHERE "dissolved" IS NOT NULL;
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS "organizations_dissolved_idx";
+
+ALTER TABLE "organizations"
+  DROP COLUMN IF EXISTS "founded",
+  DROP COLUMN IF EXISTS "dissolved";
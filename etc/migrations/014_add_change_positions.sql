@@ -0,0 +1,89 @@
+-- the change feed pages by commit order instead of by "date_updated":
+-- every change is stamped with the id of its transaction and a sequence number.
+-- a transaction id is only handed out to readers once all older transactions
+-- have finished, so a long running transaction can delay, but never skip, a change.
+
+CREATE SEQUENCE IF NOT EXISTS "change_seq";
+
+CREATE OR REPLACE FUNCTION "stamp_change"() RETURNS trigger AS $$
+BEGIN
+  NEW."change_xid" := pg_current_xact_id()::text::bigint;
+  NEW."change_seq" := nextval('change_seq');
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+ALTER TABLE "people"
+  ADD COLUMN IF NOT EXISTS "change_xid" bigint NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS "change_seq" bigint NOT NULL DEFAULT 0;
+ALTER TABLE "organizations"
+  ADD COLUMN IF NOT EXISTS "change_xid" bigint NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS "change_seq" bigint NOT NULL DEFAULT 0;
+ALTER TABLE "person_tombstones"
+  ADD COLUMN IF NOT EXISTS "change_xid" bigint NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS "change_seq" bigint NOT NULL DEFAULT 0;
+ALTER TABLE "organization_tombstones"
+  ADD COLUMN IF NOT EXISTS "change_xid" bigint NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS "change_seq" bigint NOT NULL DEFAULT 0;
+
+-- existing rows keep their order by date
+UPDATE "people" SET "change_seq" = s."seq"
+FROM (SELECT "id", row_number() OVER (ORDER BY "date_updated", "external_id") AS "seq" FROM "people") AS s
+WHERE "people"."id" = s."id";
+UPDATE "organizations" SET "change_seq" = s."seq"
+FROM (SELECT "id", row_number() OVER (ORDER BY "date_updated", "external_id") AS "seq" FROM "organizations") AS s
+WHERE "organizations"."id" = s."id";
+UPDATE "person_tombstones" SET "change_seq" = s."seq"
+FROM (SELECT "external_id", (SELECT count(*) FROM "people") + row_number() OVER (ORDER BY "date_deleted", "external_id") AS "seq" FROM "person_tombstones") AS s
+WHERE "person_tombstones"."external_id" = s."external_id";
+UPDATE "organization_tombstones" SET "change_seq" = s."seq"
+FROM (SELECT "external_id", (SELECT count(*) FROM "organizations") + row_number() OVER (ORDER BY "date_deleted", "external_id") AS "seq" FROM "organization_tombstones") AS s
+WHERE "organization_tombstones"."external_id" = s."external_id";
+
+SELECT setval('change_seq', GREATEST(
+  (SELECT count(*) FROM "people") + (SELECT count(*) FROM "person_tombstones"),
+  (SELECT count(*) FROM "organizations") + (SELECT count(*) FROM "organization_tombstones"),
+  1
+));
+
+-- only real changes move a record in the feed, not e.g. a rebuild of "ts_vals"
+CREATE TRIGGER "people_stamp_change_insert" BEFORE INSERT ON "people"
+  FOR EACH ROW EXECUTE FUNCTION "stamp_change"();
+CREATE TRIGGER "people_stamp_change_update" BEFORE UPDATE ON "people"
+  FOR EACH ROW WHEN (NEW."date_updated" IS DISTINCT FROM OLD."date_updated") EXECUTE FUNCTION "stamp_change"();
+CREATE TRIGGER "organizations_stamp_change_insert" BEFORE INSERT ON "organizations"
+  FOR EACH ROW EXECUTE FUNCTION "stamp_change"();
+CREATE TRIGGER "organizations_stamp_change_update" BEFORE UPDATE ON "organizations"
+  FOR EACH ROW WHEN (NEW."date_updated" IS DISTINCT FROM OLD."date_updated") EXECUTE FUNCTION "stamp_change"();
+CREATE TRIGGER "person_tombstones_stamp_change_insert" BEFORE INSERT ON "person_tombstones"
+  FOR EACH ROW EXECUTE FUNCTION "stamp_change"();
+CREATE TRIGGER "person_tombstones_stamp_change_update" BEFORE UPDATE ON "person_tombstones"
+  FOR EACH ROW WHEN (NEW."date_deleted" IS DISTINCT FROM OLD."date_deleted") EXECUTE FUNCTION "stamp_change"();
+CREATE TRIGGER "organization_tombstones_stamp_change_insert" BEFORE INSERT ON "organization_tombstones"
+  FOR EACH ROW EXECUTE FUNCTION "stamp_change"();
+CREATE TRIGGER "organization_tombstones_stamp_change_update" BEFORE UPDATE ON "organization_tombstones"
+  FOR EACH ROW WHEN (NEW."date_deleted" IS DISTINCT FROM OLD."date_deleted") EXECUTE FUNCTION "stamp_change"();
+
+CREATE INDEX IF NOT EXISTS "people_change_idx" ON "people" ("change_xid", "change_seq");
+CREATE INDEX IF NOT EXISTS "organizations_change_idx" ON "organizations" ("change_xid", "change_seq");
+CREATE INDEX IF NOT EXISTS "person_tombstones_change_idx" ON "person_tombstones" ("change_xid", "change_seq");
+CREATE INDEX IF NOT EXISTS "organization_tombstones_change_idx" ON "organization_tombstones" ("change_xid", "change_seq");
+
+---- create above / drop below ----
+
+DROP TRIGGER IF EXISTS "people_stamp_change_insert" ON "people";
+DROP TRIGGER IF EXISTS "people_stamp_change_update" ON "people";
+DROP TRIGGER IF EXISTS "organizations_stamp_change_insert" ON "organizations";
+DROP TRIGGER IF EXISTS "organizations_stamp_change_update" ON "organizations";
+DROP TRIGGER IF EXISTS "person_tombstones_stamp_change_insert" ON "person_tombstones";
+DROP TRIGGER IF EXISTS "person_tombstones_stamp_change_update" ON "person_tombstones";
+DROP TRIGGER IF EXISTS "organization_tombstones_stamp_change_insert" ON "organization_tombstones";
+DROP TRIGGER IF EXISTS "organization_tombstones_stamp_change_update" ON "organization_tombstones";
+
+ALTER TABLE "people" DROP COLUMN IF EXISTS "change_xid", DROP COLUMN IF EXISTS "change_seq";
+ALTER TABLE "organizations" DROP COLUMN IF EXISTS "change_xid", DROP COLUMN IF EXISTS "change_seq";
+ALTER TABLE "person_tombstones" DROP COLUMN IF EXISTS "change_xid", DROP COLUMN IF EXISTS "change_seq";
+ALTER TABLE "organization_tombstones" DROP COLUMN IF EXISTS "change_xid", DROP COLUMN IF EXISTS "change_seq";
+
+DROP FUNCTION IF EXISTS "stamp_change"();
+DROP SEQUENCE IF EXISTS "change_seq";
@@ -0,0 +1,12 @@
+-- publishers claim outbox events for a while instead of locking them during publication.
+-- published events are purged after a retention period
+
+ALTER TABLE "outbox" ADD COLUMN IF NOT EXISTS "claimed_until" timestamptz NULL;
+
+CREATE INDEX IF NOT EXISTS "outbox_date_published_idx" ON "outbox" ("date_published") WHERE "date_published" IS NOT NULL;
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS "outbox_date_published_idx";
+
+ALTER TABLE "outbox" DROP COLUMN IF EXISTS "claimed_until";
@@ -0,0 +1,14 @@
+-- dispatchers claim deliveries by setting status "in_flight", with "next_attempt" as deadline.
+-- deliveries of a dispatcher that died are claimed again once that deadline has passed
+
+DROP INDEX IF EXISTS "webhook_deliveries_pending_idx";
+
+CREATE INDEX IF NOT EXISTS "webhook_deliveries_due_idx" ON "webhook_deliveries" ("next_attempt") WHERE "status" IN ('pending', 'in_flight');
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS "webhook_deliveries_due_idx";
+
+UPDATE "webhook_deliveries" SET "status" = 'pending' WHERE "status" = 'in_flight';
+
+CREATE INDEX IF NOT EXISTS "webhook_deliveries_pending_idx" ON "webhook_deliveries" ("next_attempt") WHERE "status" = 'pending';
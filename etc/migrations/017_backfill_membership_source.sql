@@ -0,0 +1,16 @@
+-- memberships recorded before 011 were all made by the ldap sync, which only ends memberships with source ldap.
+-- they are taken to start when they were recorded, unless the sync already recorded the same start
+UPDATE "organization_members" SET "source" = 'ldap' WHERE "source" IS NULL;
+
+UPDATE "organization_members" AS "a" SET "from" = "a"."date_created"
+WHERE "a"."from" IS NULL AND NOT EXISTS (
+  SELECT 1 FROM "organization_members" AS "b"
+  WHERE "b"."person_id" = "a"."person_id"
+    AND "b"."organization_id" = "a"."organization_id"
+    AND COALESCE("b"."type", '') = COALESCE("a"."type", '')
+    AND "b"."from" = "a"."date_created"
+);
+
+---- create above / drop below ----
+
+-- the backfilled values can't be told apart from synced ones and are kept
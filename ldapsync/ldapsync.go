@@ -2,11 +2,15 @@ package ldapsync
 
 import (
 	"context"
+	"errors"
 	"reflect"
 	"slices"
 	"sort"
+	"strings"
+	"time"
 
 	"github.com/go-ldap/ldap/v3"
+	"github.com/oklog/ulid/v2"
 	"github.com/ugent-library/people-service/models"
 	"github.com/ugent-library/people-service/ugentldap"
 	"go.uber.org/zap"
@@ -26,7 +30,16 @@ func NewSynchronizer(repo models.Repository, ugentLdapClient *ugentldap.Client,
 	}
 }
 
+// maxConflictRetries is the number of times a person record is reloaded and updated again
+// after a concurrent writer changed it (cf. models.ErrConflict)
+const maxConflictRetries = 3
+
 func (si *Synchronizer) Sync(ctx context.Context) error {
+	// attribute all changes of this run to the same actor
+	runID := ulid.Make().String()
+	ctx = models.WithActor(ctx, models.Actor{Source: models.ActorSourceLdapSync, ID: runID})
+	si.logger.Infof("starting ldapsync run %s", runID)
+
 	newActiveIDs := []string{}
 
 	err := si.ugentLdapClient.SearchPeople(ctx, PersonQuery, func(ldapEntry *ldap.Entry) error {
@@ -36,111 +49,21 @@ func (si *Synchronizer) Sync(ctx context.Context) error {
 			return err
 		}
 
-		var oldPeople []*models.Person
-
-		historicUgentIDs := newPerson.GetIdentifierByNS("historic_ugent_id")
-		if len(historicUgentIDs) > 0 {
-			oldPeople, err = si.repository.GetPeopleByIdentifier(ctx, historicUgentIDs...)
-			if err != nil {
-				return err
+		var id string
+		for attempt := 1; ; attempt++ {
+			id, err = si.syncPerson(ctx, newPerson.Dup())
+			if errors.Is(err, models.ErrConflict) && attempt < maxConflictRetries {
+				si.logger.Infof("person record %s: changed concurrently, retrying", id)
+				continue
 			}
+			break
 		}
-
-		// IMPORTANT: sort inverse by date_updated
-		sort.Sort(sort.Reverse(models.ByPerson(oldPeople)))
-
-		if len(oldPeople) == 0 {
-			newPerson, err := si.repository.CreatePerson(ctx, newPerson)
-			if err != nil {
-				return err
-			}
-			si.logger.Infof("person record %s: created", newPerson.ID)
-			newActiveIDs = append(newActiveIDs, newPerson.ID)
-		} else {
-			// delete older versions with same historic_ugent_id
-			if len(oldPeople) > 1 {
-				for _, person := range oldPeople[1:] {
-					err := si.repository.DeletePerson(ctx, person.ID)
-					if err != nil {
-						return err
-					}
-					si.logger.Infof("person record %s: deleted", person.ID)
-				}
-			}
-
-			// insert updated version
-			oldPerson := oldPeople[0]
-
-			newActiveIDs = append(newActiveIDs, oldPerson.ID)
-
-			oldStoredPerson := oldPerson.Dup()
-			keepIds := make([]*models.URN, 0, 3)
-			for _, id := range oldPerson.Identifier {
-				switch id.Namespace {
-				case "orcid", "gismo_id", "biblio_id":
-					keepIds = append(keepIds, id.Dup())
-				}
-			}
-			oldPerson.ClearIdentifier()
-			oldPerson.SetIdentifier(newPerson.Identifier...)
-			for _, id := range keepIds {
-				oldPerson.AddIdentifier(id)
-			}
-			oldPerson.EnsureBiblioID() // P.S. also done in repository for other reasons
-
-			oldPerson.Active = true
-			oldPerson.BirthDate = newPerson.BirthDate
-			oldPerson.Email = newPerson.Email
-			oldPerson.GivenName = newPerson.GivenName
-			oldPerson.FamilyName = newPerson.FamilyName
-			oldPerson.Name = newPerson.Name
-			oldPerson.JobCategory = newPerson.JobCategory
-			oldPerson.HonorificPrefix = newPerson.HonorificPrefix
-			oldPerson.ObjectClass = newPerson.ObjectClass
-
-			// only add organizations not known yet (gismo possibly knows more)
-			for _, newOrgMember := range newPerson.Organization {
-				found := false
-				for _, oldOrgMember := range oldPerson.Organization {
-					if oldOrgMember.ID == newOrgMember.ID {
-						found = true
-						break
-					}
-				}
-				if !found {
-					oldPerson.AddOrganizationMember(newOrgMember)
-				}
-			}
-
-			// prepare for comparison
-			if len(oldPerson.Organization) == 0 {
-				oldPerson.Organization = nil
-			}
-			if len(oldPerson.JobCategory) == 0 {
-				oldPerson.JobCategory = nil
-			}
-			if len(oldPerson.ObjectClass) == 0 {
-				oldPerson.ObjectClass = nil
-			}
-			if len(oldPerson.Identifier) == 0 {
-				oldPerson.Identifier = nil
-			}
-			if len(oldPerson.Token) == 0 {
-				oldPerson.Token = map[string]string{}
-			}
-
-			if reflect.DeepEqual(oldPerson, oldStoredPerson) {
-				si.logger.Infof("person record %s: no update", oldPerson.ID)
-				return nil
-			}
-
-			oldPerson, err := si.repository.SavePerson(ctx, oldPerson)
-			if err != nil {
-				return err
-			}
-			si.logger.Infof("person record %s: updated", oldPerson.ID)
+		if err != nil {
+			return err
 		}
 
+		newActiveIDs = append(newActiveIDs, id)
+
 		return nil
 	})
 
@@ -169,11 +92,139 @@ func (si *Synchronizer) Sync(ctx context.Context) error {
 	return err
 }
 
+// syncPerson creates or updates the stored person record that matches newPerson,
+// and returns the id of that record
+func (si *Synchronizer) syncPerson(ctx context.Context, newPerson *models.Person) (string, error) {
+	var oldPeople []*models.Person
+	var err error
+
+	historicUgentIDs := newPerson.GetIdentifierByNS("historic_ugent_id")
+	if len(historicUgentIDs) > 0 {
+		oldPeople, err = si.repository.GetPeopleByIdentifier(ctx, historicUgentIDs...)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	// IMPORTANT: sort inverse by date_updated
+	sort.Sort(sort.Reverse(models.ByPerson(oldPeople)))
+
+	if len(oldPeople) == 0 {
+		newPerson, err := si.repository.CreatePerson(ctx, newPerson)
+		if err != nil {
+			return "", err
+		}
+		si.logger.Infof("person record %s: created", newPerson.ID)
+		return newPerson.ID, nil
+	}
+
+	// insert updated version
+	oldPerson := oldPeople[0]
+
+	// merge older versions with same historic_ugent_id into the newest one
+	if len(oldPeople) > 1 {
+		olderIDs := make([]string, 0, len(oldPeople)-1)
+		for _, person := range oldPeople[1:] {
+			olderIDs = append(olderIDs, person.ID)
+		}
+		mergedPerson, err := si.repository.MergePeople(ctx, oldPerson.ID, olderIDs, models.MergeParams{
+			OnConflict: models.MergeKeepSurvivor,
+		})
+		if err != nil {
+			return oldPerson.ID, err
+		}
+		si.logger.Infof("person record %s: merged %v", oldPerson.ID, olderIDs)
+		oldPerson = mergedPerson
+	}
+
+	oldStoredPerson := oldPerson.Dup()
+	keepIds := make([]*models.URN, 0, 3)
+	for _, id := range oldPerson.Identifier {
+		switch id.Namespace {
+		case "orcid", "gismo_id", "biblio_id":
+			keepIds = append(keepIds, id.Dup())
+		}
+	}
+	oldPerson.ClearIdentifier()
+	oldPerson.SetIdentifier(newPerson.Identifier...)
+	for _, id := range keepIds {
+		oldPerson.AddIdentifier(id)
+	}
+	oldPerson.EnsureBiblioID() // P.S. also done in repository for other reasons
+
+	oldPerson.Active = true
+	oldPerson.BirthDate = newPerson.BirthDate
+	oldPerson.Email = newPerson.Email
+	oldPerson.GivenName = newPerson.GivenName
+	oldPerson.FamilyName = newPerson.FamilyName
+	oldPerson.Name = newPerson.Name
+	oldPerson.JobCategory = newPerson.JobCategory
+	oldPerson.HonorificPrefix = newPerson.HonorificPrefix
+	oldPerson.ObjectClass = newPerson.ObjectClass
+
+	// only add memberships not known yet (gismo possibly knows more)
+	now := time.Now().UTC()
+	for _, newOrgMember := range newPerson.Organization {
+		found := false
+		for _, oldOrgMember := range oldPerson.Organization {
+			if oldOrgMember.ID == newOrgMember.ID && oldOrgMember.ValidAt(now) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			oldPerson.AddOrganizationMember(newOrgMember)
+		}
+	}
+
+	// end memberships that came from ldap and are gone from ldap
+	for _, oldOrgMember := range oldPerson.Organization {
+		if oldOrgMember.Source != models.MembershipSourceLDAP || !oldOrgMember.ValidAt(now) {
+			continue
+		}
+		if !slices.ContainsFunc(newPerson.Organization, func(om *models.OrganizationMember) bool { return om.ID == oldOrgMember.ID }) {
+			oldOrgMember.Until = &now
+		}
+	}
+
+	// prepare for comparison
+	if len(oldPerson.Organization) == 0 {
+		oldPerson.Organization = nil
+	}
+	if len(oldPerson.JobCategory) == 0 {
+		oldPerson.JobCategory = nil
+	}
+	if len(oldPerson.ObjectClass) == 0 {
+		oldPerson.ObjectClass = nil
+	}
+	if len(oldPerson.Identifier) == 0 {
+		oldPerson.Identifier = nil
+	}
+	if len(oldPerson.Token) == 0 {
+		oldPerson.Token = map[string]string{}
+	}
+
+	if reflect.DeepEqual(oldPerson, oldStoredPerson) {
+		si.logger.Infof("person record %s: no update", oldPerson.ID)
+		return oldPerson.ID, nil
+	}
+
+	// P.S. the version of oldPerson guards against concurrent updates since it was loaded
+	oldPerson, err = si.repository.SavePerson(ctx, oldPerson)
+	if err != nil {
+		return oldPeople[0].ID, err
+	}
+	si.logger.Infof("person record %s: updated", oldPerson.ID)
+
+	return oldPerson.ID, nil
+}
+
 func (si *Synchronizer) ldapEntryToPerson(ctx context.Context, ldapEntry *ldap.Entry) (*models.Person, error) {
 	newPerson := models.NewPerson()
 	newPerson.Active = true
 
 	orgIds := []string{}
+	now := time.Now().UTC()
 
 	for _, attr := range ldapEntry.Attributes {
 		for _, val := range attr.Values {
@@ -215,6 +266,10 @@ func (si *Synchronizer) ldapEntryToPerson(ctx context.Context, ldapEntry *ldap.E
 		orgIds = append(orgIds, "UZGent")
 	}
 
+	// faculty and department can be the same
+	slices.Sort(orgIds)
+	orgIds = slices.Compact(orgIds)
+
 	for _, orgId := range orgIds {
 		orgs, err := si.repository.GetOrganizationsByIdentifier(ctx, models.NewURN("biblio_id", orgId))
 		if err != nil {
@@ -235,9 +290,39 @@ func (si *Synchronizer) ldapEntryToPerson(ctx context.Context, ldapEntry *ldap.E
 		} else {
 			org = orgs[0]
 		}
+		// ldap still knows the membership, flag it for review
+		if org.StatusAt(now) == models.OrganizationDissolved {
+			if err := si.flagDissolvedMembership(ctx, newPerson, org); err != nil {
+				return nil, err
+			}
+		}
+		// a membership starts when ldap reports it first
 		newOrgMember := models.NewOrganizationMember(org.ID)
+		newOrgMember.From = &now
+		newOrgMember.Source = models.MembershipSourceLDAP
 		newPerson.AddOrganizationMember(newOrgMember)
 	}
 
 	return newPerson, nil
 }
+
+// flagDissolvedMembership warns that person is a member of dissolved organization org in ldap,
+// and names the organizations that replace org today
+func (si *Synchronizer) flagDissolvedMembership(ctx context.Context, person *models.Person, org *models.Organization) error {
+	nodes, err := si.repository.GetOrganizationLineage(ctx, models.OrganizationLineageParams{ID: org.ID})
+	if err != nil {
+		return err
+	}
+	successorIDs := []string{}
+	for _, node := range nodes {
+		if node.Depth > 0 && node.Current {
+			successorIDs = append(successorIDs, node.Organization.ID)
+		}
+	}
+	slices.Sort(successorIDs)
+	successorIDs = slices.Compact(successorIDs)
+
+	si.logger.Warnf("person with name '%s' (%s) is a member of organization %s in ldap, dissolved on %s, current successors: %v",
+		person.Name, strings.Join(person.GetIdentifierQualifiedValues(), ", "), org.ID, org.Dissolved.Format(time.RFC3339), successorIDs)
+	return nil
+}
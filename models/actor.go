@@ -0,0 +1,34 @@
+package models
+
+import "context"
+
+// sources of Actor.Source
+const (
+	ActorSourceAPI      = "api"
+	ActorSourceLdapSync = "ldapsync"
+	ActorSourceCLI      = "cli"
+	ActorSourceUnknown  = "unknown"
+)
+
+// Actor describes who made a change: the source (api, ldapsync, cli)
+// and an id within that source (api key fingerprint, ldapsync run id, system user)
+type Actor struct {
+	Source string `json:"source"`
+	ID     string `json:"id,omitempty"`
+}
+
+type actorKey struct{}
+
+// WithActor returns a copy of ctx that attributes changes to actor
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the actor set with WithActor,
+// or an actor with source "unknown"
+func ActorFromContext(ctx context.Context) Actor {
+	if actor, ok := ctx.Value(actorKey{}).(Actor); ok {
+		return actor
+	}
+	return Actor{Source: ActorSourceUnknown}
+}
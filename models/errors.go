@@ -10,3 +10,5 @@ var ErrSkipped = errors.New("was skipped")
 var ErrMissingArgument = errors.New("missing argument")
 var ErrInvalidReference = errors.New("invalid reference")
 var ErrInvalidURN = errors.New("invalid urn")
+var ErrConflict = errors.New("conflict")
+var ErrInvalidArgument = errors.New("invalid argument")
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// event types, published on subject "people.<type>"
+const (
+	EventPersonCreated       = "person.created"
+	EventPersonUpdated       = "person.updated"
+	EventPersonDeleted       = "person.deleted"
+	EventPersonActivated     = "person.activated"
+	EventPersonDeactivated   = "person.deactivated"
+	EventOrganizationCreated = "organization.created"
+	EventOrganizationUpdated = "organization.updated"
+	EventOrganizationDeleted = "organization.deleted"
+)
+
+// Event describes a change of a single record. It is stored in the outbox
+// in the same transaction as the change itself, and published afterwards.
+// Data holds the full record (without tokens), or its tombstone when the record is deleted.
+type Event struct {
+	ID       string          `json:"id"`
+	Type     string          `json:"type"`
+	Time     *time.Time      `json:"time"`
+	RecordID string          `json:"record_id"`
+	Data     json.RawMessage `json:"data"`
+}
+
+type EventService interface {
+	// PublishEvents passes at most limit unpublished events, oldest first, to fn,
+	// and marks them published when fn returns nil. It stops at the first error,
+	// and returns the number of published events.
+	PublishEvents(context.Context, int, func(*Event) error) (int, error)
+	// PurgeEvents deletes events that were published before the given time,
+	// and returns the number of deleted events.
+	PurgeEvents(context.Context, time.Time) (int, error)
+}
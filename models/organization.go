@@ -1,28 +1,66 @@
 package models
 
 import (
+	"fmt"
 	"sort"
 	"time"
 )
 
+// organization statuses, derived from Founded and Dissolved
+const (
+	OrganizationPlanned   = "planned"
+	OrganizationActive    = "active"
+	OrganizationDissolved = "dissolved"
+)
+
 type Organization struct {
-	ID          string                `json:"id,omitempty"`
-	DateCreated *time.Time            `json:"date_created,omitempty"`
-	DateUpdated *time.Time            `json:"date_updated,omitempty"`
-	Type        string                `json:"type,omitempty"`
-	NameDut     string                `json:"name_dut,omitempty"`
-	NameEng     string                `json:"name_eng,omitempty"`
-	Parent      []*OrganizationParent `json:"parent,omitempty"`
-	Identifier  []*URN                `json:"identifier,omitempty"`
-	Acronym     string                `json:"acronym,omitempty"`
+	ID          string                   `json:"id,omitempty"`
+	DateCreated *time.Time               `json:"date_created,omitempty"`
+	DateUpdated *time.Time               `json:"date_updated,omitempty"`
+	Type        string                   `json:"type,omitempty"`
+	NameDut     string                   `json:"name_dut,omitempty"`
+	NameEng     string                   `json:"name_eng,omitempty"`
+	Parent      []*OrganizationParent    `json:"parent,omitempty"`
+	Successor   []*OrganizationSuccessor `json:"successor,omitempty"`
+	// Predecessor is the reverse of Successor and is read only
+	Predecessor []*OrganizationSuccessor `json:"predecessor,omitempty"`
+	Identifier  []*URN                   `json:"identifier,omitempty"`
+	Acronym     string                   `json:"acronym,omitempty"`
+	Founded     *time.Time               `json:"founded,omitempty"`
+	Dissolved   *time.Time               `json:"dissolved,omitempty"`
+	Version     int                      `json:"version,omitempty"`
 }
 
 func (org *Organization) IsStored() bool {
 	return org.DateCreated != nil
 }
 
+// StatusAt returns OrganizationPlanned before Founded, OrganizationDissolved from Dissolved on
+// and OrganizationActive in between. Organizations without dates are always active.
+func (org *Organization) StatusAt(t time.Time) string {
+	if org.Dissolved != nil && !t.Before(*org.Dissolved) {
+		return OrganizationDissolved
+	}
+	if org.Founded != nil && t.Before(*org.Founded) {
+		return OrganizationPlanned
+	}
+	return OrganizationActive
+}
+
+// ValidateOrganizationLifecycle checks that an organization is dissolved after it was founded
+func ValidateOrganizationLifecycle(org *Organization) error {
+	if org.Founded != nil && org.Dissolved != nil && !org.Dissolved.After(*org.Founded) {
+		return fmt.Errorf("%w: organization %s: dissolved %s is not after founded %s",
+			ErrInvalidArgument, org.ID, org.Dissolved.Format(time.RFC3339), org.Founded.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// DefaultOrganizationType is the type of an organization that has no specific type
+const DefaultOrganizationType = "organization"
+
 func NewOrganization() *Organization {
-	org := &Organization{Type: "organization"}
+	org := &Organization{Type: DefaultOrganizationType}
 	return org
 }
 
@@ -85,6 +123,21 @@ func (org *Organization) AddParent(parents ...*OrganizationParent) {
 	sort.Sort(ByOrganizationParent(org.Parent))
 }
 
+func (org *Organization) SetSuccessor(successors ...*OrganizationSuccessor) {
+	sort.Sort(ByOrganizationSuccessor(successors))
+	org.Successor = successors
+}
+
+func (org *Organization) AddSuccessor(successors ...*OrganizationSuccessor) {
+	org.Successor = append(org.Successor, successors...)
+	sort.Sort(ByOrganizationSuccessor(org.Successor))
+}
+
+func (org *Organization) AddPredecessor(predecessors ...*OrganizationSuccessor) {
+	org.Predecessor = append(org.Predecessor, predecessors...)
+	sort.Sort(ByOrganizationSuccessor(org.Predecessor))
+}
+
 func (org *Organization) Dup() *Organization {
 	newOrg := &Organization{
 		ID:          org.ID,
@@ -92,6 +145,9 @@ func (org *Organization) Dup() *Organization {
 		NameDut:     org.NameDut,
 		NameEng:     org.NameEng,
 		Acronym:     org.Acronym,
+		Version:     org.Version,
+		Founded:     copyTime(org.Founded),
+		Dissolved:   copyTime(org.Dissolved),
 		DateCreated: copyTime(org.DateCreated),
 		DateUpdated: copyTime(org.DateUpdated),
 	}
@@ -102,6 +158,12 @@ func (org *Organization) Dup() *Organization {
 	for _, op := range org.Parent {
 		newOrg.Parent = append(newOrg.Parent, op.Dup())
 	}
+	for _, os := range org.Successor {
+		newOrg.Successor = append(newOrg.Successor, os.Dup())
+	}
+	for _, os := range org.Predecessor {
+		newOrg.Predecessor = append(newOrg.Predecessor, os.Dup())
+	}
 
 	return newOrg
 }
@@ -1,23 +1,82 @@
 package models
 
 import (
+	"context"
+	"fmt"
 	"time"
 )
 
+// sources of memberships
+const (
+	MembershipSourceLDAP = "ldap"
+)
+
+// OrganizationMember is a membership of an organization during [From, Until).
+// A nil From or Until is open ended. A person can be a member of the same
+// organization several times, with a different type or period.
 type OrganizationMember struct {
 	ID          string     `json:"id,omitempty"`
 	DateCreated *time.Time `json:"date_created,omitempty"`
 	DateUpdated *time.Time `json:"date_updated,omitempty"`
+	From        *time.Time `json:"from,omitempty"`
+	Until       *time.Time `json:"until,omitempty"`
+	// Type is the function of the member, e.g. "postdoc"
+	Type string `json:"type,omitempty"`
+	// FTE is the percentage of a full time position (0-100)
+	FTE *float64 `json:"fte,omitempty"`
+	// Source is the system the membership comes from, e.g. "ldap"
+	Source string `json:"source,omitempty"`
 }
 
 func (om OrganizationMember) Dup() *OrganizationMember {
+	var fte *float64
+	if om.FTE != nil {
+		v := *om.FTE
+		fte = &v
+	}
 	return &OrganizationMember{
 		ID:          om.ID,
 		DateCreated: copyTime(om.DateCreated),
 		DateUpdated: copyTime(om.DateUpdated),
+		From:        copyTime(om.From),
+		Until:       copyTime(om.Until),
+		Type:        om.Type,
+		FTE:         fte,
+		Source:      om.Source,
 	}
 }
 
+// ValidAt reports whether the membership is valid at t
+func (om *OrganizationMember) ValidAt(t time.Time) bool {
+	return (om.From == nil || !om.From.After(t)) && (om.Until == nil || om.Until.After(t))
+}
+
+// SameAs reports whether om and other are the same membership:
+// the same organization, type and start
+func (om *OrganizationMember) SameAs(other *OrganizationMember) bool {
+	return om.ID == other.ID && om.Type == other.Type && timeEqual(om.From, other.From)
+}
+
+// ValidateOrganizationMembers checks that every membership ends after it starts,
+// has a valid FTE and occurs only once
+func ValidateOrganizationMembers(orgMembers []*OrganizationMember) error {
+	for i, om := range orgMembers {
+		if om.From != nil && om.Until != nil && !om.Until.After(*om.From) {
+			return fmt.Errorf("%w: membership of organization %s: until %s is not after from %s",
+				ErrInvalidArgument, om.ID, om.Until.Format(time.RFC3339), om.From.Format(time.RFC3339))
+		}
+		if om.FTE != nil && (*om.FTE < 0 || *om.FTE > 100) {
+			return fmt.Errorf("%w: membership of organization %s: fte must be between 0 and 100", ErrInvalidArgument, om.ID)
+		}
+		for _, other := range orgMembers[i+1:] {
+			if om.SameAs(other) {
+				return fmt.Errorf("%w: duplicate membership of organization %s", ErrInvalidArgument, om.ID)
+			}
+		}
+	}
+	return nil
+}
+
 type ByOrganizationMember []*OrganizationMember
 
 func (orgMembers ByOrganizationMember) Len() int {
@@ -28,6 +87,87 @@ func (orgMembers ByOrganizationMember) Swap(i, j int) {
 	orgMembers[i], orgMembers[j] = orgMembers[j], orgMembers[i]
 }
 
+// Less orders by organization, then by start (open start first), then by type
 func (orgMembers ByOrganizationMember) Less(i, j int) bool {
-	return orgMembers[i].ID < orgMembers[j].ID
+	a, b := orgMembers[i], orgMembers[j]
+	if a.ID != b.ID {
+		return a.ID < b.ID
+	}
+	if !timeEqual(a.From, b.From) {
+		return a.From == nil || (b.From != nil && a.From.Before(*b.From))
+	}
+	return a.Type < b.Type
+}
+
+type OrganizationMemberService interface {
+	// GetOrganizationMembers returns the first page of members, GetMoreOrganizationMembers the pages after the cursor.
+	// Send the same params with every cursor.
+	GetOrganizationMembers(context.Context, OrganizationMembersParams) (*OrganizationMembersResult, error)
+	GetMoreOrganizationMembers(context.Context, OrganizationMembersParams, string) (*OrganizationMembersResult, error)
+}
+
+// MaxOrganizationMembersLimit is the maximum page size of GetOrganizationMembers
+const MaxOrganizationMembersLimit = 1000
+
+type OrganizationMembersParams struct {
+	// ID of the organization
+	ID string
+	// IncludeDescendants also returns members of organizations below ID,
+	// following parent relations valid at AsOf (or now) only
+	IncludeDescendants bool
+	// AsOf only returns memberships valid at this time, now if nil
+	AsOf *time.Time
+	// Historic returns people with any membership, past, current or future, instead of those valid at AsOf
+	Historic bool
+	Active   []bool
+	// IDsOnly returns person ids instead of full person records
+	IDsOnly bool
+	Limit   int
+}
+
+func (p OrganizationMembersParams) MergeDefault() OrganizationMembersParams {
+	active := p.Active
+	if len(active) == 0 {
+		active = []bool{true, false}
+	}
+	limit := p.Limit
+	if limit == 0 {
+		limit = 200
+	}
+	asOf := p.AsOf
+	if asOf == nil && !p.Historic {
+		now := time.Now()
+		asOf = &now
+	}
+	return OrganizationMembersParams{
+		ID:                 p.ID,
+		IncludeDescendants: p.IncludeDescendants,
+		AsOf:               asOf,
+		Historic:           p.Historic,
+		Active:             active,
+		IDsOnly:            p.IDsOnly,
+		Limit:              limit,
+	}
+}
+
+func (p OrganizationMembersParams) Validate() error {
+	if p.ID == "" {
+		return fmt.Errorf("%w: id is required", ErrMissingArgument)
+	}
+	if p.Historic && p.AsOf != nil {
+		return fmt.Errorf("%w: historic and as of can't be combined", ErrInvalidArgument)
+	}
+	if p.Limit < 0 || p.Limit > MaxOrganizationMembersLimit {
+		return fmt.Errorf("%w: limit must be between 0 and %d", ErrInvalidArgument, MaxOrganizationMembersLimit)
+	}
+	return nil
+}
+
+// OrganizationMembersResult holds one page of members ordered by creation:
+// People, or only IDs if OrganizationMembersParams.IDsOnly is set.
+// Cursor is empty on the last page.
+type OrganizationMembersResult struct {
+	People []*Person
+	IDs    []string
+	Cursor string
 }
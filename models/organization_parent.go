@@ -1,6 +1,9 @@
 package models
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 type OrganizationParent struct {
 	ID          string     `json:"id,omitempty"`
@@ -36,3 +39,38 @@ func (parents ByOrganizationParent) Less(i, j int) bool {
 	}
 	return parents[i].ID < parents[j].ID
 }
+
+// ValidateOrganizationParents checks the parent relations of organization id:
+// every relation needs a start before its end, an organization can't be its own parent,
+// and the periods of relations with the same parent can't overlap.
+// An organization can have several parents at the same time.
+// Cycles over more organizations are checked by the repository.
+func ValidateOrganizationParents(id string, parents []*OrganizationParent) error {
+	for _, parent := range parents {
+		if parent.From == nil {
+			return fmt.Errorf("%w: parent %s: from is required", ErrMissingArgument, parent.ID)
+		}
+		if id != "" && parent.ID == id {
+			return fmt.Errorf("%w: organization %s can't be its own parent", ErrInvalidArgument, id)
+		}
+		if parent.Until != nil && !parent.Until.After(*parent.From) {
+			return fmt.Errorf("%w: parent %s: until %s is not after from %s",
+				ErrInvalidArgument, parent.ID, parent.Until.Format(time.RFC3339), parent.From.Format(time.RFC3339))
+		}
+	}
+	for i, a := range parents {
+		for _, b := range parents[i+1:] {
+			if a.ID == b.ID && PeriodsOverlap(a.From, a.Until, b.From, b.Until) {
+				return fmt.Errorf("%w: parent %s from %s overlaps with parent %s from %s",
+					ErrInvalidArgument, a.ID, a.From.Format(time.RFC3339), b.ID, b.From.Format(time.RFC3339))
+			}
+		}
+	}
+	return nil
+}
+
+// PeriodsOverlap reports whether [fromA, untilA) and [fromB, untilB) overlap.
+// A nil until is open ended.
+func PeriodsOverlap(fromA, untilA, fromB, untilB *time.Time) bool {
+	return (untilB == nil || fromA.Before(*untilB)) && (untilA == nil || fromB.Before(*untilA))
+}
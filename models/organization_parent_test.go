@@ -0,0 +1,118 @@
+package models
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func testDate(year int) *time.Time {
+	t := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+	return &t
+}
+
+func TestPeriodsOverlap(t *testing.T) {
+	tests := []struct {
+		name                         string
+		fromA, untilA, fromB, untilB *time.Time
+		want                         bool
+	}{
+		{"disjoint", testDate(2000), testDate(2005), testDate(2010), testDate(2015), false},
+		{"adjacent", testDate(2000), testDate(2010), testDate(2010), testDate(2015), false},
+		{"overlapping", testDate(2000), testDate(2011), testDate(2010), testDate(2015), true},
+		{"contained", testDate(2000), testDate(2020), testDate(2010), testDate(2015), true},
+		{"both open ended", testDate(2000), nil, testDate(2010), nil, true},
+		{"open ended after the other", testDate(2010), nil, testDate(2000), testDate(2010), false},
+		{"open ended before the other", testDate(2000), nil, testDate(2010), testDate(2015), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PeriodsOverlap(tt.fromA, tt.untilA, tt.fromB, tt.untilB); got != tt.want {
+				t.Errorf("got %t, want %t", got, tt.want)
+			}
+			if got := PeriodsOverlap(tt.fromB, tt.untilB, tt.fromA, tt.untilA); got != tt.want {
+				t.Errorf("swapped: got %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateOrganizationParents(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		parents []*OrganizationParent
+		err     error
+	}{
+		{
+			name: "no parents",
+			id:   "a",
+		},
+		{
+			name: "consecutive parents",
+			id:   "a",
+			parents: []*OrganizationParent{
+				{ID: "b", From: testDate(2000), Until: testDate(2010)},
+				{ID: "c", From: testDate(2010)},
+			},
+		},
+		{
+			name:    "missing from",
+			id:      "a",
+			parents: []*OrganizationParent{{ID: "b"}},
+			err:     ErrMissingArgument,
+		},
+		{
+			name:    "own parent",
+			id:      "a",
+			parents: []*OrganizationParent{{ID: "a", From: testDate(2000)}},
+			err:     ErrInvalidArgument,
+		},
+		{
+			name:    "new organization",
+			parents: []*OrganizationParent{{ID: "a", From: testDate(2000)}},
+		},
+		{
+			name:    "until before from",
+			id:      "a",
+			parents: []*OrganizationParent{{ID: "b", From: testDate(2010), Until: testDate(2000)}},
+			err:     ErrInvalidArgument,
+		},
+		{
+			name:    "until equal to from",
+			id:      "a",
+			parents: []*OrganizationParent{{ID: "b", From: testDate(2010), Until: testDate(2010)}},
+			err:     ErrInvalidArgument,
+		},
+		{
+			name: "several parents at the same time",
+			id:   "a",
+			parents: []*OrganizationParent{
+				{ID: "b", From: testDate(2000)},
+				{ID: "c", From: testDate(2010)},
+			},
+		},
+		{
+			name: "overlapping periods with the same parent",
+			id:   "a",
+			parents: []*OrganizationParent{
+				{ID: "b", From: testDate(2000), Until: testDate(2011)},
+				{ID: "b", From: testDate(2010)},
+			},
+			err: ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateOrganizationParents(tt.id, tt.parents)
+			if tt.err == nil && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("got error %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
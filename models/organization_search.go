@@ -1,15 +1,26 @@
 package models
 
-import "context"
+import (
+	"context"
+	"fmt"
+)
 
 type OrganizationSuggestService interface {
-	SuggestOrganizations(context.Context, OrganizationSuggestParams) ([]*Organization, error)
-	RebuildAutocompleteOrganizations(context.Context) error
+	// SuggestOrganizations also returns match metadata per hit (in the same order) if params.Highlight is set
+	SuggestOrganizations(context.Context, OrganizationSuggestParams) ([]*Organization, []*Match, error)
+	RebuildAutocompleteOrganizations(context.Context, RebuildParams) error
 }
 
 type OrganizationSuggestParams struct {
 	Query string
 	Limit uint32
+	// Threshold is the minimum trigram word similarity of query and name,
+	// lower values tolerate more typos
+	Threshold float64
+	// Highlight requests match metadata per hit
+	Highlight bool
+	// IncludeDissolved also matches organizations that are dissolved now
+	IncludeDissolved bool
 }
 
 func (p OrganizationSuggestParams) MergeDefault() OrganizationSuggestParams {
@@ -17,8 +28,102 @@ func (p OrganizationSuggestParams) MergeDefault() OrganizationSuggestParams {
 	if limit == 0 {
 		limit = 20
 	}
+	threshold := p.Threshold
+	if threshold == 0 {
+		threshold = DefaultSimilarityThreshold
+	}
 	return OrganizationSuggestParams{
-		Query: p.Query,
-		Limit: limit,
+		Query:            p.Query,
+		Limit:            limit,
+		Threshold:        threshold,
+		Highlight:        p.Highlight,
+		IncludeDissolved: p.IncludeDissolved,
+	}
+}
+
+func (p OrganizationSuggestParams) Validate() error {
+	return validateThreshold(p.Threshold)
+}
+
+// organization facets returned by SearchOrganizations
+const (
+	FacetType = "type"
+)
+
+type OrganizationSearchService interface {
+	SearchOrganizations(context.Context, OrganizationSearchParams) (*OrganizationSearchResult, error)
+}
+
+// OrganizationSearchParams filters organization records. Values within a filter are combined with OR,
+// filters are combined with AND.
+type OrganizationSearchParams struct {
+	Query string
+	Type  []string
+	// Ancestor holds organization ids; only organizations below them (at any depth) match
+	Ancestor []string
+	// Current only matches organizations without parent relations
+	// or with a parent relation that is valid now.
+	// It also restricts the Ancestor filter to parent relations that are valid now.
+	Current bool
+	// IncludeDissolved also matches organizations that are dissolved now
+	IncludeDissolved bool
+	// Sort is one of SortRelevance (requires Query), SortName or SortDateUpdated
+	// (with an optional leading "-")
+	Sort       string
+	Offset     uint32
+	Limit      uint32
+	FacetLimit uint32
+}
+
+func (p OrganizationSearchParams) MergeDefault() OrganizationSearchParams {
+	sort := p.Sort
+	if sort == "" {
+		if p.Query != "" {
+			sort = SortRelevance
+		} else {
+			sort = SortName
+		}
 	}
+	limit := p.Limit
+	if limit == 0 {
+		limit = 20
+	}
+	facetLimit := p.FacetLimit
+	if facetLimit == 0 {
+		facetLimit = 50
+	}
+	return OrganizationSearchParams{
+		Query:            p.Query,
+		Type:             p.Type,
+		Ancestor:         p.Ancestor,
+		Current:          p.Current,
+		IncludeDissolved: p.IncludeDissolved,
+		Sort:             sort,
+		Offset:           p.Offset,
+		Limit:            limit,
+		FacetLimit:       facetLimit,
+	}
+}
+
+func (p OrganizationSearchParams) Validate() error {
+	switch p.Sort {
+	case SortRelevance:
+		if p.Query == "" {
+			return fmt.Errorf("%w: sort by relevance requires a query", ErrInvalidArgument)
+		}
+	case SortName, SortNameDesc, SortDateUpdated, SortDateUpdatedDesc:
+	default:
+		return fmt.Errorf("%w: unknown sort %q", ErrInvalidArgument, p.Sort)
+	}
+	return nil
+}
+
+type OrganizationSearchResult struct {
+	Total  int
+	Offset uint32
+	Limit  uint32
+	Hits   []*Organization
+	// Facets holds the value counts of every facet over all matching records,
+	// most frequent values first
+	Facets map[string][]*FacetValue
 }
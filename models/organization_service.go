@@ -1,6 +1,9 @@
 package models
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type OrganizationService interface {
 	SaveOrganization(context.Context, *Organization) (*Organization, error)
@@ -9,8 +12,12 @@ type OrganizationService interface {
 	GetOrganization(context.Context, string) (*Organization, error)
 	GetOrganizationsByIdentifier(context.Context, ...*URN) ([]*Organization, error)
 	GetOrganizationsById(context.Context, ...string) ([]*Organization, error)
-	DeleteOrganization(context.Context, string) error
+	DeleteOrganization(context.Context, string, bool) error
 	EachOrganization(context.Context, func(*Organization) bool) error
 	GetOrganizations(context.Context) ([]*Organization, string, error)
 	GetMoreOrganizations(context.Context, string) ([]*Organization, string, error)
+	GetOrganizationsChanges(context.Context, time.Time) ([]*Organization, []*Tombstone, string, error)
+	GetMoreOrganizationsChanges(context.Context, time.Time, string) ([]*Organization, []*Tombstone, string, error)
+	UpsertOrganizations(context.Context, UpsertParams, ...*Organization) ([]*UpsertResult, error)
+	GetOrganizationTombstones(context.Context, ...string) ([]*Tombstone, error)
 }
@@ -0,0 +1,132 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// OrganizationSuccessor links an organization to an organization that (partly) replaces it from Date on,
+// e.g. after a merge or a split. The same type is used for the reverse relation, Organization.Predecessor.
+type OrganizationSuccessor struct {
+	ID          string     `json:"id,omitempty"`
+	DateCreated *time.Time `json:"date_created,omitempty"`
+	DateUpdated *time.Time `json:"date_updated,omitempty"`
+	Date        *time.Time `json:"date,omitempty"`
+}
+
+func (os *OrganizationSuccessor) Dup() *OrganizationSuccessor {
+	return &OrganizationSuccessor{
+		ID:          os.ID,
+		DateCreated: copyTime(os.DateCreated),
+		DateUpdated: copyTime(os.DateUpdated),
+		Date:        copyTime(os.Date),
+	}
+}
+
+type ByOrganizationSuccessor []*OrganizationSuccessor
+
+func (successors ByOrganizationSuccessor) Len() int {
+	return len(successors)
+}
+
+func (successors ByOrganizationSuccessor) Swap(i, j int) {
+	successors[i], successors[j] = successors[j], successors[i]
+}
+
+func (successors ByOrganizationSuccessor) Less(i, j int) bool {
+	if successors[i].Date != nil && successors[j].Date != nil && !successors[i].Date.Equal(*successors[j].Date) {
+		return successors[i].Date.Before(*successors[j].Date)
+	}
+	return successors[i].ID < successors[j].ID
+}
+
+// ValidateOrganizationSuccessors checks the successor relations of organization id:
+// every relation needs a date, an organization can't succeed itself
+// and a successor can only be listed once.
+// Cycles over more organizations are checked by the repository.
+func ValidateOrganizationSuccessors(id string, successors []*OrganizationSuccessor) error {
+	seen := map[string]bool{}
+	for _, successor := range successors {
+		if successor.Date == nil {
+			return fmt.Errorf("%w: successor %s: date is required", ErrMissingArgument, successor.ID)
+		}
+		if id != "" && successor.ID == id {
+			return fmt.Errorf("%w: organization %s can't be its own successor", ErrInvalidArgument, id)
+		}
+		if seen[successor.ID] {
+			return fmt.Errorf("%w: successor %s is listed more than once", ErrInvalidArgument, successor.ID)
+		}
+		seen[successor.ID] = true
+	}
+	return nil
+}
+
+type OrganizationLineageService interface {
+	// GetOrganizationLineage follows the successor (or predecessor) relations of params.ID
+	GetOrganizationLineage(context.Context, OrganizationLineageParams) ([]*OrganizationLineageNode, error)
+}
+
+// directions of OrganizationLineageParams
+const (
+	LineageSuccessors   = "successors"
+	LineagePredecessors = "predecessors"
+)
+
+type OrganizationLineageParams struct {
+	// ID of the organization to start from
+	ID string
+	// Direction is LineageSuccessors (default) or LineagePredecessors
+	Direction string
+	// AsOf only follows relations that took effect at this time (date <= as_of).
+	// Defaults to now.
+	AsOf time.Time
+	// MaxDepth limits the number of steps, 0 follows all steps
+	MaxDepth int
+}
+
+func (p OrganizationLineageParams) MergeDefault() OrganizationLineageParams {
+	direction := p.Direction
+	if direction == "" {
+		direction = LineageSuccessors
+	}
+	asOf := p.AsOf
+	if asOf.IsZero() {
+		asOf = time.Now()
+	}
+	return OrganizationLineageParams{
+		ID:        p.ID,
+		Direction: direction,
+		AsOf:      asOf,
+		MaxDepth:  p.MaxDepth,
+	}
+}
+
+func (p OrganizationLineageParams) Validate() error {
+	if p.ID == "" {
+		return fmt.Errorf("%w: id is required", ErrMissingArgument)
+	}
+	if p.Direction != LineageSuccessors && p.Direction != LineagePredecessors {
+		return fmt.Errorf("%w: direction must be %s or %s", ErrInvalidArgument, LineageSuccessors, LineagePredecessors)
+	}
+	if p.MaxDepth < 0 {
+		return fmt.Errorf("%w: max depth must not be negative", ErrInvalidArgument)
+	}
+	return nil
+}
+
+// OrganizationLineageNode is an organization found by following successor or predecessor relations.
+// The start organization is included with depth 0.
+// An organization that is reached along several paths occurs once per path.
+type OrganizationLineageNode struct {
+	Organization *Organization
+	// Depth is the number of relations between the start and Organization
+	Depth int
+	// Path holds the organization ids from the start up to and including Organization
+	Path []string
+	// Date is the date of the followed relation, nil for the start
+	Date *time.Time
+	// Current is true if Organization has no successor at AsOf, i.e. it still exists.
+	// Following successors, the current nodes are the organizations that replace the start today.
+	Current bool
+}
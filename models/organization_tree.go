@@ -0,0 +1,84 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type OrganizationTreeService interface {
+	// GetOrganizationAncestors returns the organizations above params.ID, nearest first
+	GetOrganizationAncestors(context.Context, OrganizationTreeParams) ([]*OrganizationTreeNode, error)
+	// GetOrganizationDescendants returns the organizations below params.ID, nearest first
+	GetOrganizationDescendants(context.Context, OrganizationTreeParams) ([]*OrganizationTreeNode, error)
+	// GetOrganizationTree returns the roots above params.ID with all organizations below them
+	GetOrganizationTree(context.Context, OrganizationTreeParams) ([]*OrganizationTreeNode, error)
+	// CheckOrganizationHierarchy reports all stored parent relations that are invalid
+	CheckOrganizationHierarchy(context.Context) ([]*HierarchyViolation, error)
+}
+
+type OrganizationTreeParams struct {
+	// ID of the organization to start from
+	ID string
+	// AsOf only follows parent relations that are valid at this time (from <= as_of < until).
+	// Defaults to now.
+	AsOf time.Time
+	// MaxDepth limits the number of levels, 0 follows all levels
+	MaxDepth int
+}
+
+func (p OrganizationTreeParams) MergeDefault() OrganizationTreeParams {
+	asOf := p.AsOf
+	if asOf.IsZero() {
+		asOf = time.Now()
+	}
+	return OrganizationTreeParams{
+		ID:       p.ID,
+		AsOf:     asOf,
+		MaxDepth: p.MaxDepth,
+	}
+}
+
+func (p OrganizationTreeParams) Validate() error {
+	if p.ID == "" {
+		return fmt.Errorf("%w: id is required", ErrMissingArgument)
+	}
+	if p.MaxDepth < 0 {
+		return fmt.Errorf("%w: max depth must not be negative", ErrInvalidArgument)
+	}
+	return nil
+}
+
+// OrganizationTreeNode is an organization found by walking the hierarchy.
+// An organization with several parents can occur once per path.
+type OrganizationTreeNode struct {
+	Organization *Organization
+	// Depth is the number of relations between the start (or the root for GetOrganizationTree) and Organization
+	Depth int
+	// Path holds the organization ids from the start (or the root) up to and including Organization
+	Path []string
+	// ParentID is the parent of Organization on Path, or empty for roots and ancestors
+	ParentID string
+	// From and Until are the validity of the followed relation
+	From  *time.Time
+	Until *time.Time
+}
+
+// hierarchy violations reported by CheckOrganizationHierarchy
+const (
+	HierarchyCycle              = "cycle"
+	HierarchyInvertedPeriod     = "inverted_period"
+	HierarchyOverlappingPeriods = "overlapping_periods"
+)
+
+// HierarchyViolation is a parent relation that breaks the rules of ValidateOrganizationParents,
+// or a cycle of parent relations that are valid at the same time
+type HierarchyViolation struct {
+	Type           string `json:"type"`
+	OrganizationID string `json:"organization_id"`
+	// ParentID holds the parents of the offending relations
+	ParentID []string `json:"parent_id,omitempty"`
+	// Path holds the organization ids of a cycle, starting and ending with OrganizationID
+	Path    []string `json:"path,omitempty"`
+	Message string   `json:"message"`
+}
@@ -28,6 +28,7 @@ type Person struct {
 	Role                []string              `json:"role,omitempty"`
 	Settings            map[string]string     `json:"settings,omitempty"`
 	ObjectClass         []string              `json:"object_class,omitempty"`
+	Version             int                   `json:"version,omitempty"`
 }
 
 func (person *Person) IsStored() bool {
@@ -178,6 +179,7 @@ func (p *Person) Dup() *Person {
 		PreferredFamilyName: p.PreferredFamilyName,
 		BirthDate:           p.BirthDate,
 		HonorificPrefix:     p.HonorificPrefix,
+		Version:             p.Version,
 	}
 	newP.Token = map[string]string{}
 	for typ, val := range p.Token {
@@ -0,0 +1,107 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"math"
+)
+
+// weights of the signals that make up PersonDuplicate.Score
+const (
+	duplicateNameWeight              = 0.5
+	duplicateBirthDateWeight         = 0.2
+	duplicateDifferentBirthDateScore = -0.3
+	duplicateEmailWeight             = 0.2
+	duplicateIdentifierWeight        = 0.4
+)
+
+type DuplicateService interface {
+	FindPersonDuplicates(context.Context, DuplicateParams) ([]*PersonDuplicate, error)
+}
+
+type DuplicateParams struct {
+	// MinScore drops candidate pairs that score lower (0-1).
+	// The default 0.6 requires more than a similar name.
+	MinScore float64
+	// NameThreshold is the minimum trigram similarity (0-1) of two names to consider them a candidate pair
+	NameThreshold float64
+	// Limit caps the number of pairs, 0 returns all pairs
+	Limit int
+}
+
+func (p DuplicateParams) MergeDefault() DuplicateParams {
+	minScore := p.MinScore
+	if minScore == 0 {
+		minScore = 0.6
+	}
+	nameThreshold := p.NameThreshold
+	if nameThreshold == 0 {
+		nameThreshold = 0.8
+	}
+	return DuplicateParams{
+		MinScore:      minScore,
+		NameThreshold: nameThreshold,
+		Limit:         p.Limit,
+	}
+}
+
+func (p DuplicateParams) Validate() error {
+	if p.MinScore < 0 || p.MinScore > 1 {
+		return fmt.Errorf("%w: min score must be between 0 and 1", ErrInvalidArgument)
+	}
+	if p.NameThreshold < 0 || p.NameThreshold > 1 {
+		return fmt.Errorf("%w: name threshold must be between 0 and 1", ErrInvalidArgument)
+	}
+	if p.Limit < 0 {
+		return fmt.Errorf("%w: limit must not be negative", ErrInvalidArgument)
+	}
+	return nil
+}
+
+// PersonDuplicate is a pair of person records that probably describe the same person.
+// SurvivorID is the record to keep in a merge: the active record, or else the oldest.
+type PersonDuplicate struct {
+	SurvivorID  string
+	DuplicateID string
+	Score       float64
+	Reasons     []string
+
+	// signals, compared after normalization
+	NameSimilarity    float64
+	BirthDate         [2]string
+	SameEmail         bool
+	SharedIdentifiers []string
+}
+
+// ScorePersonDuplicate sets the score (0-1) and reasons of d from its signals
+func ScorePersonDuplicate(d *PersonDuplicate) {
+	score := 0.0
+	reasons := []string{}
+
+	if d.NameSimilarity > 0 {
+		score += duplicateNameWeight * d.NameSimilarity
+		reasons = append(reasons, fmt.Sprintf("name similarity %.2f", d.NameSimilarity))
+	}
+	if d.BirthDate[0] != "" && d.BirthDate[1] != "" {
+		if d.BirthDate[0] == d.BirthDate[1] {
+			score += duplicateBirthDateWeight
+			reasons = append(reasons, "same birth date")
+		} else {
+			score += duplicateDifferentBirthDateScore
+			reasons = append(reasons, "different birth date")
+		}
+	}
+	if d.SameEmail {
+		score += duplicateEmailWeight
+		reasons = append(reasons, "same email")
+	}
+	if len(d.SharedIdentifiers) > 0 {
+		score += duplicateIdentifierWeight
+		for _, id := range d.SharedIdentifiers {
+			reasons = append(reasons, "shared identifier "+id)
+		}
+	}
+
+	d.Score = math.Round(math.Max(0, math.Min(1, score))*100) / 100
+	d.Reasons = reasons
+}
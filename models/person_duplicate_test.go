@@ -0,0 +1,93 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestScorePersonDuplicate(t *testing.T) {
+	tests := []struct {
+		name    string
+		dup     PersonDuplicate
+		score   float64
+		reasons []string
+	}{
+		{
+			name:    "no signals",
+			score:   0,
+			reasons: []string{},
+		},
+		{
+			name:    "similar name only",
+			dup:     PersonDuplicate{NameSimilarity: 0.9},
+			score:   0.45,
+			reasons: []string{"name similarity 0.90"},
+		},
+		{
+			name: "similar name and same birth date and email",
+			dup: PersonDuplicate{
+				NameSimilarity: 1,
+				BirthDate:      [2]string{"1980-01-01", "1980-01-01"},
+				SameEmail:      true,
+			},
+			score:   0.9,
+			reasons: []string{"name similarity 1.00", "same birth date", "same email"},
+		},
+		{
+			name: "one birth date is unknown",
+			dup: PersonDuplicate{
+				NameSimilarity: 1,
+				BirthDate:      [2]string{"1980-01-01", ""},
+			},
+			score:   0.5,
+			reasons: []string{"name similarity 1.00"},
+		},
+		{
+			name: "different birth date",
+			dup: PersonDuplicate{
+				NameSimilarity: 0.8,
+				BirthDate:      [2]string{"1980-01-01", "1981-01-01"},
+			},
+			score:   0.1,
+			reasons: []string{"name similarity 0.80", "different birth date"},
+		},
+		{
+			name: "never below 0",
+			dup: PersonDuplicate{
+				BirthDate: [2]string{"1980-01-01", "1981-01-01"},
+			},
+			score:   0,
+			reasons: []string{"different birth date"},
+		},
+		{
+			name: "never above 1",
+			dup: PersonDuplicate{
+				NameSimilarity:    1,
+				BirthDate:         [2]string{"1980-01-01", "1980-01-01"},
+				SameEmail:         true,
+				SharedIdentifiers: []string{"urn:orcid:0000-0001", "urn:ugent_id:1"},
+			},
+			score: 1,
+			reasons: []string{
+				"name similarity 1.00",
+				"same birth date",
+				"same email",
+				"shared identifier urn:orcid:0000-0001",
+				"shared identifier urn:ugent_id:1",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := tt.dup
+			ScorePersonDuplicate(&d)
+			if d.Score != tt.score {
+				t.Errorf("score: got %v, want %v", d.Score, tt.score)
+			}
+			if !reflect.DeepEqual(d.Reasons, tt.reasons) {
+				t.Errorf("reasons: got %q, want %q", d.Reasons, tt.reasons)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+)
+
+// conflict rules for MergeParams.OnConflict
+const (
+	// MergeKeepSurvivor keeps the value of the surviving record
+	MergeKeepSurvivor = "survivor"
+	// MergeKeepNewest keeps the value of the most recently updated record
+	MergeKeepNewest = "newest"
+	// MergeFail aborts the merge with ErrConflict
+	MergeFail = "fail"
+)
+
+type MergeParams struct {
+	// OnConflict decides what happens when records hold a different value for
+	// the orcid, a token type or a settings key
+	OnConflict string
+}
+
+func (p MergeParams) MergeDefault() MergeParams {
+	onConflict := p.OnConflict
+	if onConflict == "" {
+		onConflict = MergeKeepSurvivor
+	}
+	return MergeParams{
+		OnConflict: onConflict,
+	}
+}
+
+func (p MergeParams) Validate() error {
+	switch p.OnConflict {
+	case MergeKeepSurvivor, MergeKeepNewest, MergeFail:
+		return nil
+	}
+	return fmt.Errorf("%w: unknown conflict rule %q", ErrInvalidArgument, p.OnConflict)
+}
+
+// MergePerson returns a copy of survivor that also holds the identifiers,
+// organization memberships, tokens, roles and settings of others.
+// All other attributes are taken from survivor.
+// Identifiers, memberships and roles are combined. A person has only one orcid,
+// so orcids, tokens and settings that differ are resolved by params.OnConflict.
+func MergePerson(survivor *Person, others []*Person, params MergeParams) (*Person, error) {
+	params = params.MergeDefault()
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	merged := survivor.Dup()
+	// newest record that provided the current value (survivor by default)
+	valueSource := map[string]*Person{}
+
+	resolve := func(key string, current string, other *Person, val string) (bool, error) {
+		if current == val {
+			return false, nil
+		}
+		if current == "" {
+			valueSource[key] = other
+			return true, nil
+		}
+		switch params.OnConflict {
+		case MergeKeepNewest:
+			src := valueSource[key]
+			if src == nil {
+				src = survivor
+			}
+			if other.DateUpdated != nil && (src.DateUpdated == nil || other.DateUpdated.After(*src.DateUpdated)) {
+				valueSource[key] = other
+				return true, nil
+			}
+			return false, nil
+		case MergeFail:
+			return false, fmt.Errorf("%w: person records %s and %s have a different %s", ErrConflict, survivor.ID, other.ID, key)
+		}
+		return false, nil
+	}
+
+	for _, other := range others {
+		for _, urn := range other.Identifier {
+			if urn.Namespace == "orcid" {
+				current := ""
+				if orcids := merged.GetIdentifierValuesByNS("orcid"); len(orcids) > 0 {
+					current = orcids[0]
+				}
+				replace, err := resolve("orcid", current, other, urn.Value)
+				if err != nil {
+					return nil, err
+				}
+				if replace {
+					ids := make([]*URN, 0, len(merged.Identifier))
+					for _, id := range merged.Identifier {
+						if id.Namespace != "orcid" {
+							ids = append(ids, id)
+						}
+					}
+					merged.SetIdentifier(append(ids, urn.Dup())...)
+				}
+				continue
+			}
+			if !slices.ContainsFunc(merged.Identifier, func(id *URN) bool { return *id == *urn }) {
+				merged.AddIdentifier(urn.Dup())
+			}
+		}
+
+		for _, orgMember := range other.Organization {
+			if !slices.ContainsFunc(merged.Organization, orgMember.SameAs) {
+				merged.AddOrganizationMember(orgMember.Dup())
+			}
+		}
+
+		for _, role := range other.Role {
+			if !slices.Contains(merged.Role, role) {
+				merged.AddRole(role)
+			}
+		}
+
+		for typ, val := range other.Token {
+			replace, err := resolve("token "+typ, merged.Token[typ], other, val)
+			if err != nil {
+				return nil, err
+			}
+			if replace {
+				merged.SetToken(typ, val)
+			}
+		}
+
+		for key, val := range other.Settings {
+			replace, err := resolve("setting "+key, merged.Settings[key], other, val)
+			if err != nil {
+				return nil, err
+			}
+			if replace {
+				if merged.Settings == nil {
+					merged.Settings = map[string]string{}
+				}
+				merged.Settings[key] = val
+			}
+		}
+	}
+
+	return merged, nil
+}
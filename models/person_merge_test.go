@@ -0,0 +1,174 @@
+package models
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMergePerson(t *testing.T) {
+	older := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	newPerson := func(id string, dateUpdated time.Time, fn func(*Person)) *Person {
+		p := NewPerson()
+		p.ID = id
+		p.DateUpdated = &dateUpdated
+		fn(p)
+		return p
+	}
+
+	tests := []struct {
+		name       string
+		survivor   *Person
+		others     []*Person
+		onConflict string
+		check      func(*testing.T, *Person)
+		err        error
+	}{
+		{
+			name: "combines identifiers, memberships and roles",
+			survivor: newPerson("a", older, func(p *Person) {
+				p.SetIdentifier(NewURN("ugent_id", "1"))
+				p.SetOrganizationMember(NewOrganizationMember("org1"))
+				p.SetRole("admin")
+			}),
+			others: []*Person{newPerson("b", newer, func(p *Person) {
+				p.SetIdentifier(NewURN("ugent_id", "1"), NewURN("ugent_id", "2"))
+				p.SetOrganizationMember(NewOrganizationMember("org1"), NewOrganizationMember("org2"))
+				p.SetRole("admin", "curator")
+			})},
+			check: func(t *testing.T, p *Person) {
+				if got := p.GetIdentifierValuesByNS("ugent_id"); !reflect.DeepEqual(got, []string{"1", "2"}) {
+					t.Errorf("identifiers: got %v", got)
+				}
+				if len(p.Organization) != 2 {
+					t.Errorf("memberships: got %d, want 2", len(p.Organization))
+				}
+				if !reflect.DeepEqual(p.Role, []string{"admin", "curator"}) {
+					t.Errorf("roles: got %v", p.Role)
+				}
+			},
+		},
+		{
+			name: "keeps other attributes of the survivor",
+			survivor: newPerson("a", older, func(p *Person) {
+				p.Name = "Survivor"
+			}),
+			others: []*Person{newPerson("b", newer, func(p *Person) {
+				p.Name = "Other"
+			})},
+			check: func(t *testing.T, p *Person) {
+				if p.ID != "a" || p.Name != "Survivor" {
+					t.Errorf("got id %q and name %q", p.ID, p.Name)
+				}
+			},
+		},
+		{
+			name:     "takes values the survivor lacks",
+			survivor: newPerson("a", older, func(p *Person) {}),
+			others: []*Person{newPerson("b", older, func(p *Person) {
+				p.SetIdentifier(NewURN("orcid", "0000-0001"))
+				p.Token = map[string]string{"biblio": "secret"}
+				p.Settings = map[string]string{"lang": "nl"}
+			})},
+			onConflict: MergeFail,
+			check: func(t *testing.T, p *Person) {
+				if got := p.GetIdentifierValuesByNS("orcid"); !reflect.DeepEqual(got, []string{"0000-0001"}) {
+					t.Errorf("orcid: got %v", got)
+				}
+				if got := p.GetTokenValue("biblio"); got != "secret" {
+					t.Errorf("token: got %q", got)
+				}
+				if got := p.Settings["lang"]; got != "nl" {
+					t.Errorf("setting: got %q", got)
+				}
+			},
+		},
+		{
+			name: "keeps the orcid of the survivor by default",
+			survivor: newPerson("a", older, func(p *Person) {
+				p.SetIdentifier(NewURN("orcid", "0000-0001"))
+			}),
+			others: []*Person{newPerson("b", newer, func(p *Person) {
+				p.SetIdentifier(NewURN("orcid", "0000-0002"))
+			})},
+			check: func(t *testing.T, p *Person) {
+				if got := p.GetIdentifierValuesByNS("orcid"); !reflect.DeepEqual(got, []string{"0000-0001"}) {
+					t.Errorf("orcid: got %v", got)
+				}
+			},
+		},
+		{
+			name: "keeps the orcid of the newest record",
+			survivor: newPerson("a", older, func(p *Person) {
+				p.SetIdentifier(NewURN("orcid", "0000-0001"), NewURN("ugent_id", "1"))
+			}),
+			others: []*Person{newPerson("b", newer, func(p *Person) {
+				p.SetIdentifier(NewURN("orcid", "0000-0002"))
+			})},
+			onConflict: MergeKeepNewest,
+			check: func(t *testing.T, p *Person) {
+				if got := p.GetIdentifierValuesByNS("orcid"); !reflect.DeepEqual(got, []string{"0000-0002"}) {
+					t.Errorf("orcid: got %v", got)
+				}
+				if got := p.GetIdentifierValuesByNS("ugent_id"); !reflect.DeepEqual(got, []string{"1"}) {
+					t.Errorf("ugent_id: got %v", got)
+				}
+			},
+		},
+		{
+			name: "keeps the token of the survivor when it is newer",
+			survivor: newPerson("a", newer, func(p *Person) {
+				p.Token = map[string]string{"biblio": "survivor"}
+			}),
+			others: []*Person{newPerson("b", older, func(p *Person) {
+				p.Token = map[string]string{"biblio": "other"}
+			})},
+			onConflict: MergeKeepNewest,
+			check: func(t *testing.T, p *Person) {
+				if got := p.GetTokenValue("biblio"); got != "survivor" {
+					t.Errorf("token: got %q", got)
+				}
+			},
+		},
+		{
+			name: "fails on a different setting",
+			survivor: newPerson("a", older, func(p *Person) {
+				p.Settings = map[string]string{"lang": "nl"}
+			}),
+			others: []*Person{newPerson("b", newer, func(p *Person) {
+				p.Settings = map[string]string{"lang": "en"}
+			})},
+			onConflict: MergeFail,
+			err:        ErrConflict,
+		},
+		{
+			name:       "rejects an unknown conflict rule",
+			survivor:   newPerson("a", older, func(p *Person) {}),
+			onConflict: "oldest",
+			err:        ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			survivor := tt.survivor.Dup()
+			merged, err := MergePerson(tt.survivor, tt.others, MergeParams{OnConflict: tt.onConflict})
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("got error %v, want %v", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			tt.check(t, merged)
+			if !reflect.DeepEqual(tt.survivor.Dup(), survivor) {
+				t.Error("survivor was modified")
+			}
+		})
+	}
+}
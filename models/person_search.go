@@ -1,16 +1,30 @@
 package models
 
-import "context"
+import (
+	"context"
+	"fmt"
+	"time"
+)
 
 type PersonSuggestService interface {
-	SuggestPeople(context.Context, PersonSuggestParams) ([]*Person, error)
-	RebuildAutocompletePeople(context.Context) error
+	// SuggestPeople also returns match metadata per hit (in the same order) if params.Highlight is set
+	SuggestPeople(context.Context, PersonSuggestParams) ([]*Person, []*Match, error)
+	RebuildAutocompletePeople(context.Context, RebuildParams) error
 }
 
+// DefaultSimilarityThreshold is the minimum trigram word similarity (0-1) between
+// the query and a name for a suggestion that does not match the full text index
+const DefaultSimilarityThreshold = 0.5
+
 type PersonSuggestParams struct {
 	Query  string
 	Limit  uint32
 	Active []bool
+	// Threshold is the minimum trigram word similarity of query and name,
+	// lower values tolerate more typos
+	Threshold float64
+	// Highlight requests match metadata per hit
+	Highlight bool
 }
 
 func (p PersonSuggestParams) MergeDefault() PersonSuggestParams {
@@ -22,9 +36,167 @@ func (p PersonSuggestParams) MergeDefault() PersonSuggestParams {
 	if limit == 0 {
 		limit = 20
 	}
+	threshold := p.Threshold
+	if threshold == 0 {
+		threshold = DefaultSimilarityThreshold
+	}
 	return PersonSuggestParams{
-		Query:  p.Query,
-		Limit:  limit,
-		Active: active,
+		Query:     p.Query,
+		Limit:     limit,
+		Active:    active,
+		Threshold: threshold,
+		Highlight: p.Highlight,
+	}
+}
+
+func (p PersonSuggestParams) Validate() error {
+	return validateThreshold(p.Threshold)
+}
+
+func validateThreshold(threshold float64) error {
+	if threshold < 0 || threshold > 1 {
+		return fmt.Errorf("%w: threshold must be between 0 and 1", ErrInvalidArgument)
+	}
+	return nil
+}
+
+// sort orders of PersonSearchParams.Sort. A leading "-" sorts descending.
+const (
+	SortRelevance       = "relevance"
+	SortName            = "name"
+	SortNameDesc        = "-name"
+	SortDateUpdated     = "date_updated"
+	SortDateUpdatedDesc = "-date_updated"
+)
+
+// person facets returned by SearchPeople
+const (
+	FacetOrganization        = "organization"
+	FacetJobCategory         = "job_category"
+	FacetRole                = "role"
+	FacetObjectClass         = "object_class"
+	FacetIdentifierNamespace = "identifier_namespace"
+	FacetActive              = "active"
+)
+
+type PersonSearchService interface {
+	SearchPeople(context.Context, PersonSearchParams) (*PersonSearchResult, error)
+}
+
+// PersonSearchParams filters person records. Values within a filter are combined with OR,
+// filters are combined with AND.
+type PersonSearchParams struct {
+	Query string
+	// Organization holds organization ids the person must be a member of
+	Organization []string
+	// IncludeSubOrganizations also matches members of organizations below those in Organization
+	IncludeSubOrganizations bool
+	// AsOf only matches (and counts in the organization facet) memberships valid at this time, now if nil,
+	// and follows parent relations valid at this time for IncludeSubOrganizations
+	AsOf *time.Time
+	// Historic matches (and counts) any membership, past, current or future, instead of those valid at AsOf
+	Historic    bool
+	JobCategory []string
+	Role        []string
+	ObjectClass []string
+	// IdentifierNamespace holds identifier namespaces the person must have an identifier of
+	IdentifierNamespace []string
+	Active              []bool
+	Sort                string
+	Offset              uint32
+	Limit               uint32
+	// FacetLimit is the maximum number of values per facet
+	FacetLimit uint32
+}
+
+func (p PersonSearchParams) MergeDefault() PersonSearchParams {
+	active := p.Active
+	if len(active) == 0 {
+		active = []bool{true, false}
 	}
+	sort := p.Sort
+	if sort == "" {
+		if p.Query != "" {
+			sort = SortRelevance
+		} else {
+			sort = SortName
+		}
+	}
+	limit := p.Limit
+	if limit == 0 {
+		limit = 20
+	}
+	facetLimit := p.FacetLimit
+	if facetLimit == 0 {
+		facetLimit = 50
+	}
+	asOf := p.AsOf
+	if asOf == nil && !p.Historic {
+		now := time.Now()
+		asOf = &now
+	}
+	return PersonSearchParams{
+		Query:                   p.Query,
+		Organization:            p.Organization,
+		IncludeSubOrganizations: p.IncludeSubOrganizations,
+		AsOf:                    asOf,
+		Historic:                p.Historic,
+		JobCategory:             p.JobCategory,
+		Role:                    p.Role,
+		ObjectClass:             p.ObjectClass,
+		IdentifierNamespace:     p.IdentifierNamespace,
+		Active:                  active,
+		Sort:                    sort,
+		Offset:                  p.Offset,
+		Limit:                   limit,
+		FacetLimit:              facetLimit,
+	}
+}
+
+func (p PersonSearchParams) Validate() error {
+	if p.Historic && p.AsOf != nil {
+		return fmt.Errorf("%w: historic and as of can't be combined", ErrInvalidArgument)
+	}
+	switch p.Sort {
+	case SortRelevance:
+		if p.Query == "" {
+			return fmt.Errorf("%w: sort by relevance requires a query", ErrInvalidArgument)
+		}
+	case SortName, SortNameDesc, SortDateUpdated, SortDateUpdatedDesc:
+	default:
+		return fmt.Errorf("%w: unknown sort %q", ErrInvalidArgument, p.Sort)
+	}
+	return nil
+}
+
+// Match explains why a suggested record matched
+type Match struct {
+	// Rank is the score the hits are ordered by
+	Rank   float64
+	Fields []*FieldMatch
+}
+
+// FieldMatch is an indexed attribute that matched the query.
+// Identifiers are reported as "identifier.<namespace>".
+type FieldMatch struct {
+	Field string
+	Value string
+	// Highlight is a fragment of Value with the matched terms between <b> and </b>.
+	// Values that only match by trigram similarity are not marked.
+	Highlight string
+}
+
+type FacetValue struct {
+	Value string
+	Count int
+}
+
+type PersonSearchResult struct {
+	Total  int
+	Offset uint32
+	Limit  uint32
+	Hits   []*Person
+	// Facets holds the value counts of every facet over all matching records,
+	// most frequent values first
+	Facets map[string][]*FacetValue
 }
@@ -2,6 +2,7 @@ package models
 
 import (
 	"context"
+	"time"
 )
 
 type PersonService interface {
@@ -13,12 +14,17 @@ type PersonService interface {
 	GetPeopleById(context.Context, ...string) ([]*Person, error)
 	DeletePerson(context.Context, string) error
 	EachPerson(context.Context, func(*Person) bool) error
-	SetPersonToken(context.Context, string, string, string) error
-	SetPersonOrcid(context.Context, string, string) error
-	SetPersonRole(context.Context, string, []string) error
-	SetPersonSettings(context.Context, string, map[string]string) error
+	SetPersonToken(context.Context, string, string, string, int) error
+	SetPersonOrcid(context.Context, string, string, int) error
+	SetPersonRole(context.Context, string, []string, int) error
+	SetPersonSettings(context.Context, string, map[string]string, int) error
 	GetPeople(context.Context) ([]*Person, string, error)
 	GetMorePeople(context.Context, string) ([]*Person, string, error)
+	GetPeopleChanges(context.Context, time.Time) ([]*Person, []*Tombstone, string, error)
+	GetMorePeopleChanges(context.Context, time.Time, string) ([]*Person, []*Tombstone, string, error)
 	GetPersonIDActive(context.Context, bool) ([]string, error)
 	SetPersonActive(context.Context, string, bool) error
+	UpsertPeople(context.Context, UpsertParams, ...*Person) ([]*UpsertResult, error)
+	MergePeople(context.Context, string, []string, MergeParams) (*Person, error)
+	GetPersonTombstones(context.Context, ...string) ([]*Tombstone, error)
 }
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// DefaultRebuildBatchSize is the default number of records updated per transaction
+const DefaultRebuildBatchSize = 1000
+
+// RebuildParams controls a rebuild of the autocomplete index.
+// A rebuild that fails or is interrupted resumes where it stopped on the next run.
+type RebuildParams struct {
+	// BatchSize is the number of records updated per transaction
+	BatchSize int
+	// Restart discards the progress of an earlier, unfinished run
+	Restart bool
+	// StaleOnly only writes records whose index values changed,
+	// e.g. after a change of the indexed fields
+	StaleOnly bool
+	// Progress is called after every batch if set
+	Progress func(RebuildProgress)
+}
+
+func (p RebuildParams) MergeDefault() RebuildParams {
+	batchSize := p.BatchSize
+	if batchSize == 0 {
+		batchSize = DefaultRebuildBatchSize
+	}
+	return RebuildParams{
+		BatchSize: batchSize,
+		Restart:   p.Restart,
+		StaleOnly: p.StaleOnly,
+		Progress:  p.Progress,
+	}
+}
+
+func (p RebuildParams) Validate() error {
+	if p.BatchSize < 0 {
+		return fmt.Errorf("%w: batch size must not be negative", ErrInvalidArgument)
+	}
+	return nil
+}
+
+type RebuildProgress struct {
+	// Processed is the number of records read in this run
+	Processed int
+	// Updated is the number of records written in this run
+	Updated int
+	// Total is the number of records this run has to process, 0 if unknown
+	Total int
+	// Elapsed is the duration of this run so far
+	Elapsed time.Duration
+}
+
+func (p RebuildProgress) RowsPerSecond() float64 {
+	if p.Elapsed <= 0 {
+		return 0
+	}
+	return float64(p.Processed) / p.Elapsed.Seconds()
+}
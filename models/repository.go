@@ -3,6 +3,16 @@ package models
 type Repository interface {
 	PersonService
 	PersonSuggestService
+	PersonSearchService
 	OrganizationService
 	OrganizationSuggestService
+	OrganizationSearchService
+	OrganizationTreeService
+	OrganizationLineageService
+	OrganizationMemberService
+	EventService
+	WebhookService
+	RevisionService
+	RevertService
+	DuplicateService
 }
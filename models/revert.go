@@ -0,0 +1,72 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// statuses of RevertResult.Status
+const (
+	RevertReverted  = "reverted"
+	RevertUnchanged = "unchanged"
+	RevertSkipped   = "skipped"
+	RevertError     = "error"
+)
+
+// RevertParams selects the records to revert, and the state to revert them to.
+// Either set ID and Version to restore a single record to the state of that revision,
+// or set Actor and a time window to restore every record that Actor changed within [From, Until)
+// to the state before its first change in that window.
+type RevertParams struct {
+	ID      string
+	Version int
+
+	Actor Actor
+	From  time.Time
+	Until time.Time
+	// Force also reverts records that were changed by others after the window,
+	// and recreates records that were merged into another record
+	Force bool
+
+	// DryRun reports what would change, without changing anything
+	DryRun bool
+}
+
+func (p RevertParams) Validate() error {
+	if p.ID != "" {
+		if p.Version <= 0 {
+			return fmt.Errorf("%w: version", ErrMissingArgument)
+		}
+		return nil
+	}
+	if p.Actor.Source == "" {
+		return fmt.Errorf("%w: either id or actor source", ErrMissingArgument)
+	}
+	if p.From.IsZero() || p.Until.IsZero() {
+		return fmt.Errorf("%w: from and until", ErrMissingArgument)
+	}
+	if !p.From.Before(p.Until) {
+		return fmt.Errorf("%w: from must be before until", ErrInvalidArgument)
+	}
+	return nil
+}
+
+type RevertResult struct {
+	ID string
+	// Version is the version of the revision the record is reverted to.
+	// It is 0 when the record is reverted to not existing at all.
+	Version int
+	Status  string
+	// Changes lists the attributes that (would) change
+	Changes []*FieldChange
+	// Warnings lists what is lost or left inconsistent, e.g. tokens,
+	// which are not kept in revisions
+	Warnings []string
+	Error    error
+}
+
+type RevertService interface {
+	RevertPeople(context.Context, RevertParams) ([]*RevertResult, error)
+	RevertOrganizations(context.Context, RevertParams) ([]*RevertResult, error)
+}
@@ -0,0 +1,97 @@
+package models
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+)
+
+// Revision is a snapshot of a record, taken on every change.
+// Data holds the record as it was after the change (without tokens),
+// and is empty when the record was deleted.
+type Revision struct {
+	RecordID    string          `json:"record_id"`
+	Version     int             `json:"version"`
+	DateCreated *time.Time      `json:"date_created"`
+	Type        string          `json:"type"`
+	Actor       Actor           `json:"actor"`
+	Data        json.RawMessage `json:"data,omitempty"`
+}
+
+// FieldChange is a change of a single top level attribute between two revisions.
+// Old or New is empty when the attribute was absent.
+type FieldChange struct {
+	Field string          `json:"field"`
+	Old   json.RawMessage `json:"old,omitempty"`
+	New   json.RawMessage `json:"new,omitempty"`
+}
+
+// attributes that change on every write
+var revisionDiffIgnore = map[string]bool{
+	"date_updated": true,
+	"version":      true,
+}
+
+// DiffRevisions returns the attributes that differ between prev and next, sorted by name.
+// prev can be nil for the first revision.
+func DiffRevisions(prev, next *Revision) ([]*FieldChange, error) {
+	oldFields := map[string]json.RawMessage{}
+	newFields := map[string]json.RawMessage{}
+	if prev != nil && len(prev.Data) > 0 {
+		if err := json.Unmarshal(prev.Data, &oldFields); err != nil {
+			return nil, err
+		}
+	}
+	if len(next.Data) > 0 {
+		if err := json.Unmarshal(next.Data, &newFields); err != nil {
+			return nil, err
+		}
+	}
+
+	changes := []*FieldChange{}
+	for field, oldVal := range oldFields {
+		if revisionDiffIgnore[field] {
+			continue
+		}
+		newVal, ok := newFields[field]
+		if !ok || !jsonEqual(oldVal, newVal) {
+			changes = append(changes, &FieldChange{Field: field, Old: oldVal, New: newVal})
+		}
+	}
+	for field, newVal := range newFields {
+		if revisionDiffIgnore[field] {
+			continue
+		}
+		if _, ok := oldFields[field]; !ok {
+			changes = append(changes, &FieldChange{Field: field, New: newVal})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Field < changes[j].Field
+	})
+
+	return changes, nil
+}
+
+// jsonEqual compares two JSON values, ignoring insignificant whitespace and key order
+func jsonEqual(a, b json.RawMessage) bool {
+	if bytes.Equal(a, b) {
+		return true
+	}
+	var va, vb any
+	if json.Unmarshal(a, &va) != nil || json.Unmarshal(b, &vb) != nil {
+		return false
+	}
+	ca, _ := json.Marshal(va)
+	cb, _ := json.Marshal(vb)
+	return bytes.Equal(ca, cb)
+}
+
+type RevisionService interface {
+	// GetPersonRevisions returns all revisions of a person record, oldest first
+	GetPersonRevisions(context.Context, string) ([]*Revision, error)
+	// GetOrganizationRevisions returns all revisions of an organization record, oldest first
+	GetOrganizationRevisions(context.Context, string) ([]*Revision, error)
+}
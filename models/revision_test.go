@@ -0,0 +1,95 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDiffRevisions(t *testing.T) {
+	rev := func(data string) *Revision {
+		if data == "" {
+			return &Revision{}
+		}
+		return &Revision{Data: json.RawMessage(data)}
+	}
+
+	tests := []struct {
+		name  string
+		prev  *Revision
+		next  *Revision
+		want  []FieldChange
+		isErr bool
+	}{
+		{
+			name: "first revision",
+			prev: nil,
+			next: rev(`{"name": "Jane", "version": 1}`),
+			want: []FieldChange{{Field: "name", New: json.RawMessage(`"Jane"`)}},
+		},
+		{
+			name: "changed, added and removed attributes, sorted by name",
+			prev: rev(`{"name": "Jane", "email": "jane@example.org"}`),
+			next: rev(`{"name": "John", "role": ["admin"]}`),
+			want: []FieldChange{
+				{Field: "email", Old: json.RawMessage(`"jane@example.org"`)},
+				{Field: "name", Old: json.RawMessage(`"Jane"`), New: json.RawMessage(`"John"`)},
+				{Field: "role", New: json.RawMessage(`["admin"]`)},
+			},
+		},
+		{
+			name: "ignores date_updated and version",
+			prev: rev(`{"name": "Jane", "date_updated": "2023-01-01T00:00:00Z", "version": 1}`),
+			next: rev(`{"name": "Jane", "date_updated": "2023-02-01T00:00:00Z", "version": 2}`),
+			want: []FieldChange{},
+		},
+		{
+			name: "ignores whitespace and key order",
+			prev: rev(`{"settings": {"a": "1", "b": "2"}}`),
+			next: rev(`{"settings":{"b":"2","a":"1"}}`),
+			want: []FieldChange{},
+		},
+		{
+			name: "deleted record",
+			prev: rev(`{"name": "Jane"}`),
+			next: rev(""),
+			want: []FieldChange{{Field: "name", Old: json.RawMessage(`"Jane"`)}},
+		},
+		{
+			name:  "invalid data",
+			prev:  rev(`{"name": `),
+			next:  rev(`{}`),
+			isErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes, err := DiffRevisions(tt.prev, tt.next)
+			if tt.isErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if len(changes) != len(tt.want) {
+				t.Fatalf("got %d changes, want %d", len(changes), len(tt.want))
+			}
+			for i, c := range changes {
+				w := tt.want[i]
+				if c.Field != w.Field || !jsonEqualOrEmpty(c.Old, w.Old) || !jsonEqualOrEmpty(c.New, w.New) {
+					t.Errorf("change %d: got %s %s -> %s, want %s %s -> %s", i, c.Field, c.Old, c.New, w.Field, w.Old, w.New)
+				}
+			}
+		})
+	}
+}
+
+func jsonEqualOrEmpty(a, b json.RawMessage) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return len(a) == len(b)
+	}
+	return jsonEqual(a, b)
+}
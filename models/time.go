@@ -27,3 +27,10 @@ func copyTime(t *time.Time) *time.Time {
 	t2 := *t
 	return &t2
 }
+
+func timeEqual(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
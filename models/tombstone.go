@@ -0,0 +1,41 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// reasons for Tombstone.Reason
+const (
+	TombstoneDeleted = "deleted"
+	TombstoneMerged  = "merged"
+)
+
+// Tombstone remains when a record is deleted or merged into another record,
+// so that clients holding its id can repair their references
+type Tombstone struct {
+	ID          string     `json:"id"`
+	DateDeleted *time.Time `json:"date_deleted"`
+	Reason      string     `json:"reason"`
+	SuccessorID string     `json:"successor_id,omitempty"`
+}
+
+var ErrGone = errors.New("gone")
+
+// GoneError is returned when a requested record no longer exists,
+// but a tombstone is left. It matches both ErrGone and ErrNotFound.
+type GoneError struct {
+	Tombstone *Tombstone
+}
+
+func (e *GoneError) Error() string {
+	if e.Tombstone.SuccessorID != "" {
+		return fmt.Sprintf("record %s was %s into %s", e.Tombstone.ID, e.Tombstone.Reason, e.Tombstone.SuccessorID)
+	}
+	return fmt.Sprintf("record %s was %s", e.Tombstone.ID, e.Tombstone.Reason)
+}
+
+func (e *GoneError) Is(target error) bool {
+	return target == ErrGone || target == ErrNotFound
+}
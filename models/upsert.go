@@ -0,0 +1,35 @@
+package models
+
+const (
+	UpsertCreated   = "created"
+	UpsertUpdated   = "updated"
+	UpsertUnchanged = "unchanged"
+	UpsertError     = "error"
+	UpsertSkipped   = "skipped"
+)
+
+type UpsertParams struct {
+	// MatchOn is either "id" (the default) or an identifier namespace
+	// used to find the stored record to update
+	MatchOn string
+	// Transactional stores all records or none at all
+	Transactional bool
+}
+
+func (p UpsertParams) MergeDefault() UpsertParams {
+	matchOn := p.MatchOn
+	if matchOn == "" {
+		matchOn = "id"
+	}
+	return UpsertParams{
+		MatchOn:       matchOn,
+		Transactional: p.Transactional,
+	}
+}
+
+type UpsertResult struct {
+	Index  int
+	ID     string
+	Status string
+	Error  error
+}
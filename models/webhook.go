@@ -0,0 +1,65 @@
+package models
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// statuses of WebhookDelivery.Status
+const (
+	WebhookDeliveryPending = "pending"
+	// WebhookDeliveryInFlight is claimed by a dispatcher until NextAttempt
+	WebhookDeliveryInFlight  = "in_flight"
+	WebhookDeliveryDelivered = "delivered"
+	WebhookDeliveryFailed    = "failed"
+)
+
+// WebhookSubscription receives a POST request on URL for every event
+// of one of EventType (or of any type when EventType is empty)
+type WebhookSubscription struct {
+	ID          string     `json:"id"`
+	DateCreated *time.Time `json:"date_created"`
+	DateUpdated *time.Time `json:"date_updated"`
+	URL         string     `json:"url"`
+	EventType   []string   `json:"event_type"`
+	// Secret signs every request. It is never returned to clients.
+	Secret string `json:"-"`
+}
+
+// WebhookDelivery is the delivery of a single event to a single subscription
+type WebhookDelivery struct {
+	ID             int               `json:"id"`
+	SubscriptionID string            `json:"subscription_id"`
+	EventID        string            `json:"event_id"`
+	EventType      string            `json:"event_type"`
+	Data           json.RawMessage   `json:"data"`
+	DateCreated    *time.Time        `json:"date_created"`
+	Status         string            `json:"status"`
+	Attempts       int               `json:"attempts"`
+	NextAttempt    *time.Time        `json:"next_attempt,omitempty"`
+	DateDelivered  *time.Time        `json:"date_delivered,omitempty"`
+	AttemptLog     []*WebhookAttempt `json:"attempt_log,omitempty"`
+}
+
+// WebhookAttempt logs a single attempt to deliver a WebhookDelivery
+type WebhookAttempt struct {
+	DateAttempted *time.Time    `json:"date_attempted"`
+	StatusCode    int           `json:"status_code,omitempty"`
+	Error         string        `json:"error,omitempty"`
+	Duration      time.Duration `json:"duration"`
+}
+
+type WebhookService interface {
+	CreateWebhookSubscription(context.Context, *WebhookSubscription) (*WebhookSubscription, error)
+	GetWebhookSubscription(context.Context, string) (*WebhookSubscription, error)
+	GetWebhookSubscriptions(context.Context) ([]*WebhookSubscription, error)
+	DeleteWebhookSubscription(context.Context, string) error
+	// GetWebhookDeliveries returns the most recent deliveries of a subscription, newest first,
+	// including their attempt log
+	GetWebhookDeliveries(context.Context, string, int) ([]*WebhookDelivery, error)
+	// DispatchWebhookDeliveries claims at most limit deliveries that are due and passes them to fn.
+	// fn attempts the delivery, updates Status (pending, delivered or failed) and NextAttempt
+	// of the delivery, and returns the attempt to log.
+	DispatchWebhookDeliveries(context.Context, int, func(*WebhookSubscription, *WebhookDelivery) *WebhookAttempt) (int, error)
+}
@@ -0,0 +1,115 @@
+package publisher
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/ugent-library/people-service/models"
+	"go.uber.org/zap"
+)
+
+// SubjectPrefix is prepended to the event type to form the subject, e.g. "people.person.updated"
+const SubjectPrefix = "people."
+
+type Config struct {
+	// Stream is the name of the JetStream stream that captures all subjects under SubjectPrefix
+	Stream string
+	// BatchSize is the maximum number of events taken from the outbox at once
+	BatchSize int
+	// PollInterval is the time to wait after the outbox is drained
+	PollInterval time.Duration
+	// Retention is how long published events are kept in the outbox, 0 keeps them forever
+	Retention time.Duration
+}
+
+// purgeInterval is the time between two purges of published events
+const purgeInterval = time.Hour
+
+// Publisher relays the events in the outbox of the repository to NATS JetStream.
+// Every event is published at least once. The event id is sent as message id,
+// so that JetStream drops duplicates within its duplicate window.
+type Publisher struct {
+	repository models.Repository
+	js         jetstream.JetStream
+	config     Config
+	logger     *zap.SugaredLogger
+}
+
+func NewPublisher(repo models.Repository, js jetstream.JetStream, config Config, l *zap.SugaredLogger) *Publisher {
+	if config.BatchSize <= 0 {
+		config.BatchSize = 100
+	}
+	if config.PollInterval <= 0 {
+		config.PollInterval = time.Second
+	}
+	return &Publisher{
+		repository: repo,
+		js:         js,
+		config:     config,
+		logger:     l,
+	}
+}
+
+func Subject(eventType string) string {
+	return SubjectPrefix + eventType
+}
+
+// EnsureStream creates the stream, or updates its subjects when it already exists
+func (p *Publisher) EnsureStream(ctx context.Context) error {
+	_, err := p.js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:     p.config.Stream,
+		Subjects: []string{SubjectPrefix + ">"},
+	})
+	return err
+}
+
+// Run publishes events until ctx is cancelled
+func (p *Publisher) Run(ctx context.Context) error {
+	var lastPurge time.Time
+	for {
+		if p.config.Retention > 0 && time.Since(lastPurge) >= purgeInterval {
+			lastPurge = time.Now()
+			n, err := p.repository.PurgeEvents(ctx, lastPurge.Add(-p.config.Retention))
+			if err != nil {
+				p.logger.Errorf("failed to purge published events: %s", err)
+			} else if n > 0 {
+				p.logger.Infof("purged %d published events", n)
+			}
+		}
+
+		n, err := p.repository.PublishEvents(ctx, p.config.BatchSize, func(e *models.Event) error {
+			return p.publish(ctx, e)
+		})
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			p.logger.Errorf("failed to publish events: %s", err)
+		}
+		if n > 0 {
+			p.logger.Infof("published %d events", n)
+		}
+
+		// continue immediately while the outbox holds more events
+		if err == nil && n == p.config.BatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(p.config.PollInterval):
+		}
+	}
+}
+
+func (p *Publisher) publish(ctx context.Context, e *models.Event) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	_, err = p.js.Publish(ctx, Subject(e.Type), data, jetstream.WithMsgID(e.ID))
+	return err
+}
@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/ugent-library/people-service/models"
+)
+
+// changes are paged by ("change_xid", "change_seq"): the transaction that made the change,
+// and a sequence number within that transaction. A transaction id can only be
+// handed out once every transaction with a lower id has finished, so changes
+// of a transaction that is still in progress hold back all later changes
+// instead of being skipped when they are committed.
+type changesCursor struct {
+	Since   time.Time `json:"s"`
+	LastXID int64     `json:"x"`
+	LastSeq int64     `json:"q"`
+}
+
+type change struct {
+	tombstone  bool
+	externalID string
+}
+
+// getChanges returns the next page of changes in table, deleted records included,
+// in the order in which they were committed
+func (repo *repository) getChanges(ctx context.Context, table, tombstoneTable string, cursor changesCursor, limit int) ([]*change, changesCursor, error) {
+	query := fmt.Sprintf(`
+WITH "horizon" AS (
+	SELECT pg_snapshot_xmin(pg_current_snapshot())::text::bigint AS "xmin"
+)
+SELECT "tombstone", "change_xid", "change_seq", "external_id" FROM (
+	SELECT false AS "tombstone", "change_xid", "change_seq", "external_id" FROM %s
+	WHERE "date_updated" >= $1 AND ("change_xid", "change_seq") > ($2, $3) AND "change_xid" < (SELECT "xmin" FROM "horizon")
+	UNION ALL
+	SELECT true AS "tombstone", "change_xid", "change_seq", "external_id" FROM %s
+	WHERE "date_deleted" >= $1 AND ("change_xid", "change_seq") > ($2, $3) AND "change_xid" < (SELECT "xmin" FROM "horizon")
+) AS "changes"
+ORDER BY "change_xid", "change_seq"
+LIMIT $4`,
+		pgx.Identifier{table}.Sanitize(),
+		pgx.Identifier{tombstoneTable}.Sanitize(),
+	)
+
+	rows, err := repo.client.Query(
+		ctx,
+		query,
+		cursor.Since,
+		cursor.LastXID,
+		cursor.LastSeq,
+		limit,
+	)
+	if err != nil {
+		return nil, cursor, err
+	}
+	defer rows.Close()
+
+	next := cursor
+	changes := []*change{}
+	for rows.Next() {
+		c := &change{}
+		if err := rows.Scan(&c.tombstone, &next.LastXID, &next.LastSeq, &c.externalID); err != nil {
+			return nil, cursor, err
+		}
+		changes = append(changes, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, cursor, err
+	}
+
+	return changes, next, nil
+}
+
+func (repo *repository) decodeChangesCursor(since time.Time, tokenValue string) (changesCursor, error) {
+	cursor := changesCursor{}
+	if err := repo.decodeCursor(tokenValue, &cursor); err != nil {
+		return cursor, err
+	}
+	if !cursor.Since.Equal(since) {
+		return cursor, fmt.Errorf("%w: cursor was issued for a different updated_since", models.ErrInvalidArgument)
+	}
+	return cursor, nil
+}
+
+// nextChangesCursor returns a cursor for every page that has changes, so that
+// a reader can continue the feed from there later. An empty page ends the feed.
+func (repo *repository) nextChangesCursor(next changesCursor, changes []*change) (string, error) {
+	if len(changes) == 0 {
+		return "", nil
+	}
+	return repo.encodeCursor(next)
+}
+
+// GetPeopleChanges returns person records updated since since, and tombstones of
+// person records deleted since since, in order of commit. A cursor is returned for every page
+// with changes; it continues the feed, now or later. Changes are withheld as long as
+// an older transaction is still in progress.
+func (repo *repository) GetPeopleChanges(ctx context.Context, since time.Time) ([]*models.Person, []*models.Tombstone, string, error) {
+	return repo.getPeopleChanges(ctx, changesCursor{Since: since})
+}
+
+// GetMorePeopleChanges continues GetPeopleChanges with the same since
+func (repo *repository) GetMorePeopleChanges(ctx context.Context, since time.Time, tokenValue string) ([]*models.Person, []*models.Tombstone, string, error) {
+	cursor, err := repo.decodeChangesCursor(since, tokenValue)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	return repo.getPeopleChanges(ctx, cursor)
+}
+
+func (repo *repository) getPeopleChanges(ctx context.Context, cursor changesCursor) ([]*models.Person, []*models.Tombstone, string, error) {
+	changes, next, err := repo.getChanges(ctx, "people", "person_tombstones", cursor, personPageLimit)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	var ids, tombstoneIDs []string
+	for _, c := range changes {
+		if c.tombstone {
+			tombstoneIDs = append(tombstoneIDs, c.externalID)
+		} else {
+			ids = append(ids, c.externalID)
+		}
+	}
+
+	var people []*models.Person
+	if len(ids) > 0 {
+		people, err = repo.GetPeopleById(ctx, ids...)
+		if err != nil {
+			return nil, nil, "", err
+		}
+	}
+	var tombstones []*models.Tombstone
+	if len(tombstoneIDs) > 0 {
+		tombstones, err = repo.GetPersonTombstones(ctx, tombstoneIDs...)
+		if err != nil {
+			return nil, nil, "", err
+		}
+	}
+
+	sortByChange(people, changes, func(p *models.Person) string { return p.ID })
+	sortByChange(tombstones, changes, func(t *models.Tombstone) string { return t.ID })
+
+	nextCursor, err := repo.nextChangesCursor(next, changes)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	return people, tombstones, nextCursor, nil
+}
+
+// GetOrganizationsChanges is the organization equivalent of GetPeopleChanges
+func (repo *repository) GetOrganizationsChanges(ctx context.Context, since time.Time) ([]*models.Organization, []*models.Tombstone, string, error) {
+	return repo.getOrganizationsChanges(ctx, changesCursor{Since: since})
+}
+
+// GetMoreOrganizationsChanges continues GetOrganizationsChanges with the same since
+func (repo *repository) GetMoreOrganizationsChanges(ctx context.Context, since time.Time, tokenValue string) ([]*models.Organization, []*models.Tombstone, string, error) {
+	cursor, err := repo.decodeChangesCursor(since, tokenValue)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	return repo.getOrganizationsChanges(ctx, cursor)
+}
+
+func (repo *repository) getOrganizationsChanges(ctx context.Context, cursor changesCursor) ([]*models.Organization, []*models.Tombstone, string, error) {
+	changes, next, err := repo.getChanges(ctx, "organizations", "organization_tombstones", cursor, organizationPageLimit)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	var ids, tombstoneIDs []string
+	for _, c := range changes {
+		if c.tombstone {
+			tombstoneIDs = append(tombstoneIDs, c.externalID)
+		} else {
+			ids = append(ids, c.externalID)
+		}
+	}
+
+	var orgs []*models.Organization
+	if len(ids) > 0 {
+		orgs, err = repo.GetOrganizationsById(ctx, ids...)
+		if err != nil {
+			return nil, nil, "", err
+		}
+	}
+	var tombstones []*models.Tombstone
+	if len(tombstoneIDs) > 0 {
+		tombstones, err = repo.GetOrganizationTombstones(ctx, tombstoneIDs...)
+		if err != nil {
+			return nil, nil, "", err
+		}
+	}
+
+	sortByChange(orgs, changes, func(o *models.Organization) string { return o.ID })
+	sortByChange(tombstones, changes, func(t *models.Tombstone) string { return t.ID })
+
+	nextCursor, err := repo.nextChangesCursor(next, changes)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	return orgs, tombstones, nextCursor, nil
+}
+
+// sortByChange sorts records in the order of changes
+func sortByChange[T any](records []T, changes []*change, idOf func(T) string) {
+	pos := make(map[string]int, len(changes))
+	for i, c := range changes {
+		pos[c.externalID] = i
+	}
+	slices.SortFunc(records, func(a, b T) int {
+		return pos[idOf(a)] - pos[idOf(b)]
+	})
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ugent-library/people-service/models"
+)
+
+func personIDs(people []*models.Person) []string {
+	ids := make([]string, 0, len(people))
+	for _, p := range people {
+		ids = append(ids, p.ID)
+	}
+	return ids
+}
+
+func TestGetPeopleChangesWaitsForOlderTransactions(t *testing.T) {
+	repo := newTestRepository(t)
+	ctx := testContext()
+	since := time.Time{}
+
+	first, err := repo.CreatePerson(ctx, newTestPerson("First"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a transaction that is still in progress when a later one commits
+	tx, err := repo.client.Begin(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx.Rollback(ctx)
+	slow := newTestPerson("Slow")
+	if err := repo.createPerson(ctx, tx, slow); err != nil {
+		t.Fatal(err)
+	}
+
+	last, err := repo.CreatePerson(ctx, newTestPerson("Last"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// changes after the open transaction are withheld
+	people, _, cursor, err := repo.GetPeopleChanges(ctx, since)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := personIDs(people); len(got) != 1 || got[0] != first.ID {
+		t.Fatalf("got %v, want only %s", got, first.ID)
+	}
+	if cursor == "" {
+		t.Fatal("expected a cursor")
+	}
+
+	people, _, _, err = repo.GetMorePeopleChanges(ctx, since, cursor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(people) != 0 {
+		t.Fatalf("got %v while the older transaction is in progress, want nothing", personIDs(people))
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// the same cursor now continues with both, in order of transaction
+	people, _, cursor, err = repo.GetMorePeopleChanges(ctx, since, cursor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := personIDs(people); len(got) != 2 || got[0] != slow.ID || got[1] != last.ID {
+		t.Fatalf("got %v, want [%s %s]", got, slow.ID, last.ID)
+	}
+
+	// a delete shows up as a tombstone after the cursor
+	if err := repo.DeletePerson(ctx, first.ID); err != nil {
+		t.Fatal(err)
+	}
+	people, tombstones, _, err := repo.GetMorePeopleChanges(ctx, since, cursor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(people) != 0 || len(tombstones) != 1 || tombstones[0].ID != first.ID {
+		t.Fatalf("got people %v and %d tombstones, want only the tombstone of %s", personIDs(people), len(tombstones), first.ID)
+	}
+}
+
+func TestGetChangesPaging(t *testing.T) {
+	repo := newTestRepository(t)
+	ctx := testContext()
+
+	var want []string
+	for _, name := range []string{"A", "B", "C"} {
+		p, err := repo.CreatePerson(ctx, newTestPerson(name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		want = append(want, p.ID)
+	}
+
+	var got []string
+	cursor := changesCursor{}
+	for {
+		changes, next, err := repo.getChanges(ctx, "people", "person_tombstones", cursor, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(changes) == 0 {
+			break
+		}
+		if len(changes) > 2 {
+			t.Fatalf("got a page of %d changes, want at most 2", len(changes))
+		}
+		for _, c := range changes {
+			got = append(got, c.externalID)
+		}
+		cursor = next
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+}
@@ -1,21 +1,30 @@
 package repository
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"github.com/ugent-library/crypt"
 )
 
 var regexMultipleSpaces = regexp.MustCompile(`\s+`)
-var regexNoBrackets = regexp.MustCompile(`[\[\]()\{\}]`)
 
+// characters with a special meaning in tsquery syntax
+var regexTSQuerySyntax = regexp.MustCompile(`[\[\](){}&|!:*<>'\\]`)
+
+// toTSQuery returns a prefix query that matches all terms in query
+// and its arguments (numbered from $1). Characters with a special meaning in tsquery syntax,
+// like brackets, are removed from the terms. No arguments are returned if no terms remain.
 func toTSQuery(query string) (string, []any) {
+	query = regexTSQuerySyntax.ReplaceAllString(query, " ")
 	// remove duplicate spaces
 	query = regexMultipleSpaces.ReplaceAllString(query, " ")
 	// trim
@@ -26,8 +35,7 @@ func toTSQuery(query string) (string, []any) {
 	argCounter := 0
 
 	for _, qp := range strings.Split(query, " ") {
-		// remove terms that contain brackets
-		if regexNoBrackets.MatchString(qp) {
+		if qp == "" {
 			continue
 		}
 		argCounter++
@@ -46,6 +54,28 @@ func toTSQuery(query string) (string, []any) {
 	return tsQuery, queryArgs
 }
 
+// tsRank returns a rank expression for a query built by toTSQuery with numArgs arguments.
+// Hits on a whole term score on top of prefix hits, so that an exact name or acronym hit
+// ranks above a longer word that merely starts with the term.
+func tsRank(tsQuery string, numArgs int) string {
+	exactParts := make([]string, 0, numArgs)
+	for i := 1; i <= numArgs; i++ {
+		exactParts = append(exactParts, fmt.Sprintf("$%d", i))
+	}
+	exactTSQuery := fmt.Sprintf(
+		"to_tsquery('usimple', %s)",
+		strings.Join(exactParts, " || ' & ' || "),
+	)
+	return fmt.Sprintf(`(ts_rank("ts", %s) + ts_rank("ts", %s))`, tsQuery, exactTSQuery)
+}
+
+// setSimilarityThreshold sets the threshold of the trigram word similarity operator <% for the rest of tx.
+// The operator, unlike a comparison with word_similarity(), can use the trigram indexes.
+func setSimilarityThreshold(ctx context.Context, tx pgx.Tx, threshold float64) error {
+	_, err := tx.Exec(ctx, `SELECT set_config('pg_trgm.word_similarity_threshold', $1, true)`, strconv.FormatFloat(threshold, 'f', -1, 64))
+	return err
+}
+
 func encryptMessage(key []byte, message string) (string, error) {
 	cryptedMsgInBytes, err := crypt.Encrypt(key, []byte(message))
 	if err != nil {
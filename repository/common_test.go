@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestToTSQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  string
+		args  []any
+	}{
+		{
+			name:  "single term",
+			query: "jane",
+			want:  "to_tsquery('usimple', $1 || ':*')",
+			args:  []any{"jane"},
+		},
+		{
+			name:  "multiple terms and spaces",
+			query: "  jane \t doe ",
+			want:  "to_tsquery('usimple', $1 || ':*' || ' & ' || $2 || ':*')",
+			args:  []any{"jane", "doe"},
+		},
+		{
+			name:  "removes tsquery syntax",
+			query: "(jane) & !doe:* 'o\\brien'",
+			want:  "to_tsquery('usimple', $1 || ':*' || ' & ' || $2 || ':*' || ' & ' || $3 || ':*' || ' & ' || $4 || ':*')",
+			args:  []any{"jane", "doe", "o", "brien"},
+		},
+		{
+			name:  "only tsquery syntax",
+			query: "[] | <>",
+			want:  "to_tsquery('usimple', )",
+			args:  []any{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, args := toTSQuery(tt.query)
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+			if !reflect.DeepEqual(args, tt.args) {
+				t.Errorf("args: got %q, want %q", args, tt.args)
+			}
+		})
+	}
+}
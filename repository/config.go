@@ -3,4 +3,8 @@ package repository
 type Config struct {
 	DbUrl  string
 	AesKey string
+	// PersonTsFields and OrganizationTsFields configure the autocomplete index,
+	// DefaultPersonTsFields and DefaultOrganizationTsFields are used when empty
+	PersonTsFields       []TsField
+	OrganizationTsFields []TsField
 }
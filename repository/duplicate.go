@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/ugent-library/people-service/models"
+)
+
+// candidate pairs share an identifier, an email address or a similar name (trigram similarity operator %),
+// every pair is listed once with the lowest row id first
+const personDuplicatesQuery = `
+WITH "p" AS (
+	SELECT
+		"id",
+		"external_id",
+		"active",
+		"date_created",
+		f_unaccent(lower("name")) AS "name",
+		COALESCE("birth_date", '') AS "birth_date",
+		lower(COALESCE("email", '')) AS "email",
+		CASE WHEN jsonb_typeof("identifier") = 'array' THEN "identifier" ELSE '[]'::jsonb END AS "identifier"
+	FROM "people"
+),
+"ids" AS (
+	SELECT "id", "v" FROM "p", jsonb_array_elements_text("identifier") AS "v"
+),
+"pairs" AS (
+	SELECT "a"."id" AS "a_id", "b"."id" AS "b_id" FROM "ids" AS "a"
+	JOIN "ids" AS "b" ON "a"."v" = "b"."v" AND "a"."id" < "b"."id"
+	UNION
+	SELECT "a"."id", "b"."id" FROM "p" AS "a"
+	JOIN "p" AS "b" ON "a"."email" = "b"."email" AND "a"."id" < "b"."id"
+	WHERE "a"."email" <> ''
+	UNION
+	SELECT "a"."id", "b"."id" FROM "people" AS "a"
+	JOIN "people" AS "b" ON f_unaccent(lower("a"."name")) % f_unaccent(lower("b"."name")) AND "a"."id" < "b"."id"
+)
+SELECT
+	"a"."external_id", "a"."active", "a"."date_created",
+	"b"."external_id", "b"."active", "b"."date_created",
+	COALESCE(similarity("a"."name", "b"."name"), 0),
+	"a"."birth_date", "b"."birth_date",
+	"a"."email" <> '' AND "a"."email" = "b"."email",
+	ARRAY(
+		SELECT jsonb_array_elements_text("a"."identifier")
+		INTERSECT
+		SELECT jsonb_array_elements_text("b"."identifier")
+	)
+FROM "pairs"
+JOIN "p" AS "a" ON "a"."id" = "pairs"."a_id"
+JOIN "p" AS "b" ON "b"."id" = "pairs"."b_id"
+`
+
+// FindPersonDuplicates returns pairs of person records that probably describe the same person,
+// highest score first.
+func (repo *repository) FindPersonDuplicates(ctx context.Context, params models.DuplicateParams) ([]*models.PersonDuplicate, error) {
+	params = params.MergeDefault()
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	tx, err := repo.client.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(
+		ctx,
+		`SELECT set_config('pg_trgm.similarity_threshold', $1, true)`,
+		strconv.FormatFloat(params.NameThreshold, 'f', -1, 64),
+	); err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, personDuplicatesQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	duplicates := []*models.PersonDuplicate{}
+	for rows.Next() {
+		var (
+			aID, bID                   string
+			aActive, bActive           bool
+			aDateCreated, bDateCreated time.Time
+		)
+		d := &models.PersonDuplicate{}
+		if err := rows.Scan(
+			&aID, &aActive, &aDateCreated,
+			&bID, &bActive, &bDateCreated,
+			&d.NameSimilarity,
+			&d.BirthDate[0], &d.BirthDate[1],
+			&d.SameEmail,
+			&d.SharedIdentifiers,
+		); err != nil {
+			return nil, err
+		}
+
+		// names below the threshold do not count
+		if d.NameSimilarity < params.NameThreshold {
+			d.NameSimilarity = 0
+		}
+		models.ScorePersonDuplicate(d)
+		if d.Score < params.MinScore {
+			continue
+		}
+
+		d.SurvivorID, d.DuplicateID = aID, bID
+		if (bActive && !aActive) || (bActive == aActive && bDateCreated.Before(aDateCreated)) {
+			d.SurvivorID, d.DuplicateID = bID, aID
+		}
+
+		duplicates = append(duplicates, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(duplicates, func(i, j int) bool {
+		if duplicates[i].Score != duplicates[j].Score {
+			return duplicates[i].Score > duplicates[j].Score
+		}
+		return duplicates[i].SurvivorID < duplicates[j].SurvivorID
+	})
+
+	if params.Limit > 0 && len(duplicates) > params.Limit {
+		duplicates = duplicates[:params.Limit]
+	}
+
+	return duplicates, nil
+}
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/ugent-library/people-service/models"
+)
+
+// getMatches returns the match metadata of every hit: the indexed attributes (in hitFieldValues)
+// that match the query, with the matched terms highlighted by ts_headline.
+// tsQuery and tsQueryArgs are built by toTSQuery; an attribute also matches if it is similar
+// to query according to the trigram word similarity threshold of tx.
+func (repo *repository) getMatches(ctx context.Context, tx pgx.Tx, tsQuery string, tsQueryArgs []any, query string, hitFieldValues [][]fieldValue, ranks []float64) ([]*models.Match, error) {
+	matches := make([]*models.Match, 0, len(hitFieldValues))
+	hits, fields, values := []int{}, []string{}, []string{}
+	for i, fieldValues := range hitFieldValues {
+		matches = append(matches, &models.Match{Rank: ranks[i], Fields: []*models.FieldMatch{}})
+		for _, fv := range fieldValues {
+			hits = append(hits, i)
+			fields = append(fields, fv.field)
+			values = append(values, fv.value)
+		}
+	}
+	if len(hits) == 0 {
+		return matches, nil
+	}
+
+	args := append([]any{}, tsQueryArgs...)
+	args = append(args, hits, fields, values, query)
+	n := len(tsQueryArgs)
+	sqlQuery := fmt.Sprintf(`
+SELECT "i", "f", "v", ts_headline('usimple', "v", %[1]s)
+FROM unnest($%[2]d::int[], $%[3]d::text[], $%[4]d::text[]) WITH ORDINALITY AS "t"("i", "f", "v", "n")
+WHERE to_tsvector('usimple', "v") @@ %[1]s OR f_unaccent(lower($%[5]d)) <%% f_unaccent(lower("v"))
+ORDER BY "n"`,
+		tsQuery, n+1, n+2, n+3, n+4,
+	)
+
+	rows, err := tx.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var i int
+		fm := &models.FieldMatch{}
+		if err := rows.Scan(&i, &fm.Field, &fm.Value, &fm.Highlight); err != nil {
+			return nil, err
+		}
+		matches[i].Fields = append(matches[i].Fields, fm)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return matches, nil
+}
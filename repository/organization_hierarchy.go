@@ -0,0 +1,257 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/ugent-library/people-service/models"
+)
+
+// class of the advisory locks that lockOrganizationRelations takes per organization row id
+const organizationHierarchyLock = 7320651
+
+// postgres error code of a deadlock
+const pgDeadlockDetected = "40P01"
+
+// row ids of organization $1 and of all organizations reachable from it
+// over parent and successor relations, whatever their period
+const organizationRelatedQuery = `
+WITH RECURSIVE "related" AS (
+	SELECT $1::bigint AS "id"
+	UNION
+	SELECT "r"."to_id" FROM (
+		SELECT "organization_id" AS "from_id", "parent_organization_id" AS "to_id" FROM "organization_parents"
+		UNION ALL
+		SELECT "organization_id", "successor_organization_id" FROM "organization_successors"
+	) AS "r"
+	JOIN "related" ON "r"."from_id" = "related"."id"
+)
+SELECT "id" FROM "related" ORDER BY "id"`
+
+// a relation "op" extends a walk up "up" when their periods overlap:
+// a cycle only counts if all its relations are valid at the same time
+const organizationCycleStep = `
+	SELECT "op"."parent_organization_id",
+		GREATEST("up"."from", "op"."from"),
+		LEAST("up"."until", COALESCE("op"."until", 'infinity')),
+		"up"."path_ids" || "op"."parent_organization_id"
+	FROM "organization_parents" AS "op"
+	JOIN "up" ON "op"."organization_id" = "up"."id"
+	WHERE GREATEST("up"."from", "op"."from") < LEAST("up"."until", COALESCE("op"."until", 'infinity'))
+		AND "up"."id" <> "up"."path_ids"[1]
+		AND NOT "op"."parent_organization_id" = any("up"."path_ids"[2:])`
+
+// walks up from organization $1 until it meets itself
+const organizationCycleQuery = `
+WITH RECURSIVE "up" AS (
+	SELECT "op"."parent_organization_id" AS "id", "op"."from", COALESCE("op"."until", 'infinity') AS "until",
+		ARRAY["op"."organization_id", "op"."parent_organization_id"] AS "path_ids"
+	FROM "organization_parents" AS "op"
+	WHERE "op"."organization_id" = $1
+	UNION ALL` + organizationCycleStep + `
+)
+SELECT "path_ids" FROM "up" WHERE "id" = "path_ids"[1]
+LIMIT 1`
+
+// all cycles, once per cycle: walks starting at the lowest row id of the cycle
+const organizationCyclesQuery = `
+WITH RECURSIVE "up" AS (
+	SELECT "op"."parent_organization_id" AS "id", "op"."from", COALESCE("op"."until", 'infinity') AS "until",
+		ARRAY["op"."organization_id", "op"."parent_organization_id"] AS "path_ids"
+	FROM "organization_parents" AS "op"
+	WHERE "op"."parent_organization_id" >= "op"."organization_id"
+	UNION ALL` + organizationCycleStep + `
+		AND "op"."parent_organization_id" >= "up"."path_ids"[1]
+)
+SELECT DISTINCT "path_ids" FROM "up" WHERE "id" = "path_ids"[1]
+ORDER BY "path_ids"`
+
+const organizationInvertedPeriodsQuery = `
+SELECT "o"."external_id", "p"."external_id", "op"."from", "op"."until"
+FROM "organization_parents" AS "op"
+JOIN "organizations" AS "o" ON "o"."id" = "op"."organization_id"
+JOIN "organizations" AS "p" ON "p"."id" = "op"."parent_organization_id"
+WHERE "op"."until" IS NOT NULL AND "op"."until" <= "op"."from"
+ORDER BY "o"."external_id", "op"."from"`
+
+const organizationOverlappingPeriodsQuery = `
+SELECT "o"."external_id", "pa"."external_id", "a"."from", "pb"."external_id", "b"."from"
+FROM "organization_parents" AS "a"
+JOIN "organization_parents" AS "b" ON "b"."organization_id" = "a"."organization_id"
+	AND "b"."parent_organization_id" = "a"."parent_organization_id" AND "b"."id" > "a"."id"
+JOIN "organizations" AS "o" ON "o"."id" = "a"."organization_id"
+JOIN "organizations" AS "pa" ON "pa"."id" = "a"."parent_organization_id"
+JOIN "organizations" AS "pb" ON "pb"."id" = "b"."parent_organization_id"
+WHERE "a"."from" < COALESCE("b"."until", 'infinity') AND "b"."from" < COALESCE("a"."until", 'infinity')
+ORDER BY "o"."external_id", "a"."from"`
+
+// lockOrganizationRelations locks what the cycle checks of organization rowID read:
+// rowID itself exclusively, and every organization it reaches over parent and successor relations
+// (its ancestors and successors) shared. A cycle that two transactions would each add half of
+// passes through the organization that the other one changes, so one of them waits for the other
+// and sees its relations. Changes in unrelated parts of the hierarchy don't wait for each other.
+// Call it after storing the relations of rowID, and before checking them.
+//
+// Relations that show up after the first locks are held can sort below those locks.
+// Two such transactions can deadlock; postgres then aborts one of them,
+// which is reported as models.ErrConflict so that the client can try again.
+func lockOrganizationRelations(ctx context.Context, tx pgx.Tx, rowID int) error {
+	err := lockOrganizationRelationsInOrder(ctx, tx, rowID)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == pgDeadlockDetected {
+		return fmt.Errorf("%w: the organization hierarchy is changed concurrently, try again", models.ErrConflict)
+	}
+	return err
+}
+
+func lockOrganizationRelationsInOrder(ctx context.Context, tx pgx.Tx, rowID int) error {
+	locked := map[int]bool{}
+	for {
+		rows, err := tx.Query(ctx, organizationRelatedQuery, rowID)
+		if err != nil {
+			return err
+		}
+		ids, err := pgx.CollectRows(rows, pgx.RowTo[int])
+		if err != nil {
+			return err
+		}
+
+		// lock in order of row id, so that transactions don't wait for each other in a circle.
+		// the relations can change until the locks are held, so repeat until no new ones show up
+		n := 0
+		for _, id := range ids {
+			if locked[id] {
+				continue
+			}
+			lockFunc := "pg_advisory_xact_lock_shared"
+			if id == rowID {
+				lockFunc = "pg_advisory_xact_lock"
+			}
+			// row ids are folded into the int4 key, a collision only means some extra waiting
+			if _, err := tx.Exec(ctx, `SELECT `+lockFunc+`($1, ($2::bigint % 2147483647)::int)`, organizationHierarchyLock, id); err != nil {
+				return err
+			}
+			locked[id] = true
+			n++
+		}
+		if n == 0 {
+			return nil
+		}
+	}
+}
+
+// checkOrganizationCycle returns models.ErrInvalidArgument if organization rowID
+// became its own ancestor. Call it after storing the parent relations.
+func checkOrganizationCycle(ctx context.Context, tx pgx.Tx, rowID int) error {
+	rows, err := tx.Query(ctx, organizationCycleQuery, rowID)
+	if err != nil {
+		return err
+	}
+	pathIDs, err := pgx.CollectRows(rows, pgx.RowTo[[]int])
+	if err != nil {
+		return err
+	}
+	if len(pathIDs) == 0 {
+		return nil
+	}
+
+	path, err := getOrganizationPath(ctx, tx, pathIDs[0])
+	if err != nil {
+		return err
+	}
+	return fmt.Errorf("%w: organization %s would become its own ancestor: %s",
+		models.ErrInvalidArgument, path[0], strings.Join(path, " -> "))
+}
+
+func (repo *repository) CheckOrganizationHierarchy(ctx context.Context) ([]*models.HierarchyViolation, error) {
+	violations := []*models.HierarchyViolation{}
+
+	rows, err := repo.client.Query(ctx, organizationInvertedPeriodsQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id, parentID string
+		var from, until time.Time
+		if err := rows.Scan(&id, &parentID, &from, &until); err != nil {
+			return nil, err
+		}
+		violations = append(violations, &models.HierarchyViolation{
+			Type:           models.HierarchyInvertedPeriod,
+			OrganizationID: id,
+			ParentID:       []string{parentID},
+			Message: fmt.Sprintf("parent %s: until %s is not after from %s",
+				parentID, until.Format(time.RFC3339), from.Format(time.RFC3339)),
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	rows, err = repo.client.Query(ctx, organizationOverlappingPeriodsQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id, parentIDA, parentIDB string
+		var fromA, fromB time.Time
+		if err := rows.Scan(&id, &parentIDA, &fromA, &parentIDB, &fromB); err != nil {
+			return nil, err
+		}
+		violations = append(violations, &models.HierarchyViolation{
+			Type:           models.HierarchyOverlappingPeriods,
+			OrganizationID: id,
+			ParentID:       []string{parentIDA, parentIDB},
+			Message: fmt.Sprintf("parent %s from %s overlaps with parent %s from %s",
+				parentIDA, fromA.Format(time.RFC3339), parentIDB, fromB.Format(time.RFC3339)),
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	rows, err = repo.client.Query(ctx, organizationCyclesQuery)
+	if err != nil {
+		return nil, err
+	}
+	cycles, err := pgx.CollectRows(rows, pgx.RowTo[[]int])
+	if err != nil {
+		return nil, err
+	}
+	for _, pathIDs := range cycles {
+		path, err := getOrganizationPath(ctx, repo.client, pathIDs)
+		if err != nil {
+			return nil, err
+		}
+		violations = append(violations, &models.HierarchyViolation{
+			Type:           models.HierarchyCycle,
+			OrganizationID: path[0],
+			Path:           path,
+			Message:        "organization is its own ancestor: " + strings.Join(path, " -> "),
+		})
+	}
+
+	return violations, nil
+}
+
+// getOrganizationPath maps the row ids of a path to organization ids
+func getOrganizationPath(ctx context.Context, db querier, pathIDs []int) ([]string, error) {
+	externalIDs, err := getOrganizationExternalIDs(ctx, db, pathIDs)
+	if err != nil {
+		return nil, err
+	}
+	path := make([]string, 0, len(pathIDs))
+	for _, id := range pathIDs {
+		path = append(path, externalIDs[id])
+	}
+	return path, nil
+}
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ugent-library/people-service/models"
+)
+
+func newTestOrganization(t *testing.T, repo *repository, name string) *models.Organization {
+	t.Helper()
+	org := models.NewOrganization()
+	org.NameEng = name
+	org, err := repo.CreateOrganization(testContext(), org)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return org
+}
+
+func TestConcurrentOrganizationCycle(t *testing.T) {
+	repo := newTestRepository(t)
+	ctx := testContext()
+
+	a := newTestOrganization(t, repo, "A")
+	b := newTestOrganization(t, repo, "B")
+	c := newTestOrganization(t, repo, "C")
+	d := newTestOrganization(t, repo, "D")
+	from := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// A gets parent B in a transaction that is still in progress
+	tx, err := repo.client.Begin(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx.Rollback(ctx)
+	a.Parent = []*models.OrganizationParent{{ID: b.ID, From: &from}}
+	if err := repo.updateOrganization(ctx, tx, a); err != nil {
+		t.Fatal(err)
+	}
+
+	// B gets parent A at the same time, which closes the cycle
+	done := make(chan error, 1)
+	go func() {
+		b.Parent = []*models.OrganizationParent{{ID: a.ID, From: &from}}
+		_, err := repo.UpdateOrganization(ctx, b)
+		done <- err
+	}()
+
+	// an unrelated part of the hierarchy doesn't wait
+	c.Parent = []*models.OrganizationParent{{ID: d.ID, From: &from}}
+	if _, err := repo.UpdateOrganization(ctx, c); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	select {
+	case err := <-done:
+		t.Fatalf("update of B did not wait for the update of A, got error %v", err)
+	case <-time.After(500 * time.Millisecond):
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, models.ErrInvalidArgument) {
+			t.Fatalf("got error %v, want %v", err, models.ErrInvalidArgument)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("update of B still waits after the update of A was committed")
+	}
+
+	violations, err := repo.CheckOrganizationHierarchy(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(violations) != 0 {
+		t.Errorf("got %d hierarchy violations, want 0", len(violations))
+	}
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ugent-library/people-service/models"
+)
+
+func (repo *repository) GetOrganizationMembers(ctx context.Context, params models.OrganizationMembersParams) (*models.OrganizationMembersResult, error) {
+	return repo.listOrganizationMembers(ctx, params, setCursor{})
+}
+
+func (repo *repository) GetMoreOrganizationMembers(ctx context.Context, params models.OrganizationMembersParams, tokenValue string) (*models.OrganizationMembersResult, error) {
+	cursor := setCursor{}
+	if err := repo.decodeCursor(tokenValue, &cursor); err != nil {
+		return nil, err
+	}
+	return repo.listOrganizationMembers(ctx, params, cursor)
+}
+
+func (repo *repository) listOrganizationMembers(ctx context.Context, params models.OrganizationMembersParams, cursor setCursor) (*models.OrganizationMembersResult, error) {
+	params = params.MergeDefault()
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	// distinguish an unknown organization from one without members
+	var exists bool
+	if err := repo.client.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM "organizations" WHERE "external_id" = $1)`, params.ID).Scan(&exists); err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, repo.notFoundError(ctx, "organization_tombstones", params.ID)
+	}
+
+	filter := &sqlFilter{}
+	filter.where(organizationMemberFilter(filter, []string{params.ID}, params.IncludeDescendants, params.AsOf))
+	filter.where(`"active" = any(` + filter.arg(params.Active) + `)`)
+	filter.where(`"id" > ` + filter.arg(cursor.LastID))
+
+	res := &models.OrganizationMembersResult{}
+	var lastID int
+	var n int
+
+	if params.IDsOnly {
+		query := `SELECT "id", "external_id" FROM "people" WHERE ` + filter.String() + ` ORDER BY "id" ASC LIMIT ` + filter.arg(params.Limit)
+		rows, err := repo.client.Query(ctx, query, filter.args...)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+
+		res.IDs = []string{}
+		for rows.Next() {
+			var id string
+			if err := rows.Scan(&lastID, &id); err != nil {
+				return nil, err
+			}
+			res.IDs = append(res.IDs, id)
+		}
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		n = len(res.IDs)
+	} else {
+		query := `SELECT ` + personColumns + ` FROM "people" WHERE ` + filter.String() + ` ORDER BY "id" ASC LIMIT ` + filter.arg(params.Limit)
+		rows, err := repo.client.Query(ctx, query, filter.args...)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+
+		personRecs := []*person{}
+		for rows.Next() {
+			personRec := &person{}
+			if err := rows.Scan(personRec.scanFields()...); err != nil {
+				return nil, err
+			}
+			personRecs = append(personRecs, personRec)
+		}
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		rows.Close()
+
+		people, err := repo.unpackPeople(ctx, repo.client, personRecs...)
+		if err != nil {
+			return nil, err
+		}
+		res.People = people
+		n = len(personRecs)
+		if n > 0 {
+			lastID = personRecs[n-1].id
+		}
+	}
+
+	if n >= params.Limit {
+		encodedCursor, err := repo.encodeCursor(setCursor{LastID: lastID})
+		if err != nil {
+			return nil, err
+		}
+		res.Cursor = encodedCursor
+	}
+
+	return res, nil
+}
@@ -0,0 +1,198 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/ugent-library/people-service/models"
+)
+
+type testMembership struct {
+	id    int
+	typ   string
+	from  *time.Time
+	until *time.Time
+	fte   *float64
+}
+
+func getTestMemberships(t *testing.T, repo *repository, personID string) []testMembership {
+	t.Helper()
+	rows, err := repo.client.Query(context.Background(), `
+SELECT "om"."id", COALESCE("om"."type", ''), "om"."from", "om"."until", "om"."fte"
+FROM "organization_members" AS "om"
+JOIN "people" AS "p" ON "p"."id" = "om"."person_id"
+WHERE "p"."external_id" = $1
+ORDER BY COALESCE("om"."type", ''), "om"."from"`,
+		personID,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	var memberships []testMembership
+	for rows.Next() {
+		m := testMembership{}
+		if err := rows.Scan(&m.id, &m.typ, &m.from, &m.until, &m.fte); err != nil {
+			t.Fatal(err)
+		}
+		memberships = append(memberships, m)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return memberships
+}
+
+func newTestMembership(orgID, typ string, from, until *time.Time) *models.OrganizationMember {
+	m := models.NewOrganizationMember(orgID)
+	m.Type = typ
+	m.From = from
+	m.Until = until
+	return m
+}
+
+func TestOrganizationMembershipKeys(t *testing.T) {
+	repo := newTestRepository(t)
+	ctx := testContext()
+	org := newTestOrganization(t, repo, "Department")
+
+	// the same organization with a different type or start
+	p := newTestPerson("Jane Doe")
+	p.Organization = []*models.OrganizationMember{
+		newTestMembership(org.ID, "postdoc", testDbDate(2000), testDbDate(2010)),
+		newTestMembership(org.ID, "assistant", testDbDate(2000), testDbDate(2005)),
+		newTestMembership(org.ID, "professor", testDbDate(2010), nil),
+		newTestMembership(org.ID, "professor", testDbDate(2015), nil),
+	}
+	p, err := repo.CreatePerson(ctx, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	before := getTestMemberships(t, repo, p.ID)
+	if len(before) != 4 {
+		t.Fatalf("got %d memberships, want 4", len(before))
+	}
+
+	// an update with the same keys updates the stored memberships in place
+	fte := 50.0
+	updated := newTestMembership(org.ID, "postdoc", testDbDate(2000), testDbDate(2012))
+	updated.FTE = &fte
+	p.Organization = []*models.OrganizationMember{
+		updated,
+		newTestMembership(org.ID, "professor", testDbDate(2010), nil),
+		newTestMembership(org.ID, "", nil, nil),
+	}
+	p, err = repo.UpdatePerson(ctx, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	after := getTestMemberships(t, repo, p.ID)
+	if len(after) != 3 {
+		t.Fatalf("got %d memberships, want 3", len(after))
+	}
+
+	// sorted on type and start: "", postdoc 2000, professor 2010
+	if after[0].typ != "" || after[0].from != nil {
+		t.Errorf("got membership %+v, want one without type and start", after[0])
+	}
+	postdoc := after[1]
+	if postdoc.typ != "postdoc" || postdoc.id != before[1].id {
+		t.Errorf("got membership %+v, want postdoc membership %d updated in place", postdoc, before[1].id)
+	}
+	if postdoc.until == nil || !postdoc.until.Equal(*testDbDate(2012)) {
+		t.Errorf("got until %v, want %v", postdoc.until, testDbDate(2012))
+	}
+	if postdoc.fte == nil || *postdoc.fte != fte {
+		t.Errorf("got fte %v, want %v", postdoc.fte, fte)
+	}
+	if after[2].typ != "professor" || after[2].id != before[2].id {
+		t.Errorf("got membership %+v, want professor membership %d", after[2], before[2].id)
+	}
+
+	// an empty type and start are part of the key as well
+	p.Organization = []*models.OrganizationMember{newTestMembership(org.ID, "", nil, testDbDate(2020))}
+	p, err = repo.UpdatePerson(ctx, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	last := getTestMemberships(t, repo, p.ID)
+	if len(last) != 1 || last[0].id != after[0].id {
+		t.Fatalf("got memberships %+v, want membership %d updated in place", last, after[0].id)
+	}
+
+	// the same key twice in one record is refused
+	p.Organization = []*models.OrganizationMember{
+		newTestMembership(org.ID, "", nil, nil),
+		newTestMembership(org.ID, "", nil, testDbDate(2020)),
+	}
+	if _, err := repo.UpdatePerson(ctx, p); !errors.Is(err, models.ErrInvalidArgument) {
+		t.Fatalf("got error %v, want %v", err, models.ErrInvalidArgument)
+	}
+}
+
+func TestGetOrganizationMembersAsOf(t *testing.T) {
+	repo := newTestRepository(t)
+	ctx := testContext()
+	org := newTestOrganization(t, repo, "Department")
+
+	current := newTestPerson("Jane Doe")
+	current.Organization = []*models.OrganizationMember{newTestMembership(org.ID, "professor", testDbDate(2010), nil)}
+	current, err := repo.CreatePerson(ctx, current)
+	if err != nil {
+		t.Fatal(err)
+	}
+	former := newTestPerson("John Doe")
+	former.Organization = []*models.OrganizationMember{newTestMembership(org.ID, "postdoc", testDbDate(2000), testDbDate(2010))}
+	former, err = repo.CreatePerson(ctx, former)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		params models.OrganizationMembersParams
+		want   []string
+	}{
+		{
+			name:   "now by default",
+			params: models.OrganizationMembersParams{},
+			want:   []string{current.ID},
+		},
+		{
+			name:   "as of",
+			params: models.OrganizationMembersParams{AsOf: testDbDate(2005)},
+			want:   []string{former.ID},
+		},
+		{
+			name:   "historic",
+			params: models.OrganizationMembersParams{Historic: true},
+			want:   []string{current.ID, former.ID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.params.ID = org.ID
+			tt.params.IDsOnly = true
+			res, err := repo.GetOrganizationMembers(ctx, tt.params)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := slices.Clone(res.IDs)
+			want := slices.Clone(tt.want)
+			slices.Sort(got)
+			slices.Sort(want)
+			if !slices.Equal(got, want) {
+				t.Fatalf("got %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func testDbDate(year int) *time.Time {
+	t := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+	return &t
+}
@@ -0,0 +1,362 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/samber/lo"
+	"github.com/ugent-library/people-service/models"
+)
+
+type organizationSuccessor struct {
+	id                              int
+	dateCreated                     *time.Time
+	dateUpdated                     *time.Time
+	date                            *time.Time
+	organizationID                  int
+	organizationExternalID          string
+	successorOrganizationID         int
+	successorOrganizationExternalID string
+}
+
+// walks the successors of organization $1 until it meets itself
+const organizationSuccessionCycleQuery = `
+WITH RECURSIVE "next" AS (
+	SELECT "os"."successor_organization_id" AS "id",
+		ARRAY["os"."organization_id", "os"."successor_organization_id"] AS "path_ids"
+	FROM "organization_successors" AS "os"
+	WHERE "os"."organization_id" = $1
+	UNION ALL
+	SELECT "os"."successor_organization_id", "next"."path_ids" || "os"."successor_organization_id"
+	FROM "organization_successors" AS "os"
+	JOIN "next" ON "os"."organization_id" = "next"."id"
+	WHERE "next"."id" <> "next"."path_ids"[1]
+		AND NOT "os"."successor_organization_id" = any("next"."path_ids"[2:])
+)
+SELECT "path_ids" FROM "next" WHERE "id" = "path_ids"[1]
+LIMIT 1`
+
+// organizationLineageQuery walks from organization $1 over the relations that took effect at $2,
+// from column "from" to column "to" of "organization_successors". $3 limits the depth, 0 follows all steps.
+// An organization is current if it has no successor at $2.
+func organizationLineageQuery(from, to string) string {
+	return fmt.Sprintf(`
+WITH RECURSIVE "lineage" AS (
+	SELECT $1::bigint AS "id", NULL::timestamptz AS "date", 0 AS "depth", ARRAY[$1::bigint] AS "path_ids"
+	UNION ALL
+	SELECT "os"."%[2]s", "os"."date", "lineage"."depth" + 1, "lineage"."path_ids" || "os"."%[2]s"
+	FROM "organization_successors" AS "os"
+	JOIN "lineage" ON "os"."%[1]s" = "lineage"."id"
+	WHERE "os"."date" <= $2 AND NOT "os"."%[2]s" = any("lineage"."path_ids") AND ($3 = 0 OR "lineage"."depth" < $3)
+)
+SELECT "id", "date", "depth", "path_ids",
+	NOT EXISTS (SELECT 1 FROM "organization_successors" AS "os" WHERE "os"."organization_id" = "lineage"."id" AND "os"."date" <= $2)
+FROM "lineage"
+ORDER BY "depth", "path_ids"`, from, to)
+}
+
+// getOrganizationSuccessors returns the successor relations in which organizationIDs take part on either side
+func (repo *repository) getOrganizationSuccessors(ctx context.Context, db querier, organizationIDs ...int) ([]*organizationSuccessor, error) {
+	query := `
+SELECT
+	"os"."id",
+	"os"."date_created",
+	"os"."date_updated",
+	"os"."date",
+	"os"."organization_id",
+	"o"."external_id",
+	"os"."successor_organization_id",
+	"s"."external_id"
+FROM "organization_successors" AS "os"
+JOIN "organizations" AS "o" ON "o"."id" = "os"."organization_id"
+JOIN "organizations" AS "s" ON "s"."id" = "os"."successor_organization_id"
+WHERE "os"."organization_id" = any($1) OR "os"."successor_organization_id" = any($1)
+ORDER BY "os"."id"`
+
+	rows, err := db.Query(ctx, query, organizationIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	organizationSuccessors := []*organizationSuccessor{}
+	for rows.Next() {
+		os := &organizationSuccessor{}
+		err := rows.Scan(
+			&os.id,
+			&os.dateCreated,
+			&os.dateUpdated,
+			&os.date,
+			&os.organizationID,
+			&os.organizationExternalID,
+			&os.successorOrganizationID,
+			&os.successorOrganizationExternalID,
+		)
+		if err != nil {
+			return nil, err
+		}
+		organizationSuccessors = append(organizationSuccessors, os)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return organizationSuccessors, nil
+}
+
+// saveOrganizationSuccessors replaces the successor relations of organization rowID with org.Successor.
+// It returns the row ids of the successors that gained, lost or changed a predecessor.
+// Check for cycles afterwards with checkOrganizationSuccessionCycle.
+func (repo *repository) saveOrganizationSuccessors(ctx context.Context, tx pgx.Tx, rowID int, org *models.Organization) ([]int, error) {
+	oldDates := map[int]time.Time{}
+	rows, err := tx.Query(ctx, `SELECT "successor_organization_id", "date" FROM "organization_successors" WHERE "organization_id" = $1`, rowID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id int
+		var date time.Time
+		if err := rows.Scan(&id, &date); err != nil {
+			return nil, err
+		}
+		oldDates[id] = date
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	successorExternalIDs := make([]string, 0, len(org.Successor))
+	for _, successor := range org.Successor {
+		successorExternalIDs = append(successorExternalIDs, successor.ID)
+	}
+	successorRowIDs := map[string]int{}
+	if len(successorExternalIDs) > 0 {
+		rows, err = tx.Query(ctx, `SELECT "id", "external_id" FROM "organizations" WHERE "external_id" = any($1)`, successorExternalIDs)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var id int
+			var externalID string
+			if err := rows.Scan(&id, &externalID); err != nil {
+				return nil, err
+			}
+			successorRowIDs[externalID] = id
+		}
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		rows.Close()
+
+		if len(successorRowIDs) != len(successorExternalIDs) {
+			return nil, models.ErrInvalidReference
+		}
+	}
+
+	query := `
+INSERT INTO "organization_successors"
+	("organization_id", "successor_organization_id", "date_created", "date_updated", "date")
+VALUES($1, $2, $3, $4, $5)
+ON CONFLICT("organization_id", "successor_organization_id")
+DO UPDATE SET "date_updated" = EXCLUDED."date_updated", "date" = EXCLUDED."date"
+`
+	changedIDs := []int{}
+	newIDs := make([]int, 0, len(org.Successor))
+	for _, successor := range org.Successor {
+		successorRowID := successorRowIDs[successor.ID]
+		_, err := tx.Exec(
+			ctx,
+			query,
+			rowID,
+			successorRowID,
+			successor.DateCreated,
+			successor.DateUpdated,
+			successor.Date,
+		)
+		if err != nil {
+			return nil, err
+		}
+		newIDs = append(newIDs, successorRowID)
+		if oldDate, ok := oldDates[successorRowID]; !ok || !oldDate.Equal(*successor.Date) {
+			changedIDs = append(changedIDs, successorRowID)
+		}
+		delete(oldDates, successorRowID)
+	}
+
+	_, err = tx.Exec(ctx, `DELETE FROM "organization_successors" WHERE "organization_id" = $1 AND NOT "successor_organization_id" = any($2)`, rowID, newIDs)
+	if err != nil {
+		return nil, err
+	}
+	for id := range oldDates {
+		changedIDs = append(changedIDs, id)
+	}
+
+	return lo.Uniq(changedIDs), nil
+}
+
+// organizationPredecessorsChanged records a new version of the organizations whose predecessors
+// were changed by saveOrganizationSuccessors
+func (repo *repository) organizationPredecessorsChanged(ctx context.Context, tx pgx.Tx, rowIDs []int) error {
+	if len(rowIDs) == 0 {
+		return nil
+	}
+	rowIDs, err := repo.touchRecords(ctx, tx, `UPDATE "organizations" SET "date_updated" = $1, "version" = "version" + 1
+WHERE "id" = any($2)
+RETURNING "id"`, rowIDs)
+	if err != nil {
+		return err
+	}
+	orgs, err := repo.getOrganizationsByRowID(ctx, tx, rowIDs...)
+	if err != nil {
+		return err
+	}
+	for _, org := range orgs {
+		if err := repo.organizationChanged(ctx, tx, models.EventOrganizationUpdated, org); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkOrganizationSuccessionCycle returns models.ErrInvalidArgument if organization rowID
+// became its own successor. Call it after storing the successor relations.
+func checkOrganizationSuccessionCycle(ctx context.Context, tx pgx.Tx, rowID int) error {
+	rows, err := tx.Query(ctx, organizationSuccessionCycleQuery, rowID)
+	if err != nil {
+		return err
+	}
+	pathIDs, err := pgx.CollectRows(rows, pgx.RowTo[[]int])
+	if err != nil {
+		return err
+	}
+	if len(pathIDs) == 0 {
+		return nil
+	}
+
+	path, err := getOrganizationPath(ctx, tx, pathIDs[0])
+	if err != nil {
+		return err
+	}
+	return fmt.Errorf("%w: organization %s would become its own successor: %s",
+		models.ErrInvalidArgument, path[0], strings.Join(path, " -> "))
+}
+
+type organizationLineageRow struct {
+	id      int
+	date    *time.Time
+	depth   int
+	pathIDs []int
+	current bool
+}
+
+func (repo *repository) GetOrganizationLineage(ctx context.Context, params models.OrganizationLineageParams) ([]*models.OrganizationLineageNode, error) {
+	params = params.MergeDefault()
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	var rowID int
+	err := repo.client.QueryRow(ctx, `SELECT "id" FROM "organizations" WHERE "external_id" = $1`, params.ID).Scan(&rowID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, repo.notFoundError(ctx, "organization_tombstones", params.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	query := organizationLineageQuery("organization_id", "successor_organization_id")
+	if params.Direction == models.LineagePredecessors {
+		query = organizationLineageQuery("successor_organization_id", "organization_id")
+	}
+
+	rows, err := repo.client.Query(ctx, query, rowID, params.AsOf, params.MaxDepth)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	lineageRows := []*organizationLineageRow{}
+	for rows.Next() {
+		r := &organizationLineageRow{}
+		if err := rows.Scan(&r.id, &r.date, &r.depth, &r.pathIDs, &r.current); err != nil {
+			return nil, err
+		}
+		lineageRows = append(lineageRows, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	// organizations on all paths, by row id
+	rowIDs := []int{}
+	seen := map[int]bool{}
+	for _, r := range lineageRows {
+		for _, id := range r.pathIDs {
+			if !seen[id] {
+				seen[id] = true
+				rowIDs = append(rowIDs, id)
+			}
+		}
+	}
+	externalIDs, err := getOrganizationExternalIDs(ctx, repo.client, rowIDs)
+	if err != nil {
+		return nil, err
+	}
+	orgs, err := repo.getOrganizationsByRowID(ctx, repo.client, rowIDs...)
+	if err != nil {
+		return nil, err
+	}
+	orgsByID := make(map[string]*models.Organization, len(orgs))
+	for _, org := range orgs {
+		orgsByID[org.ID] = org
+	}
+
+	nodes := make([]*models.OrganizationLineageNode, 0, len(lineageRows))
+	for _, r := range lineageRows {
+		node := &models.OrganizationLineageNode{
+			Organization: orgsByID[externalIDs[r.id]],
+			Depth:        r.depth,
+			Path:         make([]string, 0, len(r.pathIDs)),
+			Date:         r.date,
+			Current:      r.current,
+		}
+		if node.Organization == nil {
+			return nil, fmt.Errorf("organization %d disappeared while walking the lineage", r.id)
+		}
+		for _, id := range r.pathIDs {
+			node.Path = append(node.Path, externalIDs[id])
+		}
+		nodes = append(nodes, node)
+	}
+
+	return nodes, nil
+}
+
+func (repo *repository) getOrganizationPredecessors(ctx context.Context, db querier, rowID int) ([]*models.OrganizationSuccessor, error) {
+	organizationSuccessors, err := repo.getOrganizationSuccessors(ctx, db, rowID)
+	if err != nil {
+		return nil, err
+	}
+	var predecessors []*models.OrganizationSuccessor
+	for _, os := range organizationSuccessors {
+		if os.successorOrganizationID == rowID {
+			predecessors = append(predecessors, &models.OrganizationSuccessor{
+				ID:          os.organizationExternalID,
+				DateCreated: os.dateCreated,
+				DateUpdated: os.dateUpdated,
+				Date:        os.date,
+			})
+		}
+	}
+	sort.Sort(models.ByOrganizationSuccessor(predecessors))
+	return predecessors, nil
+}
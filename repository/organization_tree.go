@@ -0,0 +1,199 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/ugent-library/people-service/models"
+)
+
+// parent relation "op" valid at $2
+const organizationParentValidAsOf = `"op"."from" <= $2 AND ("op"."until" IS NULL OR "op"."until" > $2)`
+
+// levels below $3, or all levels if $3 is 0
+const organizationTreeDepthCond = `($3 = 0 OR "tree"."depth" < $3)`
+
+// walks up from organization $1. "path_ids" guards against cycles.
+const organizationAncestorsQuery = `
+WITH RECURSIVE "tree" AS (
+	SELECT "op"."parent_organization_id" AS "id", NULL::bigint AS "parent_id", "op"."from", "op"."until", 1 AS "depth",
+		ARRAY["op"."organization_id", "op"."parent_organization_id"] AS "path_ids"
+	FROM "organization_parents" AS "op"
+	WHERE "op"."organization_id" = $1 AND ` + organizationParentValidAsOf + `
+	UNION ALL
+	SELECT "op"."parent_organization_id", NULL::bigint, "op"."from", "op"."until", "tree"."depth" + 1,
+		"tree"."path_ids" || "op"."parent_organization_id"
+	FROM "organization_parents" AS "op"
+	JOIN "tree" ON "op"."organization_id" = "tree"."id"
+	WHERE ` + organizationParentValidAsOf + ` AND NOT "op"."parent_organization_id" = any("tree"."path_ids") AND ` + organizationTreeDepthCond + `
+)
+SELECT "id", "parent_id", "from", "until", "depth", "path_ids" FROM "tree"
+ORDER BY "depth", "path_ids"`
+
+// walks down from organization $1
+const organizationDescendantsQuery = `
+WITH RECURSIVE "tree" AS (
+	SELECT "op"."organization_id" AS "id", "op"."parent_organization_id" AS "parent_id", "op"."from", "op"."until", 1 AS "depth",
+		ARRAY["op"."parent_organization_id", "op"."organization_id"] AS "path_ids"
+	FROM "organization_parents" AS "op"
+	WHERE "op"."parent_organization_id" = $1 AND ` + organizationParentValidAsOf + `
+	UNION ALL
+	` + organizationTreeDescendantStep + `
+)
+SELECT "id", "parent_id", "from", "until", "depth", "path_ids" FROM "tree"
+ORDER BY "depth", "path_ids"`
+
+// walks up from organization $1 to its roots, then down from the roots
+const organizationTreeQuery = `
+WITH RECURSIVE "up" AS (
+	SELECT $1::bigint AS "id", ARRAY[$1::bigint] AS "path_ids"
+	UNION ALL
+	SELECT "op"."parent_organization_id", "up"."path_ids" || "op"."parent_organization_id"
+	FROM "organization_parents" AS "op"
+	JOIN "up" ON "op"."organization_id" = "up"."id"
+	WHERE ` + organizationParentValidAsOf + ` AND NOT "op"."parent_organization_id" = any("up"."path_ids")
+), "roots" AS (
+	SELECT DISTINCT "up"."id" FROM "up"
+	WHERE NOT EXISTS (
+		SELECT 1 FROM "organization_parents" AS "op" WHERE "op"."organization_id" = "up"."id" AND ` + organizationParentValidAsOf + `
+	)
+), "tree" AS (
+	SELECT "id", NULL::bigint AS "parent_id", NULL::timestamptz AS "from", NULL::timestamptz AS "until", 0 AS "depth",
+		ARRAY["id"] AS "path_ids"
+	FROM "roots"
+	UNION ALL
+	` + organizationTreeDescendantStep + `
+)
+SELECT "id", "parent_id", "from", "until", "depth", "path_ids" FROM "tree"
+ORDER BY "depth", "path_ids"`
+
+const organizationTreeDescendantStep = `SELECT "op"."organization_id", "op"."parent_organization_id", "op"."from", "op"."until", "tree"."depth" + 1,
+		"tree"."path_ids" || "op"."organization_id"
+	FROM "organization_parents" AS "op"
+	JOIN "tree" ON "op"."parent_organization_id" = "tree"."id"
+	WHERE ` + organizationParentValidAsOf + ` AND NOT "op"."organization_id" = any("tree"."path_ids") AND ` + organizationTreeDepthCond
+
+func (repo *repository) GetOrganizationAncestors(ctx context.Context, params models.OrganizationTreeParams) ([]*models.OrganizationTreeNode, error) {
+	return repo.getOrganizationTree(ctx, params, organizationAncestorsQuery)
+}
+
+func (repo *repository) GetOrganizationDescendants(ctx context.Context, params models.OrganizationTreeParams) ([]*models.OrganizationTreeNode, error) {
+	return repo.getOrganizationTree(ctx, params, organizationDescendantsQuery)
+}
+
+func (repo *repository) GetOrganizationTree(ctx context.Context, params models.OrganizationTreeParams) ([]*models.OrganizationTreeNode, error) {
+	return repo.getOrganizationTree(ctx, params, organizationTreeQuery)
+}
+
+type organizationTreeRow struct {
+	id       int
+	parentID *int
+	from     *time.Time
+	until    *time.Time
+	depth    int
+	pathIDs  []int
+}
+
+func (repo *repository) getOrganizationTree(ctx context.Context, params models.OrganizationTreeParams, query string) ([]*models.OrganizationTreeNode, error) {
+	params = params.MergeDefault()
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	var rowID int
+	err := repo.client.QueryRow(ctx, `SELECT "id" FROM "organizations" WHERE "external_id" = $1`, params.ID).Scan(&rowID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, repo.notFoundError(ctx, "organization_tombstones", params.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := repo.client.Query(ctx, query, rowID, params.AsOf, params.MaxDepth)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	treeRows := []*organizationTreeRow{}
+	for rows.Next() {
+		r := &organizationTreeRow{}
+		if err := rows.Scan(&r.id, &r.parentID, &r.from, &r.until, &r.depth, &r.pathIDs); err != nil {
+			return nil, err
+		}
+		treeRows = append(treeRows, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	// organizations on all paths, by row id
+	rowIDs := []int{}
+	seen := map[int]bool{}
+	for _, r := range treeRows {
+		for _, id := range r.pathIDs {
+			if !seen[id] {
+				seen[id] = true
+				rowIDs = append(rowIDs, id)
+			}
+		}
+	}
+	externalIDs, err := getOrganizationExternalIDs(ctx, repo.client, rowIDs)
+	if err != nil {
+		return nil, err
+	}
+	orgs, err := repo.getOrganizationsByRowID(ctx, repo.client, rowIDs...)
+	if err != nil {
+		return nil, err
+	}
+	orgsByID := make(map[string]*models.Organization, len(orgs))
+	for _, org := range orgs {
+		orgsByID[org.ID] = org
+	}
+
+	nodes := make([]*models.OrganizationTreeNode, 0, len(treeRows))
+	for _, r := range treeRows {
+		node := &models.OrganizationTreeNode{
+			Organization: orgsByID[externalIDs[r.id]],
+			Depth:        r.depth,
+			Path:         make([]string, 0, len(r.pathIDs)),
+			From:         r.from,
+			Until:        r.until,
+		}
+		if node.Organization == nil {
+			return nil, fmt.Errorf("organization %d disappeared while walking the hierarchy", r.id)
+		}
+		if r.parentID != nil {
+			node.ParentID = externalIDs[*r.parentID]
+		}
+		for _, id := range r.pathIDs {
+			node.Path = append(node.Path, externalIDs[id])
+		}
+		nodes = append(nodes, node)
+	}
+
+	return nodes, nil
+}
+
+func getOrganizationExternalIDs(ctx context.Context, db querier, rowIDs []int) (map[int]string, error) {
+	rows, err := db.Query(ctx, `SELECT "id", "external_id" FROM "organizations" WHERE "id" = any($1)`, rowIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	externalIDs := make(map[int]string, len(rowIDs))
+	for rows.Next() {
+		var id int
+		var externalID string
+		if err := rows.Scan(&id, &externalID); err != nil {
+			return nil, err
+		}
+		externalIDs[id] = externalID
+	}
+	return externalIDs, rows.Err()
+}
@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/ugent-library/people-service/models"
+)
+
+// addEvent stores an event about record recordID in the outbox.
+// db must be the transaction that made the change.
+func (repo *repository) addEvent(ctx context.Context, db querier, typ string, recordID string, data any) error {
+	rawData, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	e := &models.Event{
+		ID:       ulid.Make().String(),
+		Type:     typ,
+		Time:     &now,
+		RecordID: recordID,
+		Data:     rawData,
+	}
+
+	_, err = db.Exec(
+		ctx,
+		`INSERT INTO "outbox" ("event_id", "type", "record_id", "date_created", "data") VALUES ($1, $2, $3, $4, $5)`,
+		e.ID,
+		e.Type,
+		e.RecordID,
+		now,
+		rawData,
+	)
+	if err != nil {
+		return err
+	}
+
+	return repo.addWebhookDeliveries(ctx, db, e)
+}
+
+// outboxClaimTimeout is how long a publisher has to publish the events it claimed.
+// Events of a publisher that died are claimed again by another one after that.
+const outboxClaimTimeout = 5 * time.Minute
+
+// PublishEvents claims a batch of events and publishes them outside of any transaction,
+// so that no rows stay locked while fn talks to the message broker.
+func (repo *repository) PublishEvents(ctx context.Context, limit int, fn func(*models.Event) error) (int, error) {
+	// SKIP LOCKED lets concurrent publishers each claim their own batch
+	rows, err := repo.client.Query(
+		ctx,
+		`UPDATE "outbox" SET "claimed_until" = now() + $2 * interval '1 millisecond'
+WHERE "id" IN (
+	SELECT "id" FROM "outbox"
+	WHERE "date_published" IS NULL AND ("claimed_until" IS NULL OR "claimed_until" < now())
+	ORDER BY "id"
+	LIMIT $1
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING "id", "event_id", "type", "record_id", "date_created", "data"`,
+		limit,
+		outboxClaimTimeout.Milliseconds(),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	type claimedEvent struct {
+		rowID int
+		event *models.Event
+	}
+	var claimed []claimedEvent
+	for rows.Next() {
+		var (
+			rowID       int
+			dateCreated time.Time
+			e           models.Event
+		)
+		if err := rows.Scan(&rowID, &e.ID, &e.Type, &e.RecordID, &dateCreated, &e.Data); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		e.Time = &dateCreated
+		claimed = append(claimed, claimedEvent{rowID, &e})
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	// RETURNING doesn't keep the order of the subquery
+	slices.SortFunc(claimed, func(a, b claimedEvent) int { return a.rowID - b.rowID })
+
+	n := 0
+	var fnErr error
+	for _, c := range claimed {
+		if fnErr = fn(c.event); fnErr != nil {
+			break
+		}
+		n++
+	}
+
+	rowIDs := make([]int, len(claimed))
+	for i, c := range claimed {
+		rowIDs[i] = c.rowID
+	}
+	if n > 0 {
+		_, err = repo.client.Exec(ctx, `UPDATE "outbox" SET "date_published" = now(), "claimed_until" = NULL WHERE "id" = ANY($1)`, rowIDs[:n])
+		if err != nil {
+			return 0, err
+		}
+	}
+	// release the rest, so that it is retried right away
+	if n < len(rowIDs) {
+		_, err = repo.client.Exec(ctx, `UPDATE "outbox" SET "claimed_until" = NULL WHERE "id" = ANY($1)`, rowIDs[n:])
+		if err != nil {
+			return n, err
+		}
+	}
+
+	return n, fnErr
+}
+
+// PurgeEvents deletes events that were published before before
+func (repo *repository) PurgeEvents(ctx context.Context, before time.Time) (int, error) {
+	tag, err := repo.client.Exec(ctx, `DELETE FROM "outbox" WHERE "date_published" < $1`, before)
+	if err != nil {
+		return 0, err
+	}
+	return int(tag.RowsAffected()), nil
+}
@@ -0,0 +1,264 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/ugent-library/people-service/models"
+)
+
+// names of the rebuild checkpoints
+const (
+	rebuildCheckpointPeople        = "autocomplete_people"
+	rebuildCheckpointOrganizations = "autocomplete_organizations"
+)
+
+// rebuildRow is a row of a table with the stored and the current index values
+type rebuildRow struct {
+	id        int
+	oldTsVals map[string][]string
+	newTsVals map[string][]string
+}
+
+// rebuildTable describes how to rebuild the index values of one table
+type rebuildTable struct {
+	checkpoint string
+	table      string
+	// columns to select after "id" and "ts_vals"
+	columns string
+	// tsVals scans the selected columns and returns the current index values
+	tsVals func(pgx.Rows) (int, []byte, map[string][]string, error)
+}
+
+// RebuildAutocompletePeople recomputes "ts_vals" of all people in batches of params.BatchSize,
+// one transaction per batch. It resumes after the last batch of an unfinished run unless params.Restart is set.
+func (repo *repository) RebuildAutocompletePeople(ctx context.Context, params models.RebuildParams) error {
+	return repo.rebuildAutocomplete(ctx, params, rebuildTable{
+		checkpoint: rebuildCheckpointPeople,
+		table:      "people",
+		columns:    `"name", "given_name", "family_name", "preferred_given_name", "preferred_family_name", "email", "identifier"`,
+		tsVals: func(rows pgx.Rows) (int, []byte, map[string][]string, error) {
+			pRec := &person{}
+			var tsVals []byte
+			if err := rows.Scan(
+				&pRec.id,
+				&tsVals,
+				&pRec.name,
+				&pRec.givenName,
+				&pRec.familyName,
+				&pRec.preferredGivenName,
+				&pRec.preferredFamilyName,
+				&pRec.email,
+				&pRec.identifier,
+			); err != nil {
+				return 0, nil, nil, err
+			}
+			p := &models.Person{
+				Name:                pRec.name.String,
+				GivenName:           pRec.givenName.String,
+				FamilyName:          pRec.familyName.String,
+				PreferredGivenName:  pRec.preferredGivenName.String,
+				PreferredFamilyName: pRec.preferredFamilyName.String,
+				Email:               pRec.email.String,
+			}
+			identifiers, err := parseIdentifiers(pRec.identifier)
+			if err != nil {
+				return 0, nil, nil, err
+			}
+			p.SetIdentifier(identifiers...)
+			return pRec.id, tsVals, repo.getTsValsForPerson(p), nil
+		},
+	})
+}
+
+// RebuildAutocompleteOrganizations is the organization equivalent of RebuildAutocompletePeople
+func (repo *repository) RebuildAutocompleteOrganizations(ctx context.Context, params models.RebuildParams) error {
+	return repo.rebuildAutocomplete(ctx, params, rebuildTable{
+		checkpoint: rebuildCheckpointOrganizations,
+		table:      "organizations",
+		columns:    `"name_dut", "name_eng", "acronym", "identifier"`,
+		tsVals: func(rows pgx.Rows) (int, []byte, map[string][]string, error) {
+			oRec := &organization{}
+			var tsVals []byte
+			if err := rows.Scan(&oRec.id, &tsVals, &oRec.nameDut, &oRec.nameEng, &oRec.acronym, &oRec.identifier); err != nil {
+				return 0, nil, nil, err
+			}
+			org := &models.Organization{
+				NameDut: oRec.nameDut.String,
+				NameEng: oRec.nameEng.String,
+				Acronym: oRec.acronym.String,
+			}
+			identifiers, err := parseIdentifiers(oRec.identifier)
+			if err != nil {
+				return 0, nil, nil, err
+			}
+			org.SetIdentifier(identifiers...)
+			return oRec.id, tsVals, repo.getTsValsForOrganization(org), nil
+		},
+	})
+}
+
+func (repo *repository) rebuildAutocomplete(ctx context.Context, params models.RebuildParams, t rebuildTable) error {
+	params = params.MergeDefault()
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	var lastID int64
+	if params.Restart {
+		if err := deleteRebuildCheckpoint(ctx, repo.client, t.checkpoint); err != nil {
+			return err
+		}
+	} else {
+		id, err := getRebuildCheckpoint(ctx, repo.client, t.checkpoint)
+		if err != nil {
+			return err
+		}
+		lastID = id
+	}
+
+	progress := models.RebuildProgress{}
+	if err := repo.client.QueryRow(ctx, `SELECT count(*) FROM "`+t.table+`" WHERE "id" > $1`, lastID).Scan(&progress.Total); err != nil {
+		return err
+	}
+	start := time.Now()
+
+	for {
+		id, counts, err := repo.rebuildBatch(ctx, t, lastID, params)
+		if err != nil {
+			return err
+		}
+		if id == 0 {
+			break
+		}
+		lastID = id
+
+		progress.Processed += counts.processed
+		progress.Updated += counts.updated
+		progress.Elapsed = time.Since(start)
+		if params.Progress != nil {
+			params.Progress(progress)
+		}
+	}
+
+	return deleteRebuildCheckpoint(ctx, repo.client, t.checkpoint)
+}
+
+type rebuildBatchCounts struct {
+	processed int
+	updated   int
+}
+
+// rebuildBatch updates the rows of one batch after lastID and moves the checkpoint in the same transaction.
+// It returns the id of the last row of the batch, or 0 if there are no rows left.
+func (repo *repository) rebuildBatch(ctx context.Context, t rebuildTable, lastID int64, params models.RebuildParams) (int64, rebuildBatchCounts, error) {
+	counts := rebuildBatchCounts{}
+
+	tx, err := repo.client.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return 0, counts, err
+	}
+	defer tx.Rollback(ctx)
+
+	// lock the batch so that concurrent updates don't get overwritten with older values
+	rows, err := tx.Query(ctx, `
+SELECT "id", "ts_vals", `+t.columns+`
+FROM "`+t.table+`"
+WHERE "id" > $1
+ORDER BY "id"
+LIMIT $2
+FOR UPDATE`, lastID, params.BatchSize)
+	if err != nil {
+		return 0, counts, err
+	}
+	defer rows.Close()
+
+	batch := []*rebuildRow{}
+	for rows.Next() {
+		id, tsVals, newTsVals, err := t.tsVals(rows)
+		if err != nil {
+			return 0, counts, err
+		}
+		r := &rebuildRow{id: id, newTsVals: newTsVals}
+		// ts_vals in an outdated format is stale
+		if tsVals != nil {
+			json.Unmarshal(tsVals, &r.oldTsVals)
+		}
+		batch = append(batch, r)
+	}
+	if err := rows.Err(); err != nil {
+		return 0, counts, err
+	}
+	rows.Close()
+
+	if len(batch) == 0 {
+		return 0, counts, nil
+	}
+
+	for _, r := range batch {
+		counts.processed++
+		if params.StaleOnly && r.oldTsVals != nil && maps.EqualFunc(r.oldTsVals, r.newTsVals, slices.Equal[[]string]) {
+			continue
+		}
+		if _, err := tx.Exec(ctx, `UPDATE "`+t.table+`" SET "ts_vals" = $1 WHERE "id" = $2`, pgjson(r.newTsVals), r.id); err != nil {
+			return 0, counts, err
+		}
+		counts.updated++
+	}
+
+	lastID = int64(batch[len(batch)-1].id)
+	if err := setRebuildCheckpoint(ctx, tx, t.checkpoint, lastID); err != nil {
+		return 0, counts, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, counts, err
+	}
+
+	return lastID, counts, nil
+}
+
+func getRebuildCheckpoint(ctx context.Context, db querier, name string) (int64, error) {
+	var lastID int64
+	err := db.QueryRow(ctx, `SELECT "last_id" FROM "rebuild_checkpoints" WHERE "name" = $1`, name).Scan(&lastID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, nil
+	}
+	return lastID, err
+}
+
+func setRebuildCheckpoint(ctx context.Context, db querier, name string, lastID int64) error {
+	_, err := db.Exec(ctx, `
+INSERT INTO "rebuild_checkpoints" ("name", "last_id", "date_updated")
+VALUES ($1, $2, now())
+ON CONFLICT ("name") DO UPDATE SET "last_id" = EXCLUDED."last_id", "date_updated" = EXCLUDED."date_updated"`,
+		name, lastID,
+	)
+	return err
+}
+
+func deleteRebuildCheckpoint(ctx context.Context, db querier, name string) error {
+	_, err := db.Exec(ctx, `DELETE FROM "rebuild_checkpoints" WHERE "name" = $1`, name)
+	return err
+}
+
+func parseIdentifiers(data []byte) ([]*models.URN, error) {
+	vals, err := fromPgTextArray(data)
+	if err != nil {
+		return nil, err
+	}
+	urns := make([]*models.URN, 0, len(vals))
+	for _, val := range vals {
+		urn, err := models.ParseURN(val)
+		if err != nil {
+			return nil, err
+		}
+		urns = append(urns, urn)
+	}
+	return urns, nil
+}
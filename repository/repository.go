@@ -6,9 +6,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/oklog/ulid/v2"
@@ -22,9 +24,67 @@ const (
 	organizationPageLimit = 200
 )
 
+// organizationColumns lists the columns of table "organizations", in the order of organization.scanFields
+const organizationColumns = `
+	"id",
+	"external_id",
+	"date_created",
+	"date_updated",
+	"type",
+	"name_dut",
+	"name_eng",
+	"acronym",
+	"identifier",
+	"version",
+	"founded",
+	"dissolved"`
+
+// personColumns lists the columns of table "people", in the order of person.scanFields
+const personColumns = `
+	"id",
+	"date_created",
+	"date_updated",
+	"external_id",
+	"active",
+	"birth_date",
+	"email",
+	"given_name",
+	"name",
+	"family_name",
+	"job_category",
+	"preferred_given_name",
+	"preferred_family_name",
+	"honorific_prefix",
+	"role",
+	"settings",
+	"object_class",
+	"token",
+	"identifier",
+	"version"`
+
+// querier is implemented by both *pgxpool.Pool and pgx.Tx
+type querier interface {
+	Exec(context.Context, string, ...any) (pgconn.CommandTag, error)
+	Query(context.Context, string, ...any) (pgx.Rows, error)
+	QueryRow(context.Context, string, ...any) pgx.Row
+}
+
+// dbNow returns the start time of the current transaction on the database clock.
+// Records are stamped with it, so that the dates of changes don't depend on the clock
+// of the instance that made them.
+func dbNow(ctx context.Context, db querier) (time.Time, error) {
+	var now time.Time
+	if err := db.QueryRow(ctx, `SELECT now()`).Scan(&now); err != nil {
+		return now, err
+	}
+	return now.UTC(), nil
+}
+
 type repository struct {
-	client *pgxpool.Pool
-	secret []byte
+	client               *pgxpool.Pool
+	secret               []byte
+	personTsFields       []TsField
+	organizationTsFields []TsField
 }
 
 type setCursor struct {
@@ -50,6 +110,11 @@ type organizationMember struct {
 	personID               int
 	organizationID         int
 	organizationExternalID string
+	from                   *time.Time
+	until                  *time.Time
+	typ                    pgtype.Text
+	fte                    *float64
+	source                 pgtype.Text
 }
 
 type organization struct {
@@ -62,6 +127,26 @@ type organization struct {
 	nameEng     pgtype.Text
 	acronym     pgtype.Text
 	identifier  []byte
+	version     int
+	founded     *time.Time
+	dissolved   *time.Time
+}
+
+func (o *organization) scanFields() []any {
+	return []any{
+		&o.id,
+		&o.externalID,
+		&o.dateCreated,
+		&o.dateUpdated,
+		&o.Type,
+		&o.nameDut,
+		&o.nameEng,
+		&o.acronym,
+		&o.identifier,
+		&o.version,
+		&o.founded,
+		&o.dissolved,
+	}
 }
 
 type person struct {
@@ -84,6 +169,32 @@ type person struct {
 	settings            []byte
 	objectClass         []byte
 	identifier          []byte
+	version             int
+}
+
+func (p *person) scanFields() []any {
+	return []any{
+		&p.id,
+		&p.dateCreated,
+		&p.dateUpdated,
+		&p.externalID,
+		&p.active,
+		&p.birthDate,
+		&p.email,
+		&p.givenName,
+		&p.name,
+		&p.familyName,
+		&p.jobCategory,
+		&p.preferredGivenName,
+		&p.preferredFamilyName,
+		&p.honorificPrefix,
+		&p.role,
+		&p.settings,
+		&p.objectClass,
+		&p.token,
+		&p.identifier,
+		&p.version,
+	}
 }
 
 func NewRepository(config *Config) (*repository, error) {
@@ -92,13 +203,23 @@ func NewRepository(config *Config) (*repository, error) {
 	if err != nil {
 		return nil, err
 	}
+	personTsFields := config.PersonTsFields
+	if len(personTsFields) == 0 {
+		personTsFields = DefaultPersonTsFields
+	}
+	organizationTsFields := config.OrganizationTsFields
+	if len(organizationTsFields) == 0 {
+		organizationTsFields = DefaultOrganizationTsFields
+	}
 	return &repository{
-		client: pool,
-		secret: []byte(config.AesKey),
+		client:               pool,
+		secret:               []byte(config.AesKey),
+		personTsFields:       personTsFields,
+		organizationTsFields: organizationTsFields,
 	}, nil
 }
 
-func (repo *repository) getOrganizationMembers(ctx context.Context, personIDs ...int) ([]*organizationMember, error) {
+func (repo *repository) getOrganizationMembers(ctx context.Context, db querier, personIDs ...int) ([]*organizationMember, error) {
 	query := `
 SELECT
 	"id",
@@ -106,12 +227,17 @@ SELECT
     "person_id",
 	"date_created",
 	"date_updated",
-	(SELECT "external_id" FROM "organizations" WHERE "id" = op.organization_id) AS "organization_external_id"
+	(SELECT "external_id" FROM "organizations" WHERE "id" = op.organization_id) AS "organization_external_id",
+	"from",
+	"until",
+	"type",
+	"fte",
+	"source"
 FROM "organization_members" op
 WHERE "person_id" = any($1)
-ORDER BY array_position($1, person_id), "organization_id" ASC
+ORDER BY array_position($1, person_id), "organization_id" ASC, "from" ASC NULLS FIRST
 	`
-	rows, err := repo.client.Query(
+	rows, err := db.Query(
 		ctx,
 		query,
 		personIDs,
@@ -133,6 +259,11 @@ ORDER BY array_position($1, person_id), "organization_id" ASC
 			&om.dateCreated,
 			&om.dateUpdated,
 			&om.organizationExternalID,
+			&om.from,
+			&om.until,
+			&om.typ,
+			&om.fte,
+			&om.source,
 		)
 		if err != nil {
 			return nil, err
@@ -146,7 +277,7 @@ ORDER BY array_position($1, person_id), "organization_id" ASC
 	return organizationMembers, nil
 }
 
-func (repo *repository) getOrganizationParents(ctx context.Context, organizationIDs ...int) ([]*organizationParent, error) {
+func (repo *repository) getOrganizationParents(ctx context.Context, db querier, organizationIDs ...int) ([]*organizationParent, error) {
 	query := `
 SELECT
 	"id",
@@ -161,7 +292,7 @@ FROM "organization_parents" op
 WHERE "organization_id" = any($1)
 ORDER by array_position($1, organization_id), "parent_organization_id" ASC
 	`
-	rows, err := repo.client.Query(
+	rows, err := db.Query(
 		ctx,
 		query,
 		organizationIDs,
@@ -200,35 +331,42 @@ ORDER by array_position($1, organization_id), "parent_organization_id" ASC
 
 func (repo *repository) GetOrganization(ctx context.Context, externalId string) (*models.Organization, error) {
 	query := `
-SELECT 
-	"id", 
-	"external_id", 
-	"date_created", 
-	"date_updated", 
-	"name_dut",
-	"name_eng",
-	"acronym",
-	"type",
-	"identifier"
-FROM organizations WHERE external_id = $1 LIMIT 1`
+SELECT ` + organizationColumns + `
+FROM "organizations" WHERE external_id = $1 LIMIT 1`
 
 	orgRec := &organization{}
-	err := repo.client.QueryRow(ctx, query, externalId).Scan(
-		&orgRec.id,
-		&orgRec.externalID,
-		&orgRec.dateCreated,
-		&orgRec.dateUpdated,
-		&orgRec.nameDut,
-		&orgRec.nameEng,
-		&orgRec.acronym,
-		&orgRec.Type,
-		&orgRec.identifier,
-	)
+	err := repo.client.QueryRow(ctx, query, externalId).Scan(orgRec.scanFields()...)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, repo.notFoundError(ctx, "organization_tombstones", externalId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	orgs, err := repo.unpackOrganizations(ctx, repo.client, orgRec)
+	if err != nil {
+		return nil, err
+	}
+
+	return orgs[0], nil
+}
+
+func (repo *repository) getOrganizationForUpdate(ctx context.Context, tx pgx.Tx, externalID string) (*models.Organization, error) {
+	query := `
+SELECT ` + organizationColumns + `
+FROM "organizations" WHERE "external_id" = $1
+FOR UPDATE`
+
+	orgRec := &organization{}
+	err := tx.QueryRow(ctx, query, externalID).Scan(orgRec.scanFields()...)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, models.ErrNotFound
 	}
+	if err != nil {
+		return nil, err
+	}
 
-	orgs, err := repo.unpackOrganizations(ctx, orgRec)
+	orgs, err := repo.unpackOrganizations(ctx, tx, orgRec)
 	if err != nil {
 		return nil, err
 	}
@@ -245,16 +383,7 @@ func (repo *repository) GetOrganizationsByIdentifier(ctx context.Context, urns .
 	orgRecs := []*organization{}
 
 	query := `
-SELECT 
-	"id", 
-	"external_id", 
-	"date_created", 
-	"date_updated", 
-	"type", 
-	"name_dut", 
-	"name_eng", 
-	"acronym",
-	"identifier"
+SELECT ` + organizationColumns + `
 FROM "organizations" WHERE "identifier" ?| $1`
 
 	rows, err := repo.client.Query(ctx, query, urnValues)
@@ -265,17 +394,7 @@ FROM "organizations" WHERE "identifier" ?| $1`
 
 	for rows.Next() {
 		orgRec := &organization{}
-		err = rows.Scan(
-			&orgRec.id,
-			&orgRec.externalID,
-			&orgRec.dateCreated,
-			&orgRec.dateUpdated,
-			&orgRec.Type,
-			&orgRec.nameDut,
-			&orgRec.nameEng,
-			&orgRec.acronym,
-			&orgRec.identifier,
-		)
+		err = rows.Scan(orgRec.scanFields()...)
 		if err != nil {
 			return nil, err
 		}
@@ -290,7 +409,7 @@ FROM "organizations" WHERE "identifier" ?| $1`
 		return nil, nil
 	}
 
-	orgs, err := repo.unpackOrganizations(ctx, orgRecs...)
+	orgs, err := repo.unpackOrganizations(ctx, repo.client, orgRecs...)
 	if err != nil {
 		return nil, err
 	}
@@ -298,7 +417,7 @@ FROM "organizations" WHERE "identifier" ?| $1`
 	return orgs, nil
 }
 
-func (repo *repository) unpackOrganizations(ctx context.Context, orgRecs ...*organization) ([]*models.Organization, error) {
+func (repo *repository) unpackOrganizations(ctx context.Context, db querier, orgRecs ...*organization) ([]*models.Organization, error) {
 	orgs := make([]*models.Organization, 0, len(orgRecs))
 
 	if len(orgRecs) == 0 {
@@ -318,6 +437,9 @@ func (repo *repository) unpackOrganizations(ctx context.Context, orgRecs ...*org
 			NameDut:     orgRec.nameDut.String,
 			NameEng:     orgRec.nameEng.String,
 			Acronym:     orgRec.acronym.String,
+			Version:     orgRec.version,
+			Founded:     orgRec.founded,
+			Dissolved:   orgRec.dissolved,
 		}
 		orgs = append(orgs, org)
 		urnValues := []string{}
@@ -333,7 +455,7 @@ func (repo *repository) unpackOrganizations(ctx context.Context, orgRecs ...*org
 		}
 	}
 
-	allOrganizationParents, err := repo.getOrganizationParents(ctx, rowIDs...)
+	allOrganizationParents, err := repo.getOrganizationParents(ctx, db, rowIDs...)
 	if err != nil {
 		return nil, err
 	}
@@ -354,6 +476,34 @@ func (repo *repository) unpackOrganizations(ctx context.Context, orgRecs ...*org
 		}
 	}
 
+	allOrganizationSuccessors, err := repo.getOrganizationSuccessors(ctx, db, rowIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < len(orgRecs); i++ {
+		orgRec := orgRecs[i]
+		org := orgs[i]
+		for _, os := range allOrganizationSuccessors {
+			if os.organizationID == orgRec.id {
+				org.AddSuccessor(&models.OrganizationSuccessor{
+					ID:          os.successorOrganizationExternalID,
+					DateCreated: os.dateCreated,
+					DateUpdated: os.dateUpdated,
+					Date:        os.date,
+				})
+			}
+			if os.successorOrganizationID == orgRec.id {
+				org.AddPredecessor(&models.OrganizationSuccessor{
+					ID:          os.organizationExternalID,
+					DateCreated: os.dateCreated,
+					DateUpdated: os.dateUpdated,
+					Date:        os.date,
+				})
+			}
+		}
+	}
+
 	return orgs, nil
 }
 
@@ -364,28 +514,60 @@ func (repo *repository) SaveOrganization(ctx context.Context, org *models.Organi
 	return repo.CreateOrganization(ctx, org)
 }
 
-func (repo *repository) getTsValsForOrganization(org *models.Organization) []string {
-	tsVals := []string{org.NameDut, org.NameEng, org.Acronym}
-	tsVals = vacuum(append(tsVals, org.GetIdentifierValues()...))
-	return tsVals
+func (repo *repository) CreateOrganization(ctx context.Context, org *models.Organization) (*models.Organization, error) {
+	// start transaction
+	tx, err := repo.client.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to start transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := repo.createOrganization(ctx, tx, org); err != nil {
+		return nil, err
+	}
+
+	// commit
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("unable to commit transaction: %w", err)
+	}
+
+	return org, nil
 }
 
-func (repo *repository) CreateOrganization(ctx context.Context, org *models.Organization) (*models.Organization, error) {
-	now := time.Now().UTC()
+func (repo *repository) createOrganization(ctx context.Context, tx pgx.Tx, org *models.Organization) error {
+	now, err := dbNow(ctx, tx)
+	if err != nil {
+		return err
+	}
 	org.DateCreated = &now
 	org.DateUpdated = &now
 	org.ID = ulid.Make().String()
+	org.Version = 1
 	for _, parent := range org.Parent {
 		parent.DateCreated = &now
 		parent.DateUpdated = &now
 	}
+	for _, successor := range org.Successor {
+		successor.DateCreated = &now
+		successor.DateUpdated = &now
+	}
 
-	// start transaction
-	tx, err := repo.client.BeginTx(ctx, pgx.TxOptions{})
-	if err != nil {
-		return nil, fmt.Errorf("unable to start transaction: %w", err)
+	return repo.insertOrganization(ctx, tx, org)
+}
+
+// insertOrganization stores the new organization record org with the id, dates and version it already has
+func (repo *repository) insertOrganization(ctx context.Context, tx pgx.Tx, org *models.Organization) error {
+	if err := models.ValidateOrganizationParents(org.ID, org.Parent); err != nil {
+		return err
 	}
-	defer tx.Rollback(ctx)
+	if err := models.ValidateOrganizationSuccessors(org.ID, org.Successor); err != nil {
+		return err
+	}
+	if err := models.ValidateOrganizationLifecycle(org); err != nil {
+		return err
+	}
+	// nothing refers to a new organization yet, so its relations can't close a cycle
+	// and no locks are needed
 
 	// add organization
 	query := `
@@ -398,13 +580,16 @@ func (repo *repository) CreateOrganization(ctx context.Context, org *models.Orga
 		"type",
 		"acronym",
 		"identifier",
-		"ts_vals"
+		"ts_vals",
+		"version",
+		"founded",
+		"dissolved"
 	)
-	VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 	RETURNING "id"
 	`
 	var rowID int
-	err = tx.QueryRow(
+	err := tx.QueryRow(
 		ctx, query,
 		org.ID,
 		org.DateCreated,
@@ -415,9 +600,12 @@ func (repo *repository) CreateOrganization(ctx context.Context, org *models.Orga
 		pgtext(org.Acronym),
 		pgjson(org.GetIdentifierQualifiedValues()),
 		pgjson(repo.getTsValsForOrganization(org)),
+		org.Version,
+		org.Founded,
+		org.Dissolved,
 	).Scan(&rowID)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// add parents
@@ -435,17 +623,22 @@ func (repo *repository) CreateOrganization(ctx context.Context, org *models.Orga
 		parentOrganizationExternalIds,
 	)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer pRows.Close()
 	for pRows.Next() {
 		o := &organization{}
-		pRows.Scan(&o.id, &o.externalID)
+		if err := pRows.Scan(&o.id, &o.externalID); err != nil {
+			return err
+		}
 		pOrgRows = append(pOrgRows, o)
 	}
+	if err := pRows.Err(); err != nil {
+		return err
+	}
 
 	if len(parentOrganizationExternalIds) != len(pOrgRows) {
-		return nil, models.ErrInvalidReference
+		return models.ErrInvalidReference
 	}
 
 	query = `
@@ -472,11 +665,40 @@ VALUES($1, $2, $3, $4, $5, $6);
 			orgParent.Until,
 		)
 		if err != nil {
-			return nil, err
+			return err
 		}
 	}
 
-	// commit
+	// add successors
+	successorIDs, err := repo.saveOrganizationSuccessors(ctx, tx, rowID, org)
+	if err != nil {
+		return err
+	}
+	// stored organizations can't list a new one as successor
+	org.Predecessor = nil
+
+	if err := repo.organizationChanged(ctx, tx, models.EventOrganizationCreated, org); err != nil {
+		return err
+	}
+
+	return repo.organizationPredecessorsChanged(ctx, tx, successorIDs)
+}
+
+// UpdateOrganization stores all attributes of org.
+// When org.Version is not zero, the update only succeeds when the stored record
+// still has that version. Otherwise models.ErrConflict is returned.
+func (repo *repository) UpdateOrganization(ctx context.Context, org *models.Organization) (*models.Organization, error) {
+	// start transaction
+	tx, err := repo.client.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to start transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := repo.updateOrganization(ctx, tx, org); err != nil {
+		return nil, err
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return nil, fmt.Errorf("unable to commit transaction: %w", err)
 	}
@@ -484,8 +706,11 @@ VALUES($1, $2, $3, $4, $5, $6);
 	return org, nil
 }
 
-func (repo *repository) UpdateOrganization(ctx context.Context, org *models.Organization) (*models.Organization, error) {
-	now := time.Now().UTC()
+func (repo *repository) updateOrganization(ctx context.Context, tx pgx.Tx, org *models.Organization) error {
+	now, err := dbNow(ctx, tx)
+	if err != nil {
+		return err
+	}
 	org.DateUpdated = &now
 	for _, parent := range org.Parent {
 		if parent.DateCreated == nil {
@@ -495,13 +720,24 @@ func (repo *repository) UpdateOrganization(ctx context.Context, org *models.Orga
 			parent.DateUpdated = &now
 		}
 	}
+	for _, successor := range org.Successor {
+		if successor.DateCreated == nil {
+			successor.DateCreated = &now
+		}
+		if successor.DateUpdated == nil {
+			successor.DateUpdated = &now
+		}
+	}
 
-	// start transaction
-	tx, err := repo.client.BeginTx(ctx, pgx.TxOptions{})
-	if err != nil {
-		return nil, fmt.Errorf("unable to start transaction: %w", err)
+	if err := models.ValidateOrganizationParents(org.ID, org.Parent); err != nil {
+		return err
+	}
+	if err := models.ValidateOrganizationSuccessors(org.ID, org.Successor); err != nil {
+		return err
+	}
+	if err := models.ValidateOrganizationLifecycle(org); err != nil {
+		return err
 	}
-	defer tx.Rollback(ctx)
 
 	// update organization
 	query := `
@@ -513,9 +749,12 @@ SET
 	"type" = $5,
 	"acronym" = $6,
 	"identifier" = $7,
-	"ts_vals" = $8
-WHERE "external_id" = $1
-RETURNING "id"
+	"ts_vals" = $8,
+	"founded" = $10,
+	"dissolved" = $11,
+	"version" = "version" + 1
+WHERE "external_id" = $1 AND ($9 = 0 OR "version" = $9)
+RETURNING "id", "version"
 	`
 	var rowID int
 	err = tx.QueryRow(
@@ -529,9 +768,15 @@ RETURNING "id"
 		pgtext(org.Acronym),
 		pgjson(org.GetIdentifierQualifiedValues()),
 		pgjson(repo.getTsValsForOrganization(org)),
-	).Scan(&rowID)
+		org.Version,
+		org.Founded,
+		org.Dissolved,
+	).Scan(&rowID, &org.Version)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return repo.versionError(ctx, tx, "organizations", org.ID, org.Version)
+	}
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// update organization parents
@@ -545,7 +790,7 @@ RETURNING "id"
 
 		pRows, err := tx.Query(ctx, "SELECT id, external_id FROM organizations WHERE external_id = any($1)", parentOrganizationExternalIDs)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		defer pRows.Close()
 
@@ -555,16 +800,16 @@ RETURNING "id"
 			o := &organization{}
 			err = pRows.Scan(&o.id, &o.externalID)
 			if err != nil {
-				return nil, err
+				return err
 			}
 			pOrgRows = append(pOrgRows, o)
 		}
 		if err := pRows.Err(); err != nil {
-			return nil, err
+			return err
 		}
 
 		if len(parentOrganizationExternalIDs) != len(pOrgRows) {
-			return nil, models.ErrInvalidReference
+			return models.ErrInvalidReference
 		}
 
 		for _, parent := range org.Parent {
@@ -609,7 +854,7 @@ RETURNING "id"
 				newOrganizationParent.until,
 			).Scan(&relId)
 			if err != nil {
-				return nil, err
+				return err
 			}
 			updatedRelIds = append(updatedRelIds, relId)
 		}
@@ -618,125 +863,307 @@ RETURNING "id"
 	query = `DELETE FROM "organization_parents" WHERE "organization_id" = $1 AND NOT "id" = any($2)`
 	_, err = tx.Exec(ctx, query, rowID, updatedRelIds)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	if err := tx.Commit(ctx); err != nil {
-		return nil, fmt.Errorf("unable to commit transaction: %w", err)
+	// update organization successors
+	successorIDs, err := repo.saveOrganizationSuccessors(ctx, tx, rowID, org)
+	if err != nil {
+		return err
 	}
 
-	return org, nil
-}
-
-func (repo *repository) DeleteOrganization(ctx context.Context, id string) error {
-	_, err := repo.client.Exec(ctx, "DELETE FROM organizations WHERE external_id = $1", id)
-	return err
-}
-
-func (repo *repository) EachOrganization(ctx context.Context, cb func(*models.Organization) bool) error {
-	cursor := setCursor{}
-
-	for {
-		organizations, newCursor, err := repo.getOrganizations(ctx, cursor)
-		if err != nil {
+	if len(org.Parent) > 0 || len(org.Successor) > 0 {
+		if err := lockOrganizationRelations(ctx, tx, rowID); err != nil {
 			return err
 		}
-
-		for _, organization := range organizations {
-			if !cb(organization) {
-				return nil
-			}
-		}
-
-		if len(organizations) == 0 {
-			break
+	}
+	if len(org.Parent) > 0 {
+		if err := checkOrganizationCycle(ctx, tx, rowID); err != nil {
+			return err
 		}
-		if newCursor.LastID <= 0 {
-			break
+	}
+	if len(org.Successor) > 0 {
+		if err := checkOrganizationSuccessionCycle(ctx, tx, rowID); err != nil {
+			return err
 		}
-		cursor = newCursor
+	}
+	// predecessors are read only, report the stored ones
+	org.Predecessor, err = repo.getOrganizationPredecessors(ctx, tx, rowID)
+	if err != nil {
+		return err
 	}
 
-	return nil
-}
-
-func (repo *repository) SuggestOrganizations(ctx context.Context, params models.OrganizationSuggestParams) ([]*models.Organization, error) {
-	params = params.MergeDefault()
-	tsQuery, tsQueryArgs := toTSQuery(params.Query)
+	if err := repo.organizationChanged(ctx, tx, models.EventOrganizationUpdated, org); err != nil {
+		return err
+	}
 
-	sqlQuery := fmt.Sprintf(
-		`SELECT
-		"id", 
-		"external_id", 
-		"date_created", 
-		"date_updated", 
-		"type", 
-		"name_dut", 
-		"name_eng", 
-		"acronym",
-		"identifier",
-		ts_rank(ts, %s) AS rank 
-		FROM "organizations" WHERE ts @@ %s LIMIT %d`,
-		tsQuery,
-		tsQuery,
-		params.Limit)
+	return repo.organizationPredecessorsChanged(ctx, tx, successorIDs)
+}
 
-	rows, err := repo.client.Query(ctx, sqlQuery, tsQueryArgs...)
+// versionError explains why an update of a record with a version precondition did not match any row:
+// either the record does not exist (models.ErrNotFound) or it was changed in the meantime (models.ErrConflict)
+func (repo *repository) versionError(ctx context.Context, db querier, table string, externalID string, version int) error {
+	var currentVersion int
+	err := db.QueryRow(
+		ctx,
+		fmt.Sprintf(`SELECT "version" FROM %s WHERE "external_id" = $1`, pgx.Identifier{table}.Sanitize()),
+		externalID,
+	).Scan(&currentVersion)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return models.ErrNotFound
+	}
 	if err != nil {
-		return nil, err
+		return err
+	}
+	return fmt.Errorf(
+		"%w: record %s has version %d, expected version %d",
+		models.ErrConflict,
+		externalID,
+		currentVersion,
+		version,
+	)
+}
+
+// DeleteOrganization removes an organization record.
+// Unless cascade is true, organizations that still have members, child organizations
+// or successor relations are refused with models.ErrConflict.
+// With cascade, memberships, child and successor relations are removed along with
+// the organization (cf. "ON DELETE CASCADE"), but the related organizations themselves are kept.
+func (repo *repository) DeleteOrganization(ctx context.Context, id string, cascade bool) error {
+	tx, err := repo.client.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("unable to start transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := repo.deleteOrganization(ctx, tx, id, cascade); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("unable to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *repository) deleteOrganization(ctx context.Context, tx pgx.Tx, id string, cascade bool) error {
+	var rowID, version int
+	err := tx.QueryRow(ctx, `SELECT "id", "version" FROM "organizations" WHERE "external_id" = $1 FOR UPDATE`, id).Scan(&rowID, &version)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return models.ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	if !cascade {
+		var nMembers, nChildren, nSuccessions int
+		err = tx.QueryRow(
+			ctx,
+			`SELECT
+	(SELECT COUNT(*) FROM "organization_members" WHERE "organization_id" = $1),
+	(SELECT COUNT(*) FROM "organization_parents" WHERE "parent_organization_id" = $1),
+	(SELECT COUNT(*) FROM "organization_successors" WHERE "organization_id" = $1 OR "successor_organization_id" = $1)`,
+			rowID,
+		).Scan(&nMembers, &nChildren, &nSuccessions)
+		if err != nil {
+			return err
+		}
+		if nMembers > 0 || nChildren > 0 || nSuccessions > 0 {
+			return fmt.Errorf(
+				"%w: organization %s still has %d members, %d child organizations and %d successor relations",
+				models.ErrConflict,
+				id,
+				nMembers,
+				nChildren,
+				nSuccessions,
+			)
+		}
+	}
+
+	// members, child organizations, successors and predecessors lose a relation, so they change as well
+	var memberIDs, childIDs []int
+	if cascade {
+		memberIDs, err = repo.touchRecords(ctx, tx, `UPDATE "people" SET "date_updated" = $1, "version" = "version" + 1
+WHERE "id" IN (SELECT "person_id" FROM "organization_members" WHERE "organization_id" = $2)
+RETURNING "id"`, rowID)
+		if err != nil {
+			return err
+		}
+		childIDs, err = repo.touchRecords(ctx, tx, `UPDATE "organizations" SET "date_updated" = $1, "version" = "version" + 1
+WHERE "id" IN (SELECT "organization_id" FROM "organization_parents" WHERE "parent_organization_id" = $2)
+	OR "id" IN (SELECT "successor_organization_id" FROM "organization_successors" WHERE "organization_id" = $2)
+	OR "id" IN (SELECT "organization_id" FROM "organization_successors" WHERE "successor_organization_id" = $2)
+RETURNING "id"`, rowID)
+		if err != nil {
+			return err
+		}
+	}
+
+	if _, err = tx.Exec(ctx, `DELETE FROM "organizations" WHERE "id" = $1`, rowID); err != nil {
+		return err
+	}
+
+	if err := repo.addTombstones(ctx, tx, "organization_tombstones", models.TombstoneDeleted, "", id); err != nil {
+		return err
+	}
+
+	if err := repo.organizationDeleted(ctx, tx, id, version); err != nil {
+		return err
+	}
+
+	if len(memberIDs) > 0 {
+		people, err := repo.getPeopleByRowID(ctx, tx, memberIDs...)
+		if err != nil {
+			return err
+		}
+		for _, p := range people {
+			if err := repo.personChanged(ctx, tx, models.EventPersonUpdated, p); err != nil {
+				return err
+			}
+		}
+	}
+	if len(childIDs) > 0 {
+		orgs, err := repo.getOrganizationsByRowID(ctx, tx, childIDs...)
+		if err != nil {
+			return err
+		}
+		for _, org := range orgs {
+			if err := repo.organizationChanged(ctx, tx, models.EventOrganizationUpdated, org); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (repo *repository) EachOrganization(ctx context.Context, cb func(*models.Organization) bool) error {
+	cursor := setCursor{}
+
+	for {
+		organizations, newCursor, err := repo.getOrganizations(ctx, cursor)
+		if err != nil {
+			return err
+		}
+
+		for _, organization := range organizations {
+			if !cb(organization) {
+				return nil
+			}
+		}
+
+		if len(organizations) == 0 {
+			break
+		}
+		if newCursor.LastID <= 0 {
+			break
+		}
+		cursor = newCursor
+	}
+
+	return nil
+}
+
+// SuggestOrganizations matches on the full text index (all terms as prefix),
+// or on the trigram similarity of query and the dutch or english name, which tolerates typos.
+// Results are ranked by the sum of both scores. Dissolved organizations are left out
+// unless params.IncludeDissolved is set.
+func (repo *repository) SuggestOrganizations(ctx context.Context, params models.OrganizationSuggestParams) ([]*models.Organization, []*models.Match, error) {
+	params = params.MergeDefault()
+	if err := params.Validate(); err != nil {
+		return nil, nil, err
+	}
+	tsQuery, tsQueryArgs := toTSQuery(params.Query)
+	if len(tsQueryArgs) == 0 {
+		return nil, nil, nil
+	}
+	rank := tsRank(tsQuery, len(tsQueryArgs))
+	args := append(append([]any{}, tsQueryArgs...), params.Query)
+	query := fmt.Sprintf("f_unaccent(lower($%d))", len(args))
+	dissolvedCond := "true"
+	if !params.IncludeDissolved {
+		dissolvedCond = organizationNotDissolvedFilter
+	}
+
+	sqlQuery := fmt.Sprintf(
+		`SELECT
+	`+organizationColumns+`,
+	%[4]s + GREATEST(
+		COALESCE(word_similarity(%[2]s, f_unaccent(lower("name_dut"))), 0),
+		COALESCE(word_similarity(%[2]s, f_unaccent(lower("name_eng"))), 0)
+	) AS rank
+FROM "organizations"
+WHERE (ts @@ %[1]s OR %[2]s <%% f_unaccent(lower("name_dut")) OR %[2]s <%% f_unaccent(lower("name_eng"))) AND %[5]s
+ORDER BY "rank" DESC LIMIT %[3]d`,
+		tsQuery,
+		query,
+		params.Limit,
+		rank,
+		dissolvedCond)
+
+	tx, err := repo.client.Begin(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := setSimilarityThreshold(ctx, tx, params.Threshold); err != nil {
+		return nil, nil, err
+	}
+
+	rows, err := tx.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, nil, err
 	}
 	defer rows.Close()
 
 	orgRecs := []*organization{}
+	ranks := []float64{}
 
 	for rows.Next() {
 		orgRec := &organization{}
 		var rank float64
-		err = rows.Scan(
-			&orgRec.id,
-			&orgRec.externalID,
-			&orgRec.dateCreated,
-			&orgRec.dateUpdated,
-			&orgRec.Type,
-			&orgRec.nameDut,
-			&orgRec.nameEng,
-			&orgRec.acronym,
-			&orgRec.identifier,
-			&rank,
-		)
+		err = rows.Scan(append(orgRec.scanFields(), &rank)...)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		orgRecs = append(orgRecs, orgRec)
+		ranks = append(ranks, rank)
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if len(orgRecs) == 0 {
-		return nil, nil
+		return nil, nil, nil
 	}
 
-	orgs, err := repo.unpackOrganizations(ctx, orgRecs...)
+	orgs, err := repo.unpackOrganizations(ctx, tx, orgRecs...)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return orgs, nil
+	if !params.Highlight {
+		return orgs, nil, nil
+	}
+
+	hitFieldValues := make([][]fieldValue, 0, len(orgs))
+	for _, org := range orgs {
+		hitFieldValues = append(hitFieldValues, repo.getTsFieldValuesForOrganization(org))
+	}
+	matches, err := repo.getMatches(ctx, tx, tsQuery, tsQueryArgs, params.Query, hitFieldValues, ranks)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return orgs, matches, nil
 }
 
 func (repo *repository) GetOrganizationsById(ctx context.Context, ids ...string) ([]*models.Organization, error) {
-	query := `SELECT 
-	"id",
-	"external_id",
-	"date_created",
-	"date_updated",
-	"type",
-	"name_dut",
-	"name_eng", 
-	"acronym",
-	"identifier"
+	query := `SELECT ` + organizationColumns + `
 FROM "organizations" WHERE "external_id" = any($1)`
 
 	rows, err := repo.client.Query(ctx, query, ids)
@@ -748,17 +1175,7 @@ FROM "organizations" WHERE "external_id" = any($1)`
 	orgRecs := []*organization{}
 	for rows.Next() {
 		orgRec := &organization{}
-		err = rows.Scan(
-			&orgRec.id,
-			&orgRec.externalID,
-			&orgRec.dateCreated,
-			&orgRec.dateUpdated,
-			&orgRec.Type,
-			&orgRec.nameDut,
-			&orgRec.nameEng,
-			&orgRec.acronym,
-			&orgRec.identifier,
-		)
+		err = rows.Scan(orgRec.scanFields()...)
 		if err != nil {
 			return nil, err
 		}
@@ -773,7 +1190,7 @@ FROM "organizations" WHERE "external_id" = any($1)`
 		return nil, nil
 	}
 
-	orgs, err := repo.unpackOrganizations(ctx, orgRecs...)
+	orgs, err := repo.unpackOrganizations(ctx, repo.client, orgRecs...)
 	if err != nil {
 		return nil, err
 	}
@@ -823,16 +1240,7 @@ func (repo *repository) getOrganizations(ctx context.Context, cursor setCursor)
 
 	// get organizations
 	query := `
-SELECT 
-	"id",
-	"external_id",
-	"date_created",
-	"date_updated",
-	"type",
-	"name_dut",
-	"name_eng", 
-	"acronym",
-	"identifier"
+SELECT ` + organizationColumns + `
 FROM "organizations"
 WHERE "id" > $1 ORDER BY "id" ASC LIMIT $2`
 
@@ -845,17 +1253,7 @@ WHERE "id" > $1 ORDER BY "id" ASC LIMIT $2`
 	orgRecs := []*organization{}
 	for rows.Next() {
 		orgRec := &organization{}
-		err = rows.Scan(
-			&orgRec.id,
-			&orgRec.externalID,
-			&orgRec.dateCreated,
-			&orgRec.dateUpdated,
-			&orgRec.Type,
-			&orgRec.nameDut,
-			&orgRec.nameEng,
-			&orgRec.acronym,
-			&orgRec.identifier,
-		)
+		err = rows.Scan(orgRec.scanFields()...)
 		if err != nil {
 			return nil, newCursor, err
 		}
@@ -877,7 +1275,7 @@ WHERE "id" > $1 ORDER BY "id" ASC LIMIT $2`
 		return nil, newCursor, err
 	}
 
-	orgs, err := repo.unpackOrganizations(ctx, orgRecs...)
+	orgs, err := repo.unpackOrganizations(ctx, repo.client, orgRecs...)
 	if err != nil {
 		return nil, newCursor, err
 	}
@@ -899,30 +1297,49 @@ func (repo *repository) SavePerson(ctx context.Context, p *models.Person) (*mode
 	return repo.CreatePerson(ctx, p)
 }
 
-func (repo *repository) getTsValsForPerson(p *models.Person) []string {
-	tsVals := []string{p.Name}
-	tsVals = vacuum(append(tsVals, p.GetIdentifierValues()...))
-	return tsVals
+func (repo *repository) CreatePerson(ctx context.Context, p *models.Person) (*models.Person, error) {
+	tx, err := repo.client.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := repo.createPerson(ctx, tx, p); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("unable to commit transaction: %w", err)
+	}
+
+	return p, nil
 }
 
-func (repo *repository) CreatePerson(ctx context.Context, p *models.Person) (*models.Person, error) {
-	now := time.Now().UTC()
+func (repo *repository) createPerson(ctx context.Context, tx pgx.Tx, p *models.Person) error {
+	now, err := dbNow(ctx, tx)
+	if err != nil {
+		return err
+	}
 	p.DateCreated = &now
 	p.DateUpdated = &now
 	p.ID = ulid.Make().String()
+	p.Version = 1
 	for _, orgMember := range p.Organization {
 		orgMember.DateCreated = &now
 		orgMember.DateUpdated = &now
 	}
 
+	return repo.insertPerson(ctx, tx, p)
+}
+
+// insertPerson stores the new person record p with the id, dates and version it already has
+func (repo *repository) insertPerson(ctx context.Context, tx pgx.Tx, p *models.Person) error {
 	// ensure biblio_id
 	p.EnsureBiblioID()
 
-	tx, err := repo.client.BeginTx(ctx, pgx.TxOptions{})
-	if err != nil {
-		return nil, err
+	if err := models.ValidateOrganizationMembers(p.Organization); err != nil {
+		return err
 	}
-	defer tx.Rollback(ctx)
 
 	query := `
 INSERT INTO "people"
@@ -945,7 +1362,8 @@ INSERT INTO "people"
 		"object_class",
 		"token",
 		"identifier",
-		"ts_vals"
+		"ts_vals",
+		"version"
 	)
 	VALUES
 	(
@@ -967,7 +1385,8 @@ INSERT INTO "people"
 		$16,
 		$17,
 		$18,
-		$19
+		$19,
+		$20
 	)
 	RETURNING "id"
 	`
@@ -994,7 +1413,7 @@ INSERT INTO "people"
 	for typ, val := range p.Token {
 		eVal, err := encryptMessage(repo.secret, val)
 		if err != nil {
-			return nil, fmt.Errorf("unable to encrypt %s: %w", typ, err)
+			return fmt.Errorf("unable to encrypt %s: %w", typ, err)
 		}
 		eTokenMap[typ] = eVal
 	}
@@ -1002,11 +1421,12 @@ INSERT INTO "people"
 		pgjson(eTokenMap),
 		pgjson(p.GetIdentifierQualifiedValues()),
 		pgjson(repo.getTsValsForPerson(p)),
+		p.Version,
 	)
 
-	err = tx.QueryRow(ctx, query, queryArgs...).Scan(&rowID)
+	err := tx.QueryRow(ctx, query, queryArgs...).Scan(&rowID)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	if len(p.Organization) > 0 {
@@ -1016,90 +1436,193 @@ INSERT INTO "people"
 		}
 		organizationExternalIDs = lo.Uniq(organizationExternalIDs)
 
-		orgRowIDS := []int{}
+		orgRowIDs := map[string]int{}
 		rows, err := tx.Query(
 			ctx,
-			`SELECT "id" FROM "organizations" WHERE "external_id" = any($1)`,
+			`SELECT "id", "external_id" FROM "organizations" WHERE "external_id" = any($1)`,
 			organizationExternalIDs)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		defer rows.Close()
 
 		for rows.Next() {
-			var rowID int
-			err = rows.Scan(&rowID)
+			var orgRowID int
+			var externalID string
+			err = rows.Scan(&orgRowID, &externalID)
 			if err != nil {
-				return nil, err
+				return err
 			}
-			orgRowIDS = append(orgRowIDS, rowID)
+			orgRowIDs[externalID] = orgRowID
 		}
 		if err := rows.Err(); err != nil {
-			return nil, err
+			return err
 		}
 
-		if len(organizationExternalIDs) != len(orgRowIDS) {
-			return nil, fmt.Errorf("%w: person.organization_id contains invalid organization id's", models.ErrInvalidReference)
+		if len(organizationExternalIDs) != len(orgRowIDs) {
+			return fmt.Errorf("%w: person.organization_id contains invalid organization id's", models.ErrInvalidReference)
 		}
 
-		for i := 0; i < len(orgRowIDS); i++ {
+		for _, orgMember := range p.Organization {
 			insertQuery := `
-			INSERT INTO "organization_members"("date_created", "date_updated", "organization_id", "person_id")
-			VALUES($1, $2, $3, $4)
+			INSERT INTO "organization_members"
+				("date_created", "date_updated", "organization_id", "person_id", "from", "until", "type", "fte", "source")
+			VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9)
 			`
-			_, err = tx.Exec(ctx, insertQuery, now, now, orgRowIDS[i], rowID)
+			_, err = tx.Exec(
+				ctx,
+				insertQuery,
+				p.DateUpdated,
+				p.DateUpdated,
+				orgRowIDs[orgMember.ID],
+				rowID,
+				orgMember.From,
+				orgMember.Until,
+				pgtext(orgMember.Type),
+				orgMember.FTE,
+				pgtext(orgMember.Source),
+			)
 			if err != nil {
-				return nil, err
+				return err
 			}
 		}
 
 	}
 
-	if err := tx.Commit(ctx); err != nil {
-		return nil, fmt.Errorf("unable to commit transaction: %w", err)
+	if err := repo.personChanged(ctx, tx, models.EventPersonCreated, p); err != nil {
+		return err
 	}
 
-	return p, nil
+	return nil
 }
 
-// TODO: make transaction safe
-func (repo *repository) SetPersonOrcid(ctx context.Context, id string, orcid string) error {
-	person, err := repo.GetPerson(ctx, id)
+// SetPersonOrcid replaces all identifiers of namespace "orcid". An empty orcid removes them.
+func (repo *repository) SetPersonOrcid(ctx context.Context, id string, orcid string, version int) error {
+	// identifiers are indexed for autocomplete, so "ts_vals" is refreshed as well
+	return repo.setPersonAttribute(ctx, id, version, true, `"identifier" = (
+		SELECT COALESCE(jsonb_agg("v"), '[]'::jsonb)
+		FROM jsonb_array_elements_text(COALESCE("identifier", '[]'::jsonb)) AS "v"
+		WHERE "v" NOT LIKE 'urn:orcid:%'
+	) || CASE WHEN $4::text = '' THEN '[]'::jsonb ELSE jsonb_build_array('urn:orcid:' || $4::text) END`,
+		orcid,
+	)
+}
+
+// SetPersonToken sets token of type typ. An empty val removes the token.
+func (repo *repository) SetPersonToken(ctx context.Context, id string, typ string, val string, version int) error {
+	if val == "" {
+		return repo.setPersonAttribute(ctx, id, version, false, `"token" = COALESCE("token", '{}'::jsonb) - $4::text`, typ)
+	}
+	eVal, err := encryptMessage(repo.secret, val)
+	if err != nil {
+		return fmt.Errorf("unable to encrypt %s: %w", typ, err)
+	}
+	return repo.setPersonAttribute(ctx, id, version, false,
+		`"token" = jsonb_set(COALESCE("token", '{}'::jsonb), ARRAY[$4::text], to_jsonb($5::text))`,
+		typ, eVal,
+	)
+}
+
+// setPersonAttribute changes one attribute of person record id with a single UPDATE statement
+// and records the new version. set holds the assignments, its arguments are numbered from $4.
+// When version is not zero, models.ErrConflict is returned if the stored record has another version.
+// With refreshTsVals, "ts_vals" is recomputed from the updated record in the same transaction.
+func (repo *repository) setPersonAttribute(ctx context.Context, id string, version int, refreshTsVals bool, set string, args ...any) error {
+	tx, err := repo.client.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
 		return err
 	}
+	defer tx.Rollback(ctx)
 
-	person.Identifier = lo.Filter(person.Identifier, func(identifier *models.URN, idx int) bool {
-		return identifier.Namespace != "orcid"
-	})
-	if orcid != "" {
-		person.AddIdentifier(models.NewURN("orcid", orcid))
+	query := `
+UPDATE "people"
+SET "date_updated" = $1, ` + set + `, "version" = "version" + 1
+WHERE "external_id" = $2 AND ($3 = 0 OR "version" = $3)
+RETURNING ` + personColumns
+
+	now, err := dbNow(ctx, tx)
+	if err != nil {
+		return err
 	}
 
-	_, err = repo.UpdatePerson(ctx, person)
+	rec := &person{}
+	err = tx.QueryRow(ctx, query, append([]any{now, id, version}, args...)...).Scan(rec.scanFields()...)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return repo.versionError(ctx, tx, "people", id, version)
+	}
+	if err != nil {
+		return err
+	}
 
-	return err
+	people, err := repo.unpackPeople(ctx, tx, rec)
+	if err != nil {
+		return err
+	}
+	p := people[0]
+
+	if refreshTsVals {
+		if _, err := tx.Exec(ctx, `UPDATE "people" SET "ts_vals" = $1 WHERE "id" = $2`, pgjson(repo.getTsValsForPerson(p)), rec.id); err != nil {
+			return err
+		}
+	}
+
+	if err := repo.personChanged(ctx, tx, models.EventPersonUpdated, p); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
 }
 
-// TODO: make transaction safe
-func (repo *repository) SetPersonToken(ctx context.Context, id string, typ string, val string) error {
-	person, err := repo.GetPerson(ctx, id)
+func (repo *repository) getPersonForUpdate(ctx context.Context, tx pgx.Tx, externalID string) (*models.Person, error) {
+	query := `
+SELECT ` + personColumns + `
+FROM "people" WHERE "external_id" = $1
+FOR UPDATE
+	`
+
+	p := &person{}
+	err := tx.QueryRow(ctx, query, externalID).Scan(p.scanFields()...)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, models.ErrNotFound
+	}
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if val == "" {
-		delete(person.Token, typ)
-	} else {
-		person.Token[typ] = val
+	people, err := repo.unpackPeople(ctx, tx, p)
+	if err != nil {
+		return nil, err
 	}
 
-	_, err = repo.UpdatePerson(ctx, person)
-	return err
+	return people[0], nil
 }
 
+// UpdatePerson stores all attributes of p.
+// When p.Version is not zero, the update only succeeds when the stored record
+// still has that version. Otherwise models.ErrConflict is returned.
 func (repo *repository) UpdatePerson(ctx context.Context, p *models.Person) (*models.Person, error) {
-	now := time.Now().UTC()
+	tx, err := repo.client.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := repo.updatePerson(ctx, tx, p); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func (repo *repository) updatePerson(ctx context.Context, tx pgx.Tx, p *models.Person) error {
+	now, err := dbNow(ctx, tx)
+	if err != nil {
+		return err
+	}
 	p.DateUpdated = &now
 	for _, orgMember := range p.Organization {
 		if orgMember.DateCreated == nil {
@@ -1112,11 +1635,9 @@ func (repo *repository) UpdatePerson(ctx context.Context, p *models.Person) (*mo
 	// ensure biblio_id
 	p.EnsureBiblioID()
 
-	tx, err := repo.client.BeginTx(ctx, pgx.TxOptions{})
-	if err != nil {
-		return nil, err
+	if err := models.ValidateOrganizationMembers(p.Organization); err != nil {
+		return err
 	}
-	defer tx.Rollback(ctx)
 
 	// update person
 	query := `
@@ -1137,9 +1658,10 @@ SET "date_updated" = $1,
 	"object_class" = $14,
 	"token" = $15,
 	"identifier" = $16,
-	"ts_vals" = $17
-WHERE "external_id" = $18
-RETURNING "id"
+	"ts_vals" = $17,
+	"version" = "version" + 1
+WHERE "external_id" = $18 AND ($19 = 0 OR "version" = $19)
+RETURNING "id", "version"
 	`
 	var rowID int
 	queryArgs := []any{
@@ -1162,7 +1684,7 @@ RETURNING "id"
 	for typ, val := range p.Token {
 		eVal, err := encryptMessage(repo.secret, val)
 		if err != nil {
-			return nil, fmt.Errorf("unable to encrypt %s: %w", typ, err)
+			return fmt.Errorf("unable to encrypt %s: %w", typ, err)
 		}
 		eTokenMap[typ] = eVal
 	}
@@ -1171,11 +1693,15 @@ RETURNING "id"
 		pgjson(p.GetIdentifierQualifiedValues()),
 		pgjson(repo.getTsValsForPerson(p)),
 		p.ID,
+		p.Version,
 	)
 
-	err = tx.QueryRow(ctx, query, queryArgs...).Scan(&rowID)
+	err = tx.QueryRow(ctx, query, queryArgs...).Scan(&rowID, &p.Version)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return repo.versionError(ctx, tx, "people", p.ID, p.Version)
+	}
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// update "organization_members"
@@ -1193,7 +1719,7 @@ RETURNING "id"
 			orgExternalIDs,
 		)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		defer rows.Close()
 
@@ -1201,13 +1727,13 @@ RETURNING "id"
 		for rows.Next() {
 			o := &organization{}
 			if err = rows.Scan(&o.id, &o.externalID); err != nil {
-				return nil, err
+				return err
 			}
 			orgRows = append(orgRows, o)
 		}
 
 		if len(orgExternalIDs) != len(orgRows) {
-			return nil, models.ErrInvalidReference
+			return models.ErrInvalidReference
 		}
 
 		for _, orgMember := range p.Organization {
@@ -1221,16 +1747,28 @@ RETURNING "id"
 
 			insertQuery := `
 			INSERT INTO "organization_members"
-				("date_created", "date_updated", "person_id", "organization_id")
-			VALUES($1, $2, $3, $4)
-			ON CONFLICT("person_id", "organization_id")
-			DO UPDATE SET date_updated = EXCLUDED.date_updated
+				("date_created", "date_updated", "person_id", "organization_id", "from", "until", "type", "fte", "source")
+			VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9)
+			ON CONFLICT("person_id", "organization_id", COALESCE("type", ''), COALESCE("from", '-infinity'::timestamptz))
+			DO UPDATE SET date_updated = EXCLUDED.date_updated, until = EXCLUDED.until, fte = EXCLUDED.fte, source = EXCLUDED.source
 			RETURNING "id"
 			`
 			var relID int
-			err = tx.QueryRow(ctx, insertQuery, orgMember.DateCreated, orgMember.DateUpdated, rowID, orgId).Scan(&relID)
+			err = tx.QueryRow(
+				ctx,
+				insertQuery,
+				orgMember.DateCreated,
+				orgMember.DateUpdated,
+				rowID,
+				orgId,
+				orgMember.From,
+				orgMember.Until,
+				pgtext(orgMember.Type),
+				orgMember.FTE,
+				pgtext(orgMember.Source),
+			).Scan(&relID)
 			if err != nil {
-				return nil, err
+				return err
 			}
 			updatedOrganizationMemberIds = append(updatedOrganizationMemberIds, relID)
 		}
@@ -1240,103 +1778,45 @@ RETURNING "id"
 	queryArgs = []any{rowID, updatedOrganizationMemberIds}
 	_, err = tx.Exec(ctx, query, queryArgs...)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	if err := tx.Commit(ctx); err != nil {
-		return nil, err
+	if err := repo.personChanged(ctx, tx, models.EventPersonUpdated, p); err != nil {
+		return err
 	}
 
-	return p, nil
+	return nil
 }
 
 func (repo *repository) GetPerson(ctx context.Context, externalID string) (*models.Person, error) {
 	query := `
-SELECT
-	"id",
-	"date_created",
-	"date_updated",
-	"external_id",
-	"active",
-	"birth_date",
-	"email",
-	"given_name",
-	"name",
-	"family_name",
-	"job_category",
-	"preferred_given_name",
-	"preferred_family_name",
-	"honorific_prefix", 
-	"role",
-	"settings", 
-	"object_class",
-	"token",
-	"identifier"
+SELECT ` + personColumns + `
 FROM "people" WHERE "external_id" = $1
 LIMIT 1
 	`
 
 	p := &person{}
-	err := repo.client.QueryRow(ctx, query, externalID).Scan(
-		&p.id,
-		&p.dateCreated,
-		&p.dateUpdated,
-		&p.externalID,
-		&p.active,
-		&p.birthDate,
-		&p.email,
-		&p.givenName,
-		&p.name,
-		&p.familyName,
-		&p.jobCategory,
-		&p.preferredGivenName,
-		&p.preferredFamilyName,
-		&p.honorificPrefix,
-		&p.role,
-		&p.settings,
-		&p.objectClass,
-		&p.token,
-		&p.identifier,
-	)
+	err := repo.client.QueryRow(ctx, query, externalID).Scan(p.scanFields()...)
 
 	if errors.Is(err, pgx.ErrNoRows) {
-		return nil, models.ErrNotFound
+		return nil, repo.notFoundError(ctx, "person_tombstones", externalID)
 	}
 	if err != nil {
 		return nil, err
-	}
-
-	people, err := repo.unpackPeople(ctx, p)
-	if err != nil {
-		return nil, err
-	}
-
-	return people[0], nil
-}
-
-func (repo *repository) GetPeopleByIdentifier(ctx context.Context, urns ...*models.URN) ([]*models.Person, error) {
-	query := `
-	SELECT
-		"id",
-		"date_created",
-		"date_updated",
-		"external_id",
-		"active",
-		"birth_date",
-		"email",
-		"given_name",
-		"name",
-		"family_name",
-		"job_category",
-		"preferred_given_name",
-		"preferred_family_name",
-		"honorific_prefix", 
-		"role",
-		"settings", 
-		"object_class",
-		"token",
-		"identifier"
-	FROM "people" WHERE "identifier" ?| $1
+	}
+
+	people, err := repo.unpackPeople(ctx, repo.client, p)
+	if err != nil {
+		return nil, err
+	}
+
+	return people[0], nil
+}
+
+func (repo *repository) GetPeopleByIdentifier(ctx context.Context, urns ...*models.URN) ([]*models.Person, error) {
+	query := `
+SELECT ` + personColumns + `
+FROM "people" WHERE "identifier" ?| $1
 	`
 
 	ids := make([]string, 0, len(urns))
@@ -1353,27 +1833,7 @@ func (repo *repository) GetPeopleByIdentifier(ctx context.Context, urns ...*mode
 
 	for rows.Next() {
 		p := &person{}
-		err = rows.Scan(
-			&p.id,
-			&p.dateCreated,
-			&p.dateUpdated,
-			&p.externalID,
-			&p.active,
-			&p.birthDate,
-			&p.email,
-			&p.givenName,
-			&p.name,
-			&p.familyName,
-			&p.jobCategory,
-			&p.preferredGivenName,
-			&p.preferredFamilyName,
-			&p.honorificPrefix,
-			&p.role,
-			&p.settings,
-			&p.objectClass,
-			&p.token,
-			&p.identifier,
-		)
+		err = rows.Scan(p.scanFields()...)
 		if err != nil {
 			return nil, err
 		}
@@ -1384,7 +1844,7 @@ func (repo *repository) GetPeopleByIdentifier(ctx context.Context, urns ...*mode
 		return nil, nil
 	}
 
-	people, err := repo.unpackPeople(ctx, personRecs...)
+	people, err := repo.unpackPeople(ctx, repo.client, personRecs...)
 	if err != nil {
 		return nil, err
 	}
@@ -1394,27 +1854,8 @@ func (repo *repository) GetPeopleByIdentifier(ctx context.Context, urns ...*mode
 
 func (repo *repository) GetPeopleById(ctx context.Context, ids ...string) ([]*models.Person, error) {
 	query := `
-	SELECT
-		"id",
-		"date_created",
-		"date_updated",
-		"external_id",
-		"active",
-		"birth_date",
-		"email",
-		"given_name",
-		"name",
-		"family_name",
-		"job_category",
-		"preferred_given_name",
-		"preferred_family_name",
-		"honorific_prefix", 
-		"role",
-		"settings", 
-		"object_class",
-		"token",
-		"identifier"
-	FROM "people" WHERE "external_id" = any($1)
+SELECT ` + personColumns + `
+FROM "people" WHERE "external_id" = any($1)
 	`
 
 	rows, err := repo.client.Query(ctx, query, ids)
@@ -1427,27 +1868,7 @@ func (repo *repository) GetPeopleById(ctx context.Context, ids ...string) ([]*mo
 
 	for rows.Next() {
 		p := &person{}
-		err = rows.Scan(
-			&p.id,
-			&p.dateCreated,
-			&p.dateUpdated,
-			&p.externalID,
-			&p.active,
-			&p.birthDate,
-			&p.email,
-			&p.givenName,
-			&p.name,
-			&p.familyName,
-			&p.jobCategory,
-			&p.preferredGivenName,
-			&p.preferredFamilyName,
-			&p.honorificPrefix,
-			&p.role,
-			&p.settings,
-			&p.objectClass,
-			&p.token,
-			&p.identifier,
-		)
+		err = rows.Scan(p.scanFields()...)
 		if err != nil {
 			return nil, err
 		}
@@ -1458,7 +1879,7 @@ func (repo *repository) GetPeopleById(ctx context.Context, ids ...string) ([]*mo
 		return nil, nil
 	}
 
-	people, err := repo.unpackPeople(ctx, personRecs...)
+	people, err := repo.unpackPeople(ctx, repo.client, personRecs...)
 	if err != nil {
 		return nil, err
 	}
@@ -1466,7 +1887,7 @@ func (repo *repository) GetPeopleById(ctx context.Context, ids ...string) ([]*mo
 	return people, nil
 }
 
-func (repo *repository) unpackPeople(ctx context.Context, personRecs ...*person) ([]*models.Person, error) {
+func (repo *repository) unpackPeople(ctx context.Context, db querier, personRecs ...*person) ([]*models.Person, error) {
 	people := make([]*models.Person, 0, len(personRecs))
 
 	if len(personRecs) == 0 {
@@ -1491,6 +1912,7 @@ func (repo *repository) unpackPeople(ctx context.Context, personRecs ...*person)
 			PreferredFamilyName: personRec.preferredFamilyName.String,
 			BirthDate:           personRec.birthDate.String,
 			HonorificPrefix:     personRec.honorificPrefix.String,
+			Version:             personRec.version,
 		}
 		if vals, err := fromPgTextArray(personRec.jobCategory); err != nil {
 			return nil, err
@@ -1540,7 +1962,7 @@ func (repo *repository) unpackPeople(ctx context.Context, personRecs ...*person)
 		people = append(people, person)
 	}
 
-	allPersonOrganizationMembers, err := repo.getOrganizationMembers(ctx, rowIDs...)
+	allPersonOrganizationMembers, err := repo.getOrganizationMembers(ctx, db, rowIDs...)
 	if err != nil {
 		return nil, err
 	}
@@ -1553,6 +1975,11 @@ func (repo *repository) unpackPeople(ctx context.Context, personRecs ...*person)
 					ID:          orgMember.organizationExternalID,
 					DateCreated: orgMember.dateCreated,
 					DateUpdated: orgMember.dateUpdated,
+					From:        orgMember.from,
+					Until:       orgMember.until,
+					Type:        orgMember.typ.String,
+					FTE:         orgMember.fte,
+					Source:      orgMember.source.String,
 				})
 			}
 		}
@@ -1562,8 +1989,104 @@ func (repo *repository) unpackPeople(ctx context.Context, personRecs ...*person)
 }
 
 func (repo *repository) DeletePerson(ctx context.Context, id string) error {
-	_, err := repo.client.Exec(ctx, `DELETE FROM "people" WHERE "external_id" = $1`, id)
-	return err
+	tx, err := repo.client.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := repo.deletePerson(ctx, tx, id); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (repo *repository) deletePerson(ctx context.Context, tx pgx.Tx, id string) error {
+	var version int
+	err := tx.QueryRow(ctx, `DELETE FROM "people" WHERE "external_id" = $1 RETURNING "version"`, id).Scan(&version)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return models.ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := repo.addTombstones(ctx, tx, "person_tombstones", models.TombstoneDeleted, "", id); err != nil {
+		return err
+	}
+
+	return repo.personDeleted(ctx, tx, id, version)
+}
+
+// MergePeople merges the person records with ids otherIDs into the person record with id survivorID,
+// and deletes them afterwards (cf. models.MergePerson)
+func (repo *repository) MergePeople(ctx context.Context, survivorID string, otherIDs []string, params models.MergeParams) (*models.Person, error) {
+	if len(otherIDs) == 0 {
+		return nil, fmt.Errorf("%w: no person records to merge", models.ErrMissingArgument)
+	}
+	if slices.Contains(otherIDs, survivorID) {
+		return nil, fmt.Errorf("%w: cannot merge person record %s into itself", models.ErrInvalidArgument, survivorID)
+	}
+
+	otherIDs = slices.Clone(otherIDs)
+	slices.Sort(otherIDs)
+	otherIDs = slices.Compact(otherIDs)
+
+	tx, err := repo.client.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// lock records in a fixed order to avoid deadlocks between concurrent merges
+	ids := append([]string{survivorID}, otherIDs...)
+	slices.Sort(ids)
+	ids = slices.Compact(ids)
+
+	var survivor *models.Person
+	others := make([]*models.Person, 0, len(ids)-1)
+	for _, id := range ids {
+		p, err := repo.getPersonForUpdate(ctx, tx, id)
+		if err != nil {
+			return nil, fmt.Errorf("cannot find person record %s to merge: %w", id, err)
+		}
+		if id == survivorID {
+			survivor = p
+		} else {
+			others = append(others, p)
+		}
+	}
+
+	merged, err := models.MergePerson(survivor, others, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// delete first, identifiers move to the surviving record
+	if _, err := tx.Exec(ctx, `DELETE FROM "people" WHERE "external_id" = ANY($1)`, otherIDs); err != nil {
+		return nil, err
+	}
+
+	if err := repo.addTombstones(ctx, tx, "person_tombstones", models.TombstoneMerged, survivorID, otherIDs...); err != nil {
+		return nil, err
+	}
+
+	for _, other := range others {
+		if err := repo.personDeleted(ctx, tx, other.ID, other.Version); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := repo.updatePerson(ctx, tx, merged); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return merged, nil
 }
 
 func (repo *repository) EachPerson(ctx context.Context, cb func(*models.Person) bool) error {
@@ -1594,126 +2117,104 @@ func (repo *repository) EachPerson(ctx context.Context, cb func(*models.Person)
 
 }
 
-func (repo *repository) SuggestPeople(ctx context.Context, params models.PersonSuggestParams) ([]*models.Person, error) {
+// SuggestPeople matches on the full text index (all terms as prefix),
+// or on the trigram similarity of query and name, which tolerates typos.
+// Results are ranked by the sum of both scores.
+func (repo *repository) SuggestPeople(ctx context.Context, params models.PersonSuggestParams) ([]*models.Person, []*models.Match, error) {
 	params = params.MergeDefault()
+	if err := params.Validate(); err != nil {
+		return nil, nil, err
+	}
 	tsQuery, tsQueryArgs := toTSQuery(params.Query)
+	if len(tsQueryArgs) == 0 {
+		return nil, nil, nil
+	}
 	sqlQuery := `
 SELECT
-	"id",
-	"date_created",
-	"date_updated",
-	"external_id",
-	"active",
-	"birth_date",
-	"email",
-	"given_name",
-	"name",
-	"family_name",
-	"job_category",
-	"preferred_given_name",
-	"preferred_family_name",
-	"honorific_prefix", 
-	"role",
-	"settings", 
-	"object_class",
-	"token",
-	"identifier",
-	ts_rank(ts, %s) AS rank
-FROM "people" WHERE ts @@ %s AND "active" = any(%s)  ORDER BY "rank" DESC LIMIT %d
+	` + personColumns + `,
+	%[5]s + word_similarity(%[2]s, f_unaccent(lower("name"))) AS rank
+FROM "people"
+WHERE (ts @@ %[1]s OR %[2]s <%% f_unaccent(lower("name"))) AND "active" = any(%[3]s)
+ORDER BY "rank" DESC LIMIT %[4]d
 `
-	tsQueryArgs = append(tsQueryArgs, params.Active)
+	rank := tsRank(tsQuery, len(tsQueryArgs))
+	args := append(append([]any{}, tsQueryArgs...), params.Query, params.Active)
 	sqlQuery = fmt.Sprintf(
 		sqlQuery,
 		tsQuery,
-		tsQuery,
-		fmt.Sprintf("$%d", len(tsQueryArgs)),
+		fmt.Sprintf("f_unaccent(lower($%d))", len(args)-1),
+		fmt.Sprintf("$%d", len(args)),
 		params.Limit,
+		rank,
 	)
-	rows, err := repo.client.Query(ctx, sqlQuery, tsQueryArgs...)
+
+	tx, err := repo.client.Begin(ctx)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := setSimilarityThreshold(ctx, tx, params.Threshold); err != nil {
+		return nil, nil, err
+	}
+
+	rows, err := tx.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, nil, err
 	}
 	defer rows.Close()
 
 	personRecs := []*person{}
+	ranks := []float64{}
 
 	for rows.Next() {
 		personRec := &person{}
 		var rank float64
-		err = rows.Scan(
-			&personRec.id,
-			&personRec.dateCreated,
-			&personRec.dateUpdated,
-			&personRec.externalID,
-			&personRec.active,
-			&personRec.birthDate,
-			&personRec.email,
-			&personRec.givenName,
-			&personRec.name,
-			&personRec.familyName,
-			&personRec.jobCategory,
-			&personRec.preferredGivenName,
-			&personRec.preferredFamilyName,
-			&personRec.honorificPrefix,
-			&personRec.role,
-			&personRec.settings,
-			&personRec.objectClass,
-			&personRec.token,
-			&personRec.identifier,
-			&rank,
-		)
+		err = rows.Scan(append(personRec.scanFields(), &rank)...)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		personRecs = append(personRecs, personRec)
+		ranks = append(ranks, rank)
 	}
 
-	if len(personRecs) == 0 {
-		return nil, nil
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
 	}
 
-	people, err := repo.unpackPeople(ctx, personRecs...)
-	if err != nil {
-		return nil, err
+	if len(personRecs) == 0 {
+		return nil, nil, nil
 	}
 
-	return people, nil
-}
-
-func (repo *repository) SetPersonRole(ctx context.Context, externalID string, roles []string) error {
-	res, err := repo.client.Exec(
-		ctx,
-		`UPDATE "people" SET date_updated = now(), role = $1 WHERE external_id = $2`,
-		pgjson(roles),
-		externalID,
-	)
+	people, err := repo.unpackPeople(ctx, tx, personRecs...)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	if res.RowsAffected() == 0 {
-		return models.ErrNotFound
+	if !params.Highlight {
+		return people, nil, nil
 	}
 
-	return nil
-}
-
-func (repo *repository) SetPersonSettings(ctx context.Context, externalID string, settings map[string]string) error {
-	res, err := repo.client.Exec(
-		ctx,
-		`UPDATE "people" SET date_updated = now(), settings = $1 WHERE external_id = $2`,
-		pgjson(settings),
-		externalID,
-	)
+	hitFieldValues := make([][]fieldValue, 0, len(people))
+	for _, p := range people {
+		hitFieldValues = append(hitFieldValues, repo.getTsFieldValuesForPerson(p))
+	}
+	matches, err := repo.getMatches(ctx, tx, tsQuery, tsQueryArgs, params.Query, hitFieldValues, ranks)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	if res.RowsAffected() == 0 {
-		return models.ErrNotFound
-	}
+	return people, matches, nil
+}
 
-	return nil
+func (repo *repository) SetPersonRole(ctx context.Context, externalID string, roles []string, version int) error {
+	p := &models.Person{}
+	p.SetRole(roles...)
+	return repo.setPersonAttribute(ctx, externalID, version, false, `"role" = $4`, pgjson(p.Role))
+}
+
+func (repo *repository) SetPersonSettings(ctx context.Context, externalID string, settings map[string]string, version int) error {
+	return repo.setPersonAttribute(ctx, externalID, version, false, `"settings" = $4`, pgjson(settings))
 }
 
 func (repo *repository) GetPeople(ctx context.Context) ([]*models.Person, string, error) {
@@ -1757,26 +2258,7 @@ func (repo *repository) getPeople(ctx context.Context, cursor setCursor) ([]*mod
 	newCursor := setCursor{}
 
 	query := `
-SELECT
-	"id",
-	"date_created",
-	"date_updated",
-	"external_id",
-	"active",
-	"birth_date",
-	"email",
-	"given_name",
-	"name",
-	"family_name",
-	"job_category",
-	"preferred_given_name",
-	"preferred_family_name",
-	"honorific_prefix", 
-	"role",
-	"settings", 
-	"object_class",
-	"token",
-	"identifier"
+SELECT ` + personColumns + `
 FROM "people" WHERE "id" > $1 ORDER BY "id" ASC LIMIT $2
 	`
 
@@ -1790,27 +2272,7 @@ FROM "people" WHERE "id" > $1 ORDER BY "id" ASC LIMIT $2
 
 	for rows.Next() {
 		personRec := &person{}
-		err = rows.Scan(
-			&personRec.id,
-			&personRec.dateCreated,
-			&personRec.dateUpdated,
-			&personRec.externalID,
-			&personRec.active,
-			&personRec.birthDate,
-			&personRec.email,
-			&personRec.givenName,
-			&personRec.name,
-			&personRec.familyName,
-			&personRec.jobCategory,
-			&personRec.preferredGivenName,
-			&personRec.preferredFamilyName,
-			&personRec.honorificPrefix,
-			&personRec.role,
-			&personRec.settings,
-			&personRec.objectClass,
-			&personRec.token,
-			&personRec.identifier,
-		)
+		err = rows.Scan(personRec.scanFields()...)
 		if err != nil {
 			return nil, newCursor, err
 		}
@@ -1821,7 +2283,7 @@ FROM "people" WHERE "id" > $1 ORDER BY "id" ASC LIMIT $2
 		return nil, newCursor, nil
 	}
 
-	people, err := repo.unpackPeople(ctx, personRecs...)
+	people, err := repo.unpackPeople(ctx, repo.client, personRecs...)
 	if err != nil {
 		return nil, newCursor, err
 	}
@@ -1865,125 +2327,119 @@ func (repo *repository) GetPersonIDActive(ctx context.Context, active bool) ([]s
 }
 
 func (repo *repository) SetPersonActive(ctx context.Context, externalID string, active bool) error {
-	_, err := repo.client.Exec(
-		ctx,
-		`UPDATE "people" SET date_updated = now(), active = $1 WHERE "external_id" = $2`,
-		active,
-		externalID,
-	)
+	return repo.SetPeopleActive(ctx, active, externalID)
+}
+
+// SetPeopleActive sets attribute active of the person records externalIDs.
+// Records that already have that value are left untouched.
+func (repo *repository) SetPeopleActive(ctx context.Context, active bool, externalIDs ...string) error {
+	tx, err := repo.client.Begin(ctx)
 	if err != nil {
 		return err
 	}
-	return nil
-}
+	defer tx.Rollback(ctx)
 
-func (repo *repository) SetPeopleActive(ctx context.Context, active bool, externalIDs ...string) error {
-	_, err := repo.client.Exec(
+	rowIDs, err := repo.touchRecords(
 		ctx,
-		`UPDATE "people" SET date_updated = now(), active = $1 WHERE "external_id" = any($2)`,
+		tx,
+		`UPDATE "people" SET "date_updated" = $1, "active" = $2, "version" = "version" + 1
+WHERE "external_id" = any($3) AND "active" <> $2
+RETURNING "id"`,
 		active,
 		externalIDs,
 	)
 	if err != nil {
 		return err
 	}
-	return nil
-}
-
-func (repo *repository) RebuildAutocompletePeople(ctx context.Context) error {
-	tx, err := repo.client.BeginTx(ctx, pgx.TxOptions{})
-	if err != nil {
-		return err
-	}
-	defer tx.Rollback(ctx)
 
-	rows, err := repo.client.Query(ctx, `SELECT "id", "name", "identifier" FROM "people"`)
-	if err != nil {
-		return err
-	}
-	for rows.Next() {
-		pRec := &person{}
-		if err = rows.Scan(&pRec.id, &pRec.name, &pRec.identifier); err != nil {
+	if len(rowIDs) > 0 {
+		people, err := repo.getPeopleByRowID(ctx, tx, rowIDs...)
+		if err != nil {
 			return err
 		}
-		p := &models.Person{Name: pRec.name.String}
-		if vals, err := fromPgTextArray(pRec.identifier); err != nil {
-			return err
-		} else {
-			for _, val := range vals {
-				urn, err := models.ParseURN(val)
-				if err != nil {
-					return err
-				}
-				p.AddIdentifier(urn)
-			}
+		eventType := models.EventPersonDeactivated
+		if active {
+			eventType = models.EventPersonActivated
 		}
-		_, err = tx.Exec(ctx, `UPDATE "people" SET "ts_vals" = $1 WHERE "id" = $2`, pgjson(repo.getTsValsForPerson(p)), pRec.id)
-		if err != nil {
-			return err
+		for _, p := range people {
+			if err := repo.personChanged(ctx, tx, eventType, p); err != nil {
+				return err
+			}
 		}
 	}
 
-	if err = rows.Err(); err != nil {
-		return err
+	return tx.Commit(ctx)
+}
+
+// touchRecords executes an update query that takes the current time as first argument
+// and returns the "id" of every updated row
+func (repo *repository) touchRecords(ctx context.Context, tx pgx.Tx, query string, args ...any) ([]int, error) {
+	now, err := dbNow(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := tx.Query(ctx, query, append([]any{now}, args...)...)
+	if err != nil {
+		return nil, err
 	}
+	defer rows.Close()
 
-	if err = tx.Commit(ctx); err != nil {
-		return err
+	rowIDs := []int{}
+	for rows.Next() {
+		var rowID int
+		if err := rows.Scan(&rowID); err != nil {
+			return nil, err
+		}
+		rowIDs = append(rowIDs, rowID)
 	}
 
-	return nil
+	return rowIDs, rows.Err()
 }
 
-func (repo *repository) RebuildAutocompleteOrganizations(ctx context.Context) error {
-	tx, err := repo.client.BeginTx(ctx, pgx.TxOptions{})
-	if err != nil {
-		return err
-	}
-	defer tx.Rollback(ctx)
-
-	rows, err := repo.client.Query(ctx, `SELECT "id", "name_dut", "name_eng", "acronym", "identifier" FROM "organizations"`)
+func (repo *repository) getPeopleByRowID(ctx context.Context, db querier, rowIDs ...int) ([]*models.Person, error) {
+	rows, err := db.Query(ctx, `SELECT `+personColumns+` FROM "people" WHERE "id" = any($1)`, rowIDs)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer rows.Close()
 
+	personRecs := []*person{}
 	for rows.Next() {
-		oRec := &organization{}
-		if err = rows.Scan(&oRec.id, &oRec.nameDut, &oRec.nameEng, &oRec.acronym, &oRec.identifier); err != nil {
-			return err
-		}
-		org := &models.Organization{
-			NameDut: oRec.nameDut.String,
-			NameEng: oRec.nameEng.String,
-			Acronym: oRec.acronym.String,
-		}
-		if vals, err := fromPgTextArray(oRec.identifier); err != nil {
-			return err
-		} else {
-			for _, val := range vals {
-				urn, err := models.ParseURN(val)
-				if err != nil {
-					return err
-				}
-				org.AddIdentifier(urn)
-			}
-		}
-		_, err = tx.Exec(ctx, `UPDATE "organizations" SET "ts_vals" = $1 WHERE "id" = $2`, pgjson(repo.getTsValsForOrganization(org)), oRec.id)
-		if err != nil {
-			return err
+		p := &person{}
+		if err := rows.Scan(p.scanFields()...); err != nil {
+			return nil, err
 		}
+		personRecs = append(personRecs, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
 	}
+	rows.Close()
 
-	if err = rows.Err(); err != nil {
-		return err
+	return repo.unpackPeople(ctx, db, personRecs...)
+}
+
+func (repo *repository) getOrganizationsByRowID(ctx context.Context, db querier, rowIDs ...int) ([]*models.Organization, error) {
+	rows, err := db.Query(ctx, `SELECT `+organizationColumns+` FROM "organizations" WHERE "id" = any($1)`, rowIDs)
+	if err != nil {
+		return nil, err
 	}
+	defer rows.Close()
 
-	if err = tx.Commit(ctx); err != nil {
-		return err
+	orgRecs := []*organization{}
+	for rows.Next() {
+		o := &organization{}
+		if err := rows.Scan(o.scanFields()...); err != nil {
+			return nil, err
+		}
+		orgRecs = append(orgRecs, o)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
 
-	return nil
+	return repo.unpackOrganizations(ctx, db, orgRecs...)
 }
 
 func (repo *repository) encodeCursor(c any) (string, error) {
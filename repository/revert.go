@@ -0,0 +1,439 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/ugent-library/people-service/models"
+)
+
+// restoreFunc brings record id to the state in data (the record is deleted when data is nil)
+// within tx. A merged record is only recreated with force.
+type restoreFunc func(ctx context.Context, tx pgx.Tx, id string, data json.RawMessage, force bool) (*restored, error)
+
+// restored holds snapshots of a record before and after a restoreFunc
+type restored struct {
+	before, after any
+	// changed is false when the record was already in the requested state
+	changed bool
+	// warnings about what could not be restored
+	warnings []string
+}
+
+// RevertPeople restores person records to an earlier revision (cf. models.RevertParams).
+// Every record is reverted in its own transaction.
+func (repo *repository) RevertPeople(ctx context.Context, params models.RevertParams) ([]*models.RevertResult, error) {
+	return repo.revert(ctx, params, "person_revisions", repo.restorePerson)
+}
+
+// RevertOrganizations is the organization equivalent of RevertPeople
+func (repo *repository) RevertOrganizations(ctx context.Context, params models.RevertParams) ([]*models.RevertResult, error) {
+	return repo.revert(ctx, params, "organization_revisions", repo.restoreOrganization)
+}
+
+func (repo *repository) revert(ctx context.Context, params models.RevertParams, revisionTable string, restore restoreFunc) ([]*models.RevertResult, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if params.ID != "" {
+		revisions, err := repo.getRevisions(ctx, revisionTable, params.ID)
+		if err != nil {
+			return nil, err
+		}
+		var target *models.Revision
+		for _, rev := range revisions {
+			if rev.Version == params.Version && len(rev.Data) > 0 {
+				target = rev
+			}
+		}
+		if target == nil {
+			return nil, fmt.Errorf("%w: record %s has no revision with version %d", models.ErrNotFound, params.ID, params.Version)
+		}
+		res := repo.revertRecord(ctx, params.ID, target.Version, target.Data, params, restore)
+		if res.Error != nil {
+			return nil, res.Error
+		}
+		return []*models.RevertResult{res}, nil
+	}
+
+	ids, err := repo.getRevisedRecordIDs(ctx, revisionTable, params)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*models.RevertResult, 0, len(ids))
+	for _, id := range ids {
+		revisions, err := repo.getRevisions(ctx, revisionTable, id)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, repo.revertRevisions(ctx, id, revisions, params, restore))
+	}
+
+	return results, nil
+}
+
+// revertRevisions reverts record id to the state before its first revision by params.Actor in the time window
+func (repo *repository) revertRevisions(ctx context.Context, id string, revisions []*models.Revision, params models.RevertParams, restore restoreFunc) *models.RevertResult {
+	inWindow := func(rev *models.Revision) bool {
+		return !rev.DateCreated.Before(params.From) && rev.DateCreated.Before(params.Until) &&
+			rev.Actor.Source == params.Actor.Source &&
+			(params.Actor.ID == "" || rev.Actor.ID == params.Actor.ID)
+	}
+
+	first := -1
+	for i, rev := range revisions {
+		if inWindow(rev) {
+			first = i
+			break
+		}
+	}
+	if first < 0 {
+		return &models.RevertResult{ID: id, Status: models.RevertUnchanged}
+	}
+
+	if !params.Force {
+		for _, rev := range revisions[first+1:] {
+			if !inWindow(rev) {
+				return &models.RevertResult{
+					ID:     id,
+					Status: models.RevertSkipped,
+					Error:  fmt.Errorf("record was changed by %s %s at %s", rev.Actor.Source, rev.Actor.ID, rev.DateCreated.Format(time.RFC3339)),
+				}
+			}
+		}
+	}
+
+	if first == 0 {
+		if !strings.HasSuffix(revisions[0].Type, ".created") {
+			return &models.RevertResult{
+				ID:     id,
+				Status: models.RevertError,
+				Error:  fmt.Errorf("%w: no revision of record %s before %s", models.ErrNotFound, id, revisions[0].DateCreated.Format(time.RFC3339)),
+			}
+		}
+		// record did not exist before
+		return repo.revertRecord(ctx, id, 0, nil, params, restore)
+	}
+
+	target := revisions[first-1]
+	return repo.revertRecord(ctx, id, target.Version, target.Data, params, restore)
+}
+
+func (repo *repository) revertRecord(ctx context.Context, id string, version int, data json.RawMessage, params models.RevertParams, restore restoreFunc) *models.RevertResult {
+	res := &models.RevertResult{ID: id, Version: version}
+
+	err := func() error {
+		tx, err := repo.client.Begin(ctx)
+		if err != nil {
+			return err
+		}
+		defer tx.Rollback(ctx)
+
+		r, err := restore(ctx, tx, id, data, params.Force)
+		if err != nil {
+			return err
+		}
+		if !r.changed {
+			res.Status = models.RevertUnchanged
+			return nil
+		}
+		res.Warnings = r.warnings
+
+		beforeRev, afterRev := &models.Revision{}, &models.Revision{}
+		if r.before != nil {
+			if beforeRev.Data, err = json.Marshal(r.before); err != nil {
+				return err
+			}
+		}
+		if r.after != nil {
+			if afterRev.Data, err = json.Marshal(r.after); err != nil {
+				return err
+			}
+		}
+		if res.Changes, err = models.DiffRevisions(beforeRev, afterRev); err != nil {
+			return err
+		}
+		res.Status = models.RevertReverted
+
+		if params.DryRun {
+			return nil
+		}
+		return tx.Commit(ctx)
+	}()
+	if err != nil {
+		res.Status = models.RevertError
+		res.Error = err
+	}
+
+	return res
+}
+
+// getRevisedRecordIDs returns the ids of all records that params.Actor changed in the time window
+func (repo *repository) getRevisedRecordIDs(ctx context.Context, revisionTable string, params models.RevertParams) ([]string, error) {
+	rows, err := repo.client.Query(
+		ctx,
+		`SELECT "external_id" FROM `+pgx.Identifier{revisionTable}.Sanitize()+`
+WHERE "actor_source" = $1 AND ($2 = '' OR "actor_id" = $2) AND "date_created" >= $3 AND "date_created" < $4
+GROUP BY "external_id"
+ORDER BY MIN("id")`,
+		params.Actor.Source,
+		params.Actor.ID,
+		params.From,
+		params.Until,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// checkRecreate refuses to recreate record id when it was merged into another record,
+// because the survivor still holds the identifiers of id. With force, it is recreated anyway
+// and a warning is returned.
+func (repo *repository) checkRecreate(ctx context.Context, tx pgx.Tx, tombstoneTable string, id string, force bool) ([]string, error) {
+	tombstones, err := repo.getTombstones(ctx, tx, tombstoneTable, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(tombstones) == 0 || tombstones[0].Reason != models.TombstoneMerged {
+		return nil, nil
+	}
+	msg := fmt.Sprintf("record was merged into %s, which still holds its identifiers", tombstones[0].SuccessorID)
+	if !force {
+		return nil, fmt.Errorf("%w: %s, use force to restore it anyway", models.ErrConflict, msg)
+	}
+	return []string{msg}, nil
+}
+
+func tokenKeys(token map[string]string) []string {
+	keys := make([]string, 0, len(token))
+	for key := range token {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// latestVersion returns the highest version recorded for record id
+func (repo *repository) latestVersion(ctx context.Context, tx pgx.Tx, revisionTable string, id string) (int, error) {
+	var version int
+	err := tx.QueryRow(
+		ctx,
+		`SELECT COALESCE(MAX("version"), 0) FROM `+pgx.Identifier{revisionTable}.Sanitize()+` WHERE "external_id" = $1`,
+		id,
+	).Scan(&version)
+	return version, err
+}
+
+func (repo *repository) restorePerson(ctx context.Context, tx pgx.Tx, id string, data json.RawMessage, force bool) (*restored, error) {
+	current, err := repo.getPersonForUpdate(ctx, tx, id)
+	if err != nil && !errors.Is(err, models.ErrNotFound) {
+		return nil, err
+	}
+
+	var before any
+	if current != nil {
+		before = personSnapshot(current)
+	}
+
+	if data == nil {
+		if current == nil {
+			return &restored{}, nil
+		}
+		var warnings []string
+		if len(current.Token) > 0 {
+			warnings = append(warnings, fmt.Sprintf("tokens %s are deleted with the record, and are lost when it is restored later", strings.Join(tokenKeys(current.Token), ", ")))
+		}
+		if err := repo.deletePerson(ctx, tx, id); err != nil {
+			return nil, err
+		}
+		return &restored{before: before, changed: true, warnings: warnings}, nil
+	}
+
+	target := &models.Person{}
+	if err := json.Unmarshal(data, target); err != nil {
+		return nil, err
+	}
+	target.ID = id
+
+	// record was deleted or merged: recreate it under the same id
+	if current == nil {
+		warnings, err := repo.checkRecreate(ctx, tx, "person_tombstones", id, force)
+		if err != nil {
+			return nil, err
+		}
+		version, err := repo.latestVersion(ctx, tx, "person_revisions", id)
+		if err != nil {
+			return nil, err
+		}
+		now, err := dbNow(ctx, tx)
+		if err != nil {
+			return nil, err
+		}
+		target.DateUpdated = &now
+		if target.DateCreated == nil {
+			target.DateCreated = &now
+		}
+		target.Version = version + 1
+		// tokens are not kept in revisions
+		target.Token = map[string]string{}
+		warnings = append(warnings, "tokens are not kept in revisions, the record is restored without tokens")
+		if _, err := tx.Exec(ctx, `DELETE FROM "person_tombstones" WHERE "external_id" = $1`, id); err != nil {
+			return nil, err
+		}
+		if err := repo.insertPerson(ctx, tx, target); err != nil {
+			return nil, err
+		}
+		return &restored{after: personSnapshot(target), changed: true, warnings: warnings}, nil
+	}
+
+	target.DateCreated = current.DateCreated
+	target.DateUpdated = current.DateUpdated
+	target.Version = current.Version
+	// tokens are not kept in revisions
+	target.Token = current.Token
+	for _, orgMember := range target.Organization {
+		orgMember.DateCreated = nil
+		orgMember.DateUpdated = nil
+		for _, currentOrgMember := range current.Organization {
+			if currentOrgMember.ID == orgMember.ID {
+				orgMember.DateCreated = currentOrgMember.DateCreated
+				orgMember.DateUpdated = currentOrgMember.DateUpdated
+				break
+			}
+		}
+	}
+
+	if reflect.DeepEqual(normalizePerson(current), normalizePerson(target)) {
+		return &restored{before: before, after: before}, nil
+	}
+
+	if err := repo.updatePerson(ctx, tx, target); err != nil {
+		return nil, err
+	}
+
+	return &restored{before: before, after: personSnapshot(target), changed: true}, nil
+}
+
+func (repo *repository) restoreOrganization(ctx context.Context, tx pgx.Tx, id string, data json.RawMessage, force bool) (*restored, error) {
+	current, err := repo.getOrganizationForUpdate(ctx, tx, id)
+	if err != nil && !errors.Is(err, models.ErrNotFound) {
+		return nil, err
+	}
+
+	var before any
+	if current != nil {
+		before = current.Dup()
+	}
+
+	if data == nil {
+		if current == nil {
+			return &restored{}, nil
+		}
+		// never take members or child organizations along
+		if err := repo.deleteOrganization(ctx, tx, id, false); err != nil {
+			return nil, err
+		}
+		return &restored{before: before, changed: true}, nil
+	}
+
+	target := &models.Organization{}
+	if err := json.Unmarshal(data, target); err != nil {
+		return nil, err
+	}
+	target.ID = id
+
+	// record was deleted: recreate it under the same id
+	if current == nil {
+		warnings, err := repo.checkRecreate(ctx, tx, "organization_tombstones", id, force)
+		if err != nil {
+			return nil, err
+		}
+		version, err := repo.latestVersion(ctx, tx, "organization_revisions", id)
+		if err != nil {
+			return nil, err
+		}
+		now, err := dbNow(ctx, tx)
+		if err != nil {
+			return nil, err
+		}
+		target.DateUpdated = &now
+		if target.DateCreated == nil {
+			target.DateCreated = &now
+		}
+		target.Version = version + 1
+		for _, parent := range target.Parent {
+			parent.DateCreated = &now
+			parent.DateUpdated = &now
+		}
+		for _, successor := range target.Successor {
+			successor.DateCreated = &now
+			successor.DateUpdated = &now
+		}
+		if _, err := tx.Exec(ctx, `DELETE FROM "organization_tombstones" WHERE "external_id" = $1`, id); err != nil {
+			return nil, err
+		}
+		if err := repo.insertOrganization(ctx, tx, target); err != nil {
+			return nil, err
+		}
+		return &restored{after: target.Dup(), changed: true, warnings: warnings}, nil
+	}
+
+	target.DateCreated = current.DateCreated
+	target.DateUpdated = current.DateUpdated
+	target.Version = current.Version
+	for _, parent := range target.Parent {
+		parent.DateCreated = nil
+		parent.DateUpdated = nil
+		for _, currentParent := range current.Parent {
+			if currentParent.ID == parent.ID && currentParent.From.Equal(*parent.From) {
+				parent.DateCreated = currentParent.DateCreated
+				parent.DateUpdated = currentParent.DateUpdated
+				break
+			}
+		}
+	}
+	for _, successor := range target.Successor {
+		successor.DateCreated = nil
+		successor.DateUpdated = nil
+		for _, currentSuccessor := range current.Successor {
+			if currentSuccessor.ID == successor.ID && successor.Date != nil && currentSuccessor.Date.Equal(*successor.Date) {
+				successor.DateCreated = currentSuccessor.DateCreated
+				successor.DateUpdated = currentSuccessor.DateUpdated
+				break
+			}
+		}
+	}
+	// predecessors are read only
+	target.Predecessor = current.Predecessor
+
+	if reflect.DeepEqual(normalizeOrganization(current), normalizeOrganization(target)) {
+		return &restored{before: before, after: before}, nil
+	}
+
+	if err := repo.updateOrganization(ctx, tx, target); err != nil {
+		return nil, err
+	}
+
+	return &restored{before: before, after: target.Dup(), changed: true}, nil
+}
@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ugent-library/people-service/models"
+)
+
+func TestRevertDeletedPerson(t *testing.T) {
+	repo := newTestRepository(t)
+	ctx := testContext()
+
+	p, err := repo.CreatePerson(ctx, newTestPerson("Jane Doe"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.DeletePerson(ctx, p.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := repo.RevertPeople(ctx, models.RevertParams{ID: p.ID, Version: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(results) != 1 || results[0].Status != models.RevertReverted {
+		t.Fatalf("got %+v, want a reverted record", results)
+	}
+
+	restored, err := repo.GetPerson(ctx, p.ID)
+	if err != nil {
+		t.Fatalf("restored record not found: %s", err)
+	}
+	if restored.Name != p.Name {
+		t.Errorf("got name %q, want %q", restored.Name, p.Name)
+	}
+	if restored.Version <= p.Version {
+		t.Errorf("got version %d, want a version after %d", restored.Version, p.Version)
+	}
+	tombstones, err := repo.GetPersonTombstones(ctx, p.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tombstones) != 0 {
+		t.Errorf("got %d tombstones for a restored record, want 0", len(tombstones))
+	}
+}
+
+func TestRevertMergedPerson(t *testing.T) {
+	repo := newTestRepository(t)
+	ctx := testContext()
+
+	survivor, err := repo.CreatePerson(ctx, newTestPerson("Jane Doe"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	merged, err := repo.CreatePerson(ctx, newTestPerson("J. Doe"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.MergePeople(ctx, survivor.ID, []string{merged.ID}, models.MergeParams{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the survivor holds the identifiers of the merged record
+	_, err = repo.RevertPeople(ctx, models.RevertParams{ID: merged.ID, Version: 1})
+	if !errors.Is(err, models.ErrConflict) {
+		t.Fatalf("got error %v, want %v", err, models.ErrConflict)
+	}
+	if _, err := repo.GetPerson(ctx, merged.ID); !errors.Is(err, models.ErrNotFound) {
+		t.Fatalf("merged record was recreated without force")
+	}
+
+	results, err := repo.RevertPeople(ctx, models.RevertParams{ID: merged.ID, Version: 1, Force: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(results) != 1 || results[0].Status != models.RevertReverted {
+		t.Fatalf("got %+v, want a reverted record", results)
+	}
+	warned := false
+	for _, w := range results[0].Warnings {
+		if strings.Contains(w, survivor.ID) {
+			warned = true
+		}
+	}
+	if !warned {
+		t.Errorf("got warnings %v, want a warning about survivor %s", results[0].Warnings, survivor.ID)
+	}
+	if _, err := repo.GetPerson(ctx, merged.ID); err != nil {
+		t.Fatalf("merged record was not recreated: %s", err)
+	}
+}
+
+func TestRevertPeopleByActor(t *testing.T) {
+	repo := newTestRepository(t)
+	ctx := testContext()
+
+	keep, err := repo.CreatePerson(ctx, newTestPerson("Jane Doe"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	from := time.Now().Add(-time.Minute)
+	actor := models.Actor{Source: models.ActorSourceLdapSync, ID: "run-1"}
+	syncCtx := models.WithActor(ctx, actor)
+
+	created, err := repo.CreatePerson(syncCtx, newTestPerson("John Doe"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	keep.Name = "Jane Roe"
+	if _, err := repo.UpdatePerson(syncCtx, keep); err != nil {
+		t.Fatal(err)
+	}
+
+	params := models.RevertParams{Actor: actor, From: from, Until: time.Now().Add(time.Minute)}
+
+	dryRun := params
+	dryRun.DryRun = true
+	results, err := repo.RevertPeople(ctx, dryRun)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want 2", len(results))
+	}
+	if p, err := repo.GetPerson(ctx, keep.ID); err != nil || p.Name != "Jane Roe" {
+		t.Fatalf("dry run changed record %s", keep.ID)
+	}
+
+	results, err = repo.RevertPeople(ctx, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, res := range results {
+		if res.Status != models.RevertReverted {
+			t.Errorf("got status %q for %s, want %q", res.Status, res.ID, models.RevertReverted)
+		}
+	}
+
+	// a record created in the window is deleted, an updated one gets its old state back
+	if _, err := repo.GetPerson(ctx, created.ID); !errors.Is(err, models.ErrNotFound) {
+		t.Errorf("record %s created in the window still exists", created.ID)
+	}
+	p, err := repo.GetPerson(ctx, keep.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Name != "Jane Doe" {
+		t.Errorf("got name %q, want %q", p.Name, "Jane Doe")
+	}
+}
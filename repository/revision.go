@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/ugent-library/people-service/models"
+)
+
+// personChanged records a change of p, made in transaction db:
+// a revision with a snapshot of p, and an event
+func (repo *repository) personChanged(ctx context.Context, db querier, typ string, p *models.Person) error {
+	data := personSnapshot(p)
+
+	if err := repo.addRevision(ctx, db, "person_revisions", typ, p.ID, p.Version, data); err != nil {
+		return err
+	}
+	return repo.addEvent(ctx, db, typ, p.ID, data)
+}
+
+// personSnapshot returns a copy of p without tokens: never store or publish secrets
+func personSnapshot(p *models.Person) *models.Person {
+	data := p.Dup()
+	data.Token = nil
+	return data
+}
+
+// organizationChanged is the organization equivalent of personChanged
+func (repo *repository) organizationChanged(ctx context.Context, db querier, typ string, org *models.Organization) error {
+	if err := repo.addRevision(ctx, db, "organization_revisions", typ, org.ID, org.Version, org); err != nil {
+		return err
+	}
+	return repo.addEvent(ctx, db, typ, org.ID, org)
+}
+
+// personDeleted records the deletion of person record id, that had version version.
+// Its tombstone must be added first.
+func (repo *repository) personDeleted(ctx context.Context, db querier, id string, version int) error {
+	return repo.recordDeleted(ctx, db, models.EventPersonDeleted, "person_tombstones", "person_revisions", id, version)
+}
+
+// organizationDeleted is the organization equivalent of personDeleted
+func (repo *repository) organizationDeleted(ctx context.Context, db querier, id string, version int) error {
+	return repo.recordDeleted(ctx, db, models.EventOrganizationDeleted, "organization_tombstones", "organization_revisions", id, version)
+}
+
+func (repo *repository) recordDeleted(ctx context.Context, db querier, typ, tombstoneTable, revisionTable, id string, version int) error {
+	if err := repo.addRevision(ctx, db, revisionTable, typ, id, version, nil); err != nil {
+		return err
+	}
+	tombstones, err := repo.getTombstones(ctx, db, tombstoneTable, id)
+	if err != nil {
+		return err
+	}
+	for _, tombstone := range tombstones {
+		if err := repo.addEvent(ctx, db, typ, id, tombstone); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (repo *repository) addRevision(ctx context.Context, db querier, table, typ, id string, version int, data any) error {
+	var rawData []byte
+	if data != nil {
+		d, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+		rawData = d
+	}
+
+	actor := models.ActorFromContext(ctx)
+	var actorID *string
+	if actor.ID != "" {
+		actorID = &actor.ID
+	}
+
+	_, err := db.Exec(
+		ctx,
+		`INSERT INTO `+pgx.Identifier{table}.Sanitize()+`
+	("external_id", "version", "date_created", "type", "actor_source", "actor_id", "data")
+VALUES ($1, $2, now(), $3, $4, $5, $6)`,
+		id,
+		version,
+		typ,
+		actor.Source,
+		actorID,
+		rawData,
+	)
+
+	return err
+}
+
+func (repo *repository) getRevisions(ctx context.Context, table string, id string) ([]*models.Revision, error) {
+	rows, err := repo.client.Query(
+		ctx,
+		`SELECT "external_id", "version", "date_created", "type", "actor_source", "actor_id", "data"
+FROM `+pgx.Identifier{table}.Sanitize()+`
+WHERE "external_id" = $1
+ORDER BY "id"`,
+		id,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	revisions := []*models.Revision{}
+	for rows.Next() {
+		var (
+			rev         models.Revision
+			dateCreated time.Time
+			actorID     *string
+		)
+		if err := rows.Scan(&rev.RecordID, &rev.Version, &dateCreated, &rev.Type, &rev.Actor.Source, &actorID, &rev.Data); err != nil {
+			return nil, err
+		}
+		rev.DateCreated = &dateCreated
+		if actorID != nil {
+			rev.Actor.ID = *actorID
+		}
+		revisions = append(revisions, &rev)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return revisions, nil
+}
+
+func (repo *repository) GetPersonRevisions(ctx context.Context, id string) ([]*models.Revision, error) {
+	return repo.getRevisions(ctx, "person_revisions", id)
+}
+
+func (repo *repository) GetOrganizationRevisions(ctx context.Context, id string) ([]*models.Revision, error) {
+	return repo.getRevisions(ctx, "organization_revisions", id)
+}
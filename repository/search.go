@@ -0,0 +1,410 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ugent-library/people-service/models"
+)
+
+// sqlFilter collects the conditions and positional arguments of a WHERE clause
+type sqlFilter struct {
+	conditions []string
+	args       []any
+}
+
+// arg adds a positional argument and returns its placeholder
+func (f *sqlFilter) arg(val any) string {
+	f.args = append(f.args, val)
+	return fmt.Sprintf("$%d", len(f.args))
+}
+
+func (f *sqlFilter) where(cond string) {
+	f.conditions = append(f.conditions, cond)
+}
+
+func (f *sqlFilter) String() string {
+	if len(f.conditions) == 0 {
+		return "true"
+	}
+	return strings.Join(f.conditions, " AND ")
+}
+
+// sortFacets puts the most frequent values of every facet first, and keeps the first limit values
+func sortFacets(facets map[string][]*models.FacetValue, limit uint32) {
+	for facet, values := range facets {
+		sort.Slice(values, func(i, j int) bool {
+			if values[i].Count != values[j].Count {
+				return values[i].Count > values[j].Count
+			}
+			return values[i].Value < values[j].Value
+		})
+		if len(values) > int(limit) {
+			facets[facet] = values[:limit]
+		}
+	}
+}
+
+// searchOrderBy returns the ORDER BY clause for a sort order of models.PersonSearchParams
+// or models.OrganizationSearchParams. "id" breaks ties, so that pages are stable.
+func searchOrderBy(sort, rank, nameExpr string) string {
+	switch sort {
+	case models.SortRelevance:
+		return rank + ` DESC, "id" ASC`
+	case models.SortNameDesc:
+		return nameExpr + ` DESC NULLS LAST, "id" DESC`
+	case models.SortDateUpdated:
+		return `"date_updated" ASC, "id" ASC`
+	case models.SortDateUpdatedDesc:
+		return `"date_updated" DESC, "id" DESC`
+	default:
+		return nameExpr + ` ASC NULLS LAST, "id" ASC`
+	}
+}
+
+// jsonbArray guards against jsonb columns that hold null instead of an array
+func jsonbArray(col string) string {
+	return fmt.Sprintf(`(CASE WHEN jsonb_typeof(%[1]s) = 'array' THEN %[1]s ELSE '[]'::jsonb END)`, col)
+}
+
+// members of the given organizations, with membership "om" condition %[2]s
+const personSearchOrganizationFilter = `"id" IN (
+	SELECT "om"."person_id" FROM "organization_members" AS "om"
+	JOIN "organizations" AS "o" ON "o"."id" = "om"."organization_id"
+	WHERE "o"."external_id" = any(%[1]s) AND %[2]s
+)`
+
+// members of the given organizations and those below them, following parent relations "op" valid at %[3]s only
+const personSearchOrganizationTreeFilter = `"id" IN (
+	SELECT "om"."person_id" FROM "organization_members" AS "om" WHERE %[2]s AND "om"."organization_id" IN (
+		WITH RECURSIVE "tree" AS (
+			SELECT "id" FROM "organizations" WHERE "external_id" = any(%[1]s)
+			UNION
+			SELECT "op"."organization_id" FROM "organization_parents" AS "op"
+			JOIN "tree" ON "op"."parent_organization_id" = "tree"."id"
+			WHERE "op"."from" <= %[3]s AND ("op"."until" IS NULL OR "op"."until" > %[3]s)
+		)
+		SELECT "id" FROM "tree"
+	)
+)`
+
+// membershipValidAt is the condition that membership "om" is valid at placeholder t
+func membershipValidAt(t string) string {
+	return fmt.Sprintf(`("om"."from" IS NULL OR "om"."from" <= %[1]s) AND ("om"."until" IS NULL OR "om"."until" > %[1]s)`, t)
+}
+
+// organizationMemberFilter returns the filter on members of orgs, or of orgs and all organizations below them.
+// With asOf, only memberships and parent relations valid at that time count.
+func organizationMemberFilter(filter *sqlFilter, orgs []string, includeDescendants bool, asOf *time.Time) string {
+	orgsArg := filter.arg(orgs)
+	membershipCond := "true"
+	parentTime := "now()"
+	if asOf != nil {
+		parentTime = filter.arg(*asOf)
+		membershipCond = membershipValidAt(parentTime)
+	}
+	if includeDescendants {
+		return fmt.Sprintf(personSearchOrganizationTreeFilter, orgsArg, membershipCond, parentTime)
+	}
+	return fmt.Sprintf(personSearchOrganizationFilter, orgsArg, membershipCond)
+}
+
+// counts of all facets over the matching records, plus the total as a row with an empty facet name
+const personSearchFacetsQuery = `
+WITH "hits" AS (
+	SELECT "id", "active", "job_category", "role", "object_class", "identifier" FROM "people" WHERE %[1]s
+)
+SELECT '', '', count(*) FROM "hits"
+UNION ALL
+SELECT 'active', "active"::text, count(*) FROM "hits" GROUP BY "active"
+UNION ALL
+SELECT 'job_category', "v", count(*) FROM "hits", jsonb_array_elements_text(%[2]s) AS "v" GROUP BY "v"
+UNION ALL
+SELECT 'role', "v", count(*) FROM "hits", jsonb_array_elements_text(%[3]s) AS "v" GROUP BY "v"
+UNION ALL
+SELECT 'object_class', "v", count(*) FROM "hits", jsonb_array_elements_text(%[4]s) AS "v" GROUP BY "v"
+UNION ALL
+SELECT 'identifier_namespace', split_part("v", ':', 2), count(DISTINCT "hits"."id")
+FROM "hits", jsonb_array_elements_text(%[5]s) AS "v" GROUP BY 2
+UNION ALL
+SELECT 'organization', "o"."external_id", count(DISTINCT "om"."person_id") FROM "hits"
+JOIN "organization_members" AS "om" ON "om"."person_id" = "hits"."id" AND %[6]s
+JOIN "organizations" AS "o" ON "o"."id" = "om"."organization_id"
+GROUP BY "o"."external_id"
+`
+
+func (repo *repository) SearchPeople(ctx context.Context, params models.PersonSearchParams) (*models.PersonSearchResult, error) {
+	params = params.MergeDefault()
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	filter := &sqlFilter{}
+	var tsQuery, rank string
+	if params.Query != "" {
+		// toTSQuery numbers its arguments from $1
+		tsQuery, filter.args = toTSQuery(params.Query)
+		if len(filter.args) == 0 {
+			return &models.PersonSearchResult{
+				Offset: params.Offset,
+				Limit:  params.Limit,
+				Hits:   []*models.Person{},
+				Facets: map[string][]*models.FacetValue{},
+			}, nil
+		}
+		filter.where(`"ts" @@ ` + tsQuery)
+		rank = tsRank(tsQuery, len(filter.args))
+	}
+	filter.where(`"active" = any(` + filter.arg(params.Active) + `)`)
+	if len(params.Organization) > 0 {
+		filter.where(organizationMemberFilter(filter, params.Organization, params.IncludeSubOrganizations, params.AsOf))
+	}
+	if len(params.JobCategory) > 0 {
+		filter.where(`"job_category" ?| ` + filter.arg(params.JobCategory))
+	}
+	if len(params.Role) > 0 {
+		filter.where(`"role" ?| ` + filter.arg(params.Role))
+	}
+	if len(params.ObjectClass) > 0 {
+		filter.where(`"object_class" ?| ` + filter.arg(params.ObjectClass))
+	}
+	if len(params.IdentifierNamespace) > 0 {
+		filter.where(fmt.Sprintf(
+			`EXISTS (SELECT 1 FROM jsonb_array_elements_text(%s) AS "i" WHERE split_part("i", ':', 2) = any(%s))`,
+			jsonbArray(`"identifier"`),
+			filter.arg(params.IdentifierNamespace),
+		))
+	}
+
+	// the organization facet counts memberships valid at params.AsOf only (all if params.Historic).
+	// The hits query has no use for that argument, so it is only passed to the facets query.
+	facetArgs := filter.args
+	membershipCond := "true"
+	if params.AsOf != nil {
+		facetArgs = append(slices.Clip(filter.args), *params.AsOf)
+		membershipCond = membershipValidAt(fmt.Sprintf("$%d", len(facetArgs)))
+	}
+
+	res := &models.PersonSearchResult{
+		Offset: params.Offset,
+		Limit:  params.Limit,
+		Facets: map[string][]*models.FacetValue{
+			models.FacetOrganization:        {},
+			models.FacetJobCategory:         {},
+			models.FacetRole:                {},
+			models.FacetObjectClass:         {},
+			models.FacetIdentifierNamespace: {},
+			models.FacetActive:              {},
+		},
+	}
+
+	// total and facets
+	facetsQuery := fmt.Sprintf(
+		personSearchFacetsQuery,
+		filter.String(),
+		jsonbArray(`"job_category"`),
+		jsonbArray(`"role"`),
+		jsonbArray(`"object_class"`),
+		jsonbArray(`"identifier"`),
+		membershipCond,
+	)
+	rows, err := repo.client.Query(ctx, facetsQuery, facetArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var facet string
+		fv := &models.FacetValue{}
+		if err := rows.Scan(&facet, &fv.Value, &fv.Count); err != nil {
+			return nil, err
+		}
+		if facet == "" {
+			res.Total = fv.Count
+			continue
+		}
+		res.Facets[facet] = append(res.Facets[facet], fv)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	sortFacets(res.Facets, params.FacetLimit)
+
+	// hits
+	hitsQuery := fmt.Sprintf(
+		`SELECT %s FROM "people" WHERE %s ORDER BY %s OFFSET %d LIMIT %d`,
+		personColumns,
+		filter.String(),
+		searchOrderBy(params.Sort, rank, `"name"`),
+		params.Offset,
+		params.Limit,
+	)
+	rows, err = repo.client.Query(ctx, hitsQuery, filter.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	personRecs := []*person{}
+	for rows.Next() {
+		personRec := &person{}
+		if err := rows.Scan(personRec.scanFields()...); err != nil {
+			return nil, err
+		}
+		personRecs = append(personRecs, personRec)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if res.Hits, err = repo.unpackPeople(ctx, repo.client, personRecs...); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// organizations below the given ones
+const organizationSearchAncestorFilter = `"id" IN (
+	WITH RECURSIVE "tree" AS (
+		SELECT "op"."organization_id" AS "id" FROM "organization_parents" AS "op"
+		JOIN "organizations" AS "o" ON "o"."id" = "op"."parent_organization_id"
+		WHERE "o"."external_id" = any(%[1]s) AND %[2]s
+		UNION
+		SELECT "op"."organization_id" FROM "organization_parents" AS "op"
+		JOIN "tree" ON "op"."parent_organization_id" = "tree"."id"
+		WHERE %[2]s
+	)
+	SELECT "id" FROM "tree"
+)`
+
+const organizationParentCurrent = `"op"."from" <= now() AND ("op"."until" IS NULL OR "op"."until" > now())`
+
+// organizations without parents or with a current parent
+const organizationSearchCurrentFilter = `(
+	NOT EXISTS (SELECT 1 FROM "organization_parents" AS "op" WHERE "op"."organization_id" = "organizations"."id")
+	OR EXISTS (SELECT 1 FROM "organization_parents" AS "op" WHERE "op"."organization_id" = "organizations"."id" AND ` + organizationParentCurrent + `)
+)`
+
+// organizations that are not dissolved now
+const organizationNotDissolvedFilter = `("dissolved" IS NULL OR "dissolved" > now())`
+
+// counts of all facets over the matching records, plus the total as a row with an empty facet name
+const organizationSearchFacetsQuery = `
+WITH "hits" AS (
+	SELECT "id", "type" FROM "organizations" WHERE %s
+)
+SELECT '', '', count(*) FROM "hits"
+UNION ALL
+SELECT 'type', "type", count(*) FROM "hits" GROUP BY "type"
+`
+
+func (repo *repository) SearchOrganizations(ctx context.Context, params models.OrganizationSearchParams) (*models.OrganizationSearchResult, error) {
+	params = params.MergeDefault()
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	res := &models.OrganizationSearchResult{
+		Offset: params.Offset,
+		Limit:  params.Limit,
+		Hits:   []*models.Organization{},
+		Facets: map[string][]*models.FacetValue{
+			models.FacetType: {},
+		},
+	}
+
+	filter := &sqlFilter{}
+	var tsQuery, rank string
+	if params.Query != "" {
+		// toTSQuery numbers its arguments from $1
+		tsQuery, filter.args = toTSQuery(params.Query)
+		if len(filter.args) == 0 {
+			return res, nil
+		}
+		filter.where(`"ts" @@ ` + tsQuery)
+		rank = tsRank(tsQuery, len(filter.args))
+	}
+	if len(params.Type) > 0 {
+		filter.where(`"type" = any(` + filter.arg(params.Type) + `)`)
+	}
+	if len(params.Ancestor) > 0 {
+		parentCond := "true"
+		if params.Current {
+			parentCond = organizationParentCurrent
+		}
+		filter.where(fmt.Sprintf(organizationSearchAncestorFilter, filter.arg(params.Ancestor), parentCond))
+	}
+	if params.Current {
+		filter.where(organizationSearchCurrentFilter)
+	}
+	if !params.IncludeDissolved {
+		filter.where(organizationNotDissolvedFilter)
+	}
+
+	// total and facets
+	rows, err := repo.client.Query(ctx, fmt.Sprintf(organizationSearchFacetsQuery, filter.String()), filter.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var facet string
+		fv := &models.FacetValue{}
+		if err := rows.Scan(&facet, &fv.Value, &fv.Count); err != nil {
+			return nil, err
+		}
+		if facet == "" {
+			res.Total = fv.Count
+			continue
+		}
+		res.Facets[facet] = append(res.Facets[facet], fv)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	sortFacets(res.Facets, params.FacetLimit)
+
+	// hits
+	hitsQuery := fmt.Sprintf(
+		`SELECT %s FROM "organizations" WHERE %s ORDER BY %s OFFSET %d LIMIT %d`,
+		organizationColumns,
+		filter.String(),
+		searchOrderBy(params.Sort, rank, `COALESCE("name_dut", "name_eng")`),
+		params.Offset,
+		params.Limit,
+	)
+	rows, err = repo.client.Query(ctx, hitsQuery, filter.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	orgRecs := []*organization{}
+	for rows.Next() {
+		orgRec := &organization{}
+		if err := rows.Scan(orgRec.scanFields()...); err != nil {
+			return nil, err
+		}
+		orgRecs = append(orgRecs, orgRec)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if res.Hits, err = repo.unpackOrganizations(ctx, repo.client, orgRecs...); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/ugent-library/people-service/models"
+)
+
+// addTombstones records that the records ids in table (either "person_tombstones" or "organization_tombstones")
+// are gone for reason. Tombstones that point to one of ids are redirected to successorID,
+// so that a successor never has to be followed more than once.
+func (repo *repository) addTombstones(ctx context.Context, db querier, table string, reason string, successorID string, ids ...string) error {
+	var successor *string
+	if successorID != "" {
+		successor = &successorID
+	}
+
+	_, err := db.Exec(
+		ctx,
+		`INSERT INTO `+pgx.Identifier{table}.Sanitize()+` ("external_id", "date_deleted", "reason", "successor_id")
+SELECT unnest($1::text[]), now(), $2, $3
+ON CONFLICT ("external_id") DO UPDATE
+SET "date_deleted" = EXCLUDED."date_deleted", "reason" = EXCLUDED."reason", "successor_id" = EXCLUDED."successor_id"`,
+		ids,
+		reason,
+		successor,
+	)
+	if err != nil {
+		return err
+	}
+
+	if successor != nil {
+		_, err = db.Exec(
+			ctx,
+			`UPDATE `+pgx.Identifier{table}.Sanitize()+` SET "successor_id" = $1 WHERE "successor_id" = ANY($2)`,
+			successorID,
+			ids,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (repo *repository) getTombstones(ctx context.Context, db querier, table string, ids ...string) ([]*models.Tombstone, error) {
+	rows, err := db.Query(
+		ctx,
+		`SELECT "external_id", "date_deleted", "reason", "successor_id" FROM `+pgx.Identifier{table}.Sanitize()+`
+WHERE "external_id" = ANY($1)`,
+		ids,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tombstones := []*models.Tombstone{}
+	for rows.Next() {
+		var (
+			t           models.Tombstone
+			dateDeleted time.Time
+			successorID *string
+		)
+		if err := rows.Scan(&t.ID, &dateDeleted, &t.Reason, &successorID); err != nil {
+			return nil, err
+		}
+		t.DateDeleted = &dateDeleted
+		if successorID != nil {
+			t.SuccessorID = *successorID
+		}
+		tombstones = append(tombstones, &t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return tombstones, nil
+}
+
+// notFoundError returns a *models.GoneError when a tombstone is left for id,
+// and models.ErrNotFound otherwise
+func (repo *repository) notFoundError(ctx context.Context, table string, id string) error {
+	tombstones, err := repo.getTombstones(ctx, repo.client, table, id)
+	if err != nil {
+		return err
+	}
+	if len(tombstones) == 0 {
+		return models.ErrNotFound
+	}
+	return &models.GoneError{Tombstone: tombstones[0]}
+}
+
+func (repo *repository) GetPersonTombstones(ctx context.Context, ids ...string) ([]*models.Tombstone, error) {
+	return repo.getTombstones(ctx, repo.client, "person_tombstones", ids...)
+}
+
+func (repo *repository) GetOrganizationTombstones(ctx context.Context, ids ...string) ([]*models.Tombstone, error) {
+	return repo.getTombstones(ctx, repo.client, "organization_tombstones", ids...)
+}
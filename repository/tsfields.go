@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/ugent-library/people-service/models"
+)
+
+// TsField is an attribute that is indexed for autocomplete, with its tsvector weight (A, B, C or D).
+// ts_rank weighs A hits highest and D hits lowest.
+type TsField struct {
+	Name   string
+	Weight string
+}
+
+var tsWeights = []string{"A", "B", "C", "D"}
+
+// DefaultPersonTsFields favour name hits over email and identifier hits
+var DefaultPersonTsFields = []TsField{
+	{"name", "A"},
+	{"family_name", "A"},
+	{"preferred_family_name", "A"},
+	{"given_name", "B"},
+	{"preferred_given_name", "B"},
+	{"email", "C"},
+	{"identifier", "D"},
+}
+
+// DefaultOrganizationTsFields favour name and acronym hits over identifier hits
+var DefaultOrganizationTsFields = []TsField{
+	{"name_dut", "A"},
+	{"name_eng", "A"},
+	{"acronym", "A"},
+	{"identifier", "D"},
+}
+
+var personTsFieldValues = map[string]func(*models.Person) []string{
+	"name":                  func(p *models.Person) []string { return []string{p.Name} },
+	"given_name":            func(p *models.Person) []string { return []string{p.GivenName} },
+	"family_name":           func(p *models.Person) []string { return []string{p.FamilyName} },
+	"preferred_given_name":  func(p *models.Person) []string { return []string{p.PreferredGivenName} },
+	"preferred_family_name": func(p *models.Person) []string { return []string{p.PreferredFamilyName} },
+	"email":                 func(p *models.Person) []string { return []string{p.Email} },
+	"identifier":            func(p *models.Person) []string { return p.GetIdentifierValues() },
+}
+
+var organizationTsFieldValues = map[string]func(*models.Organization) []string{
+	"name_dut":   func(o *models.Organization) []string { return []string{o.NameDut} },
+	"name_eng":   func(o *models.Organization) []string { return []string{o.NameEng} },
+	"acronym":    func(o *models.Organization) []string { return []string{o.Acronym} },
+	"identifier": func(o *models.Organization) []string { return o.GetIdentifierValues() },
+}
+
+// ParsePersonTsFields parses a field list like "name:A,email:C,identifier:D"
+func ParsePersonTsFields(v string) ([]TsField, error) {
+	return parseTsFields(v, func(name string) bool {
+		_, ok := personTsFieldValues[name]
+		return ok
+	})
+}
+
+// ParseOrganizationTsFields parses a field list like "name_dut:A,acronym:A,identifier:D"
+func ParseOrganizationTsFields(v string) ([]TsField, error) {
+	return parseTsFields(v, func(name string) bool {
+		_, ok := organizationTsFieldValues[name]
+		return ok
+	})
+}
+
+func parseTsFields(v string, known func(string) bool) ([]TsField, error) {
+	fields := []TsField{}
+	for _, part := range strings.Split(v, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		name, weight, ok := strings.Cut(part, ":")
+		if !ok {
+			weight = "D"
+		}
+		name = strings.TrimSpace(name)
+		weight = strings.ToUpper(strings.TrimSpace(weight))
+		if !known(name) {
+			return nil, fmt.Errorf("unknown autocomplete field %q", name)
+		}
+		if !slices.Contains(tsWeights, weight) {
+			return nil, fmt.Errorf("invalid weight %q for autocomplete field %q: must be A, B, C or D", weight, name)
+		}
+		fields = append(fields, TsField{Name: name, Weight: weight})
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("no autocomplete fields in %q", v)
+	}
+	return fields, nil
+}
+
+// getTsValsForPerson returns the values to index per weight,
+// the generated column "ts" turns these into a weighted tsvector
+func (repo *repository) getTsValsForPerson(p *models.Person) map[string][]string {
+	tsVals := map[string][]string{}
+	for _, field := range repo.personTsFields {
+		tsVals[field.Weight] = append(tsVals[field.Weight], personTsFieldValues[field.Name](p)...)
+	}
+	return vacuumTsVals(tsVals)
+}
+
+// getTsValsForOrganization is the organization equivalent of getTsValsForPerson
+func (repo *repository) getTsValsForOrganization(org *models.Organization) map[string][]string {
+	tsVals := map[string][]string{}
+	for _, field := range repo.organizationTsFields {
+		tsVals[field.Weight] = append(tsVals[field.Weight], organizationTsFieldValues[field.Name](org)...)
+	}
+	return vacuumTsVals(tsVals)
+}
+
+func vacuumTsVals(tsVals map[string][]string) map[string][]string {
+	for weight, vals := range tsVals {
+		if vals = vacuum(vals); len(vals) > 0 {
+			tsVals[weight] = vals
+		} else {
+			delete(tsVals, weight)
+		}
+	}
+	return tsVals
+}
+
+// fieldValue is the value of an indexed attribute, cf. models.FieldMatch
+type fieldValue struct {
+	field string
+	value string
+}
+
+// getTsFieldValuesForPerson returns the non empty values of the indexed attributes of p,
+// identifiers are reported per namespace
+func (repo *repository) getTsFieldValuesForPerson(p *models.Person) []fieldValue {
+	fieldValues := []fieldValue{}
+	for _, field := range repo.personTsFields {
+		if field.Name == "identifier" {
+			for _, urn := range p.Identifier {
+				fieldValues = append(fieldValues, fieldValue{"identifier." + urn.Namespace, urn.Value})
+			}
+			continue
+		}
+		for _, val := range vacuum(personTsFieldValues[field.Name](p)) {
+			fieldValues = append(fieldValues, fieldValue{field.Name, val})
+		}
+	}
+	return fieldValues
+}
+
+// getTsFieldValuesForOrganization is the organization equivalent of getTsFieldValuesForPerson
+func (repo *repository) getTsFieldValuesForOrganization(org *models.Organization) []fieldValue {
+	fieldValues := []fieldValue{}
+	for _, field := range repo.organizationTsFields {
+		if field.Name == "identifier" {
+			for _, urn := range org.Identifier {
+				fieldValues = append(fieldValues, fieldValue{"identifier." + urn.Namespace, urn.Value})
+			}
+			continue
+		}
+		for _, val := range vacuum(organizationTsFieldValues[field.Name](org)) {
+			fieldValues = append(fieldValues, fieldValue{field.Name, val})
+		}
+	}
+	return fieldValues
+}
@@ -0,0 +1,383 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/ugent-library/people-service/models"
+)
+
+// UpsertPeople creates or updates each of people.
+// Stored records are matched on id or on identifier namespace params.MatchOn.
+// A matched record is replaced as a whole, like UpdatePerson does,
+// except that its biblio_id is kept when the new record has none.
+//
+// Without params.Transactional every record is stored in its own transaction,
+// and failures are reported per record. With params.Transactional the first failure
+// rolls back all records, which are then reported as skipped.
+// An error is only returned if the transaction itself fails.
+func (repo *repository) UpsertPeople(ctx context.Context, params models.UpsertParams, people ...*models.Person) ([]*models.UpsertResult, error) {
+	params = params.MergeDefault()
+
+	results := make([]*models.UpsertResult, 0, len(people))
+	for i, p := range people {
+		results = append(results, &models.UpsertResult{Index: i, ID: p.ID})
+	}
+
+	upsert := func(tx pgx.Tx, i int) error {
+		status, err := repo.upsertPerson(ctx, tx, params.MatchOn, people[i])
+		if err != nil {
+			// a failed create has an id that was never stored, report the given one
+			results[i].Status = models.UpsertError
+			results[i].Error = err
+			return err
+		}
+		results[i].ID = people[i].ID
+		results[i].Status = status
+		return nil
+	}
+
+	if params.Transactional {
+		failed := false
+		err := pgx.BeginFunc(ctx, repo.client, func(tx pgx.Tx) error {
+			for i := range people {
+				if err := upsert(tx, i); err != nil {
+					failed = true
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil && !failed {
+			// begin or commit failed, nothing was stored
+			return nil, err
+		}
+		if err != nil {
+			skipUpsertResults(results)
+		}
+		return results, nil
+	}
+
+	for i := range people {
+		id := people[i].ID
+		err := pgx.BeginFunc(ctx, repo.client, func(tx pgx.Tx) error {
+			return upsert(tx, i)
+		})
+		// errors of the record itself are already in results,
+		// but begin or commit can fail as well
+		if err != nil && results[i].Status != models.UpsertError {
+			results[i].ID = id
+			results[i].Status = models.UpsertError
+			results[i].Error = err
+		}
+	}
+
+	return results, nil
+}
+
+func (repo *repository) upsertPerson(ctx context.Context, tx pgx.Tx, matchOn string, p *models.Person) (string, error) {
+	var oldPerson *models.Person
+
+	if matchOn == "id" {
+		if p.ID != "" {
+			op, err := repo.getPersonForUpdate(ctx, tx, p.ID)
+			if err != nil {
+				return "", fmt.Errorf("cannot find person record %s to update: %w", p.ID, err)
+			}
+			oldPerson = op
+		}
+	} else {
+		urns := p.GetIdentifierByNS(matchOn)
+		if len(urns) == 0 {
+			return "", fmt.Errorf("%w: person record has no identifier of namespace %s", models.ErrMissingArgument, matchOn)
+		}
+		externalIDs, err := repo.getExternalIDsByIdentifier(ctx, tx, "people", urns...)
+		if err != nil {
+			return "", err
+		}
+		if len(externalIDs) > 1 {
+			return "", fmt.Errorf("%w: identifiers %v match multiple person records", models.ErrConflict, urns)
+		}
+		if len(externalIDs) == 1 {
+			op, err := repo.getPersonForUpdate(ctx, tx, externalIDs[0])
+			if err != nil {
+				return "", err
+			}
+			oldPerson = op
+		}
+	}
+
+	if oldPerson == nil {
+		if err := repo.createPerson(ctx, tx, p); err != nil {
+			return "", err
+		}
+		return models.UpsertCreated, nil
+	}
+
+	if p.Version != 0 && p.Version != oldPerson.Version {
+		return "", repo.versionError(ctx, tx, "people", oldPerson.ID, p.Version)
+	}
+	p.ID = oldPerson.ID
+	p.Version = oldPerson.Version
+	p.DateCreated = oldPerson.DateCreated
+	p.DateUpdated = oldPerson.DateUpdated
+	if len(p.GetIdentifierByNS("biblio_id")) == 0 {
+		for _, urn := range oldPerson.GetIdentifierByNS("biblio_id") {
+			p.AddIdentifier(urn.Dup())
+		}
+	}
+	for _, orgMember := range p.Organization {
+		for _, oldOrgMember := range oldPerson.Organization {
+			if oldOrgMember.ID == orgMember.ID {
+				orgMember.DateCreated = oldOrgMember.DateCreated
+				orgMember.DateUpdated = oldOrgMember.DateUpdated
+				break
+			}
+		}
+	}
+
+	if reflect.DeepEqual(normalizePerson(oldPerson), normalizePerson(p)) {
+		return models.UpsertUnchanged, nil
+	}
+
+	if err := repo.updatePerson(ctx, tx, p); err != nil {
+		return "", err
+	}
+
+	return models.UpsertUpdated, nil
+}
+
+// UpsertOrganizations creates or updates each of orgs.
+// See UpsertPeople for the meaning of params.
+// Parents can refer to organizations that precede them in orgs.
+func (repo *repository) UpsertOrganizations(ctx context.Context, params models.UpsertParams, orgs ...*models.Organization) ([]*models.UpsertResult, error) {
+	params = params.MergeDefault()
+
+	results := make([]*models.UpsertResult, 0, len(orgs))
+	for i, org := range orgs {
+		results = append(results, &models.UpsertResult{Index: i, ID: org.ID})
+	}
+
+	upsert := func(tx pgx.Tx, i int) error {
+		status, err := repo.upsertOrganization(ctx, tx, params.MatchOn, orgs[i])
+		if err != nil {
+			// a failed create has an id that was never stored, report the given one
+			results[i].Status = models.UpsertError
+			results[i].Error = err
+			return err
+		}
+		results[i].ID = orgs[i].ID
+		results[i].Status = status
+		return nil
+	}
+
+	if params.Transactional {
+		failed := false
+		err := pgx.BeginFunc(ctx, repo.client, func(tx pgx.Tx) error {
+			for i := range orgs {
+				if err := upsert(tx, i); err != nil {
+					failed = true
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil && !failed {
+			// begin or commit failed, nothing was stored
+			return nil, err
+		}
+		if err != nil {
+			skipUpsertResults(results)
+		}
+		return results, nil
+	}
+
+	for i := range orgs {
+		id := orgs[i].ID
+		err := pgx.BeginFunc(ctx, repo.client, func(tx pgx.Tx) error {
+			return upsert(tx, i)
+		})
+		// errors of the record itself are already in results,
+		// but begin or commit can fail as well
+		if err != nil && results[i].Status != models.UpsertError {
+			results[i].ID = id
+			results[i].Status = models.UpsertError
+			results[i].Error = err
+		}
+	}
+
+	return results, nil
+}
+
+func (repo *repository) upsertOrganization(ctx context.Context, tx pgx.Tx, matchOn string, org *models.Organization) (string, error) {
+	var oldOrg *models.Organization
+
+	if matchOn == "id" {
+		if org.ID != "" {
+			oo, err := repo.getOrganizationForUpdate(ctx, tx, org.ID)
+			if err != nil {
+				return "", fmt.Errorf("cannot find organization record %s to update: %w", org.ID, err)
+			}
+			oldOrg = oo
+		}
+	} else {
+		urns := org.GetIdentifierByNS(matchOn)
+		if len(urns) == 0 {
+			return "", fmt.Errorf("%w: organization record has no identifier of namespace %s", models.ErrMissingArgument, matchOn)
+		}
+		externalIDs, err := repo.getExternalIDsByIdentifier(ctx, tx, "organizations", urns...)
+		if err != nil {
+			return "", err
+		}
+		if len(externalIDs) > 1 {
+			return "", fmt.Errorf("%w: identifiers %v match multiple organization records", models.ErrConflict, urns)
+		}
+		if len(externalIDs) == 1 {
+			oo, err := repo.getOrganizationForUpdate(ctx, tx, externalIDs[0])
+			if err != nil {
+				return "", err
+			}
+			oldOrg = oo
+		}
+	}
+
+	if oldOrg == nil {
+		if err := repo.createOrganization(ctx, tx, org); err != nil {
+			return "", err
+		}
+		return models.UpsertCreated, nil
+	}
+
+	if org.Version != 0 && org.Version != oldOrg.Version {
+		return "", repo.versionError(ctx, tx, "organizations", oldOrg.ID, org.Version)
+	}
+	org.ID = oldOrg.ID
+	org.Version = oldOrg.Version
+	org.DateCreated = oldOrg.DateCreated
+	org.DateUpdated = oldOrg.DateUpdated
+	for _, parent := range org.Parent {
+		for _, oldParent := range oldOrg.Parent {
+			if oldParent.ID == parent.ID && oldParent.From.Equal(*parent.From) {
+				parent.DateCreated = oldParent.DateCreated
+				parent.DateUpdated = oldParent.DateUpdated
+				break
+			}
+		}
+	}
+	for _, successor := range org.Successor {
+		for _, oldSuccessor := range oldOrg.Successor {
+			if oldSuccessor.ID == successor.ID && successor.Date != nil && oldSuccessor.Date.Equal(*successor.Date) {
+				successor.DateCreated = oldSuccessor.DateCreated
+				successor.DateUpdated = oldSuccessor.DateUpdated
+				break
+			}
+		}
+	}
+	// predecessors are read only
+	org.Predecessor = oldOrg.Predecessor
+
+	if reflect.DeepEqual(normalizeOrganization(oldOrg), normalizeOrganization(org)) {
+		return models.UpsertUnchanged, nil
+	}
+
+	if err := repo.updateOrganization(ctx, tx, org); err != nil {
+		return "", err
+	}
+
+	return models.UpsertUpdated, nil
+}
+
+func (repo *repository) getExternalIDsByIdentifier(ctx context.Context, db querier, table string, urns ...*models.URN) ([]string, error) {
+	urnValues := make([]string, 0, len(urns))
+	for _, urn := range urns {
+		urnValues = append(urnValues, urn.String())
+	}
+
+	rows, err := db.Query(
+		ctx,
+		fmt.Sprintf(`SELECT "external_id" FROM %s WHERE "identifier" ?| $1`, pgx.Identifier{table}.Sanitize()),
+		urnValues,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	externalIDs := []string{}
+	for rows.Next() {
+		var externalID string
+		if err := rows.Scan(&externalID); err != nil {
+			return nil, err
+		}
+		externalIDs = append(externalIDs, externalID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return externalIDs, nil
+}
+
+// skipUpsertResults marks all results as skipped after a rolled back transaction,
+// except for the one that caused the rollback. Records created in that
+// transaction no longer exist, so their ids are cleared.
+func skipUpsertResults(results []*models.UpsertResult) {
+	for _, res := range results {
+		if res.Status == models.UpsertError {
+			continue
+		}
+		if res.Status == models.UpsertCreated {
+			res.ID = ""
+		}
+		res.Status = models.UpsertSkipped
+	}
+}
+
+// normalizePerson returns a copy of p in which empty lists and maps are nil,
+// so that reflect.DeepEqual ignores the difference
+func normalizePerson(p *models.Person) *models.Person {
+	p = p.Dup()
+	if len(p.Token) == 0 {
+		p.Token = nil
+	}
+	if len(p.Settings) == 0 {
+		p.Settings = nil
+	}
+	if len(p.Identifier) == 0 {
+		p.Identifier = nil
+	}
+	if len(p.Organization) == 0 {
+		p.Organization = nil
+	}
+	if len(p.JobCategory) == 0 {
+		p.JobCategory = nil
+	}
+	if len(p.Role) == 0 {
+		p.Role = nil
+	}
+	if len(p.ObjectClass) == 0 {
+		p.ObjectClass = nil
+	}
+	return p
+}
+
+// normalizeOrganization returns a copy of org in which empty lists are nil,
+// so that reflect.DeepEqual ignores the difference
+func normalizeOrganization(org *models.Organization) *models.Organization {
+	org = org.Dup()
+	if len(org.Identifier) == 0 {
+		org.Identifier = nil
+	}
+	if len(org.Parent) == 0 {
+		org.Parent = nil
+	}
+	if len(org.Successor) == 0 {
+		org.Successor = nil
+	}
+	if len(org.Predecessor) == 0 {
+		org.Predecessor = nil
+	}
+	return org
+}
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ugent-library/people-service/models"
+)
+
+func newTestPerson(name string) *models.Person {
+	p := models.NewPerson()
+	p.Name = name
+	return p
+}
+
+func TestUpsertPeopleTransactional(t *testing.T) {
+	repo := newTestRepository(t)
+	ctx := testContext()
+
+	// the second record matches no stored person, which rolls back the first
+	unknown := newTestPerson("John Doe")
+	unknown.ID = "does-not-exist"
+	results, err := repo.UpsertPeople(ctx, models.UpsertParams{Transactional: true}, newTestPerson("Jane Doe"), unknown)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want 2", len(results))
+	}
+	if results[0].Status != models.UpsertSkipped {
+		t.Errorf("got status %q for the first record, want %q", results[0].Status, models.UpsertSkipped)
+	}
+	if results[0].ID != "" {
+		t.Errorf("got id %q for a record that was rolled back", results[0].ID)
+	}
+	if results[1].Status != models.UpsertError || results[1].Error == nil {
+		t.Errorf("got status %q for the second record, want %q", results[1].Status, models.UpsertError)
+	}
+	if n := countRows(t, repo, "people"); n != 0 {
+		t.Errorf("got %d stored people, want 0", n)
+	}
+
+	// without a transaction the valid record is stored
+	results, err = repo.UpsertPeople(ctx, models.UpsertParams{}, newTestPerson("Jane Doe"), unknown)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if results[0].Status != models.UpsertCreated || results[0].ID == "" {
+		t.Errorf("got status %q and id %q for the first record, want a created record", results[0].Status, results[0].ID)
+	}
+	if results[1].Status != models.UpsertError {
+		t.Errorf("got status %q for the second record, want %q", results[1].Status, models.UpsertError)
+	}
+	if n := countRows(t, repo, "people"); n != 1 {
+		t.Errorf("got %d stored people, want 1", n)
+	}
+}
+
+func TestUpsertPeopleFailedTransaction(t *testing.T) {
+	repo := newTestRepository(t)
+
+	// a cancelled context makes begin fail before any record is tried
+	ctx, cancel := context.WithCancel(testContext())
+	cancel()
+
+	if _, err := repo.UpsertPeople(ctx, models.UpsertParams{Transactional: true}, newTestPerson("Jane Doe")); err == nil {
+		t.Error("expected an error when the transaction can't begin")
+	}
+
+	results, err := repo.UpsertPeople(ctx, models.UpsertParams{}, newTestPerson("Jane Doe"), newTestPerson("John Doe"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for _, res := range results {
+		if res.Status != models.UpsertError || res.Error == nil {
+			t.Errorf("got status %q for record %d, want %q", res.Status, res.Index, models.UpsertError)
+		}
+	}
+
+	if n := countRows(t, repo, "people"); n != 0 {
+		t.Errorf("got %d stored people, want 0", n)
+	}
+}
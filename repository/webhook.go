@@ -0,0 +1,425 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/oklog/ulid/v2"
+	"github.com/ugent-library/people-service/models"
+)
+
+const webhookSubscriptionColumns = `"id", "external_id", "date_created", "date_updated", "url", "event_type", "secret"`
+
+type webhookSubscription struct {
+	id          int
+	externalID  string
+	dateCreated time.Time
+	dateUpdated time.Time
+	url         string
+	eventType   []string
+	secret      string
+}
+
+func (s *webhookSubscription) scanFields() []any {
+	return []any{&s.id, &s.externalID, &s.dateCreated, &s.dateUpdated, &s.url, &s.eventType, &s.secret}
+}
+
+func (repo *repository) unpackWebhookSubscription(rec *webhookSubscription) (*models.WebhookSubscription, error) {
+	secret, err := decryptMessage(repo.secret, rec.secret)
+	if err != nil {
+		return nil, err
+	}
+	return &models.WebhookSubscription{
+		ID:          rec.externalID,
+		DateCreated: &rec.dateCreated,
+		DateUpdated: &rec.dateUpdated,
+		URL:         rec.url,
+		EventType:   rec.eventType,
+		Secret:      secret,
+	}, nil
+}
+
+// addWebhookDeliveries schedules the delivery of e to every subscription that wants it.
+// db must be the transaction that made the change.
+func (repo *repository) addWebhookDeliveries(ctx context.Context, db querier, e *models.Event) error {
+	payload, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.Exec(
+		ctx,
+		`INSERT INTO "webhook_deliveries"
+	("subscription_id", "event_id", "event_type", "data", "date_created", "status", "next_attempt")
+SELECT "id", $1, $2, $3, $4, $5, $4 FROM "webhook_subscriptions"
+WHERE "event_type" = '[]'::jsonb OR "event_type" ? $2`,
+		e.ID,
+		e.Type,
+		payload,
+		*e.Time,
+		models.WebhookDeliveryPending,
+	)
+
+	return err
+}
+
+func (repo *repository) CreateWebhookSubscription(ctx context.Context, sub *models.WebhookSubscription) (*models.WebhookSubscription, error) {
+	eSecret, err := encryptMessage(repo.secret, sub.Secret)
+	if err != nil {
+		return nil, err
+	}
+
+	eventType := sub.EventType
+	if eventType == nil {
+		eventType = []string{}
+	}
+
+	now := time.Now().UTC()
+	newSub := &models.WebhookSubscription{
+		ID:          ulid.Make().String(),
+		DateCreated: &now,
+		DateUpdated: &now,
+		URL:         sub.URL,
+		EventType:   eventType,
+		Secret:      sub.Secret,
+	}
+
+	_, err = repo.client.Exec(
+		ctx,
+		`INSERT INTO "webhook_subscriptions" ("external_id", "date_created", "date_updated", "url", "event_type", "secret")
+VALUES ($1, $2, $3, $4, $5, $6)`,
+		newSub.ID,
+		now,
+		now,
+		newSub.URL,
+		newSub.EventType,
+		eSecret,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSub, nil
+}
+
+func (repo *repository) GetWebhookSubscription(ctx context.Context, id string) (*models.WebhookSubscription, error) {
+	rec := &webhookSubscription{}
+	err := repo.client.QueryRow(
+		ctx,
+		`SELECT `+webhookSubscriptionColumns+` FROM "webhook_subscriptions" WHERE "external_id" = $1`,
+		id,
+	).Scan(rec.scanFields()...)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, models.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return repo.unpackWebhookSubscription(rec)
+}
+
+func (repo *repository) GetWebhookSubscriptions(ctx context.Context) ([]*models.WebhookSubscription, error) {
+	rows, err := repo.client.Query(ctx, `SELECT `+webhookSubscriptionColumns+` FROM "webhook_subscriptions" ORDER BY "id"`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	subs := []*models.WebhookSubscription{}
+	for rows.Next() {
+		rec := &webhookSubscription{}
+		if err := rows.Scan(rec.scanFields()...); err != nil {
+			return nil, err
+		}
+		sub, err := repo.unpackWebhookSubscription(rec)
+		if err != nil {
+			return nil, err
+		}
+		subs = append(subs, sub)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return subs, nil
+}
+
+// DeleteWebhookSubscription deletes the subscription, and its deliveries
+func (repo *repository) DeleteWebhookSubscription(ctx context.Context, id string) error {
+	res, err := repo.client.Exec(ctx, `DELETE FROM "webhook_subscriptions" WHERE "external_id" = $1`, id)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return models.ErrNotFound
+	}
+	return nil
+}
+
+func (repo *repository) GetWebhookDeliveries(ctx context.Context, subscriptionID string, limit int) ([]*models.WebhookDelivery, error) {
+	var subRowID int
+	err := repo.client.QueryRow(ctx, `SELECT "id" FROM "webhook_subscriptions" WHERE "external_id" = $1`, subscriptionID).Scan(&subRowID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, models.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := repo.client.Query(
+		ctx,
+		`SELECT "id", "event_id", "event_type", "data", "date_created", "status", "attempts", "next_attempt", "date_delivered"
+FROM "webhook_deliveries" WHERE "subscription_id" = $1
+ORDER BY "id" DESC
+LIMIT $2`,
+		subRowID,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	deliveries, err := scanWebhookDeliveries(rows, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range deliveries {
+		d.SubscriptionID = subscriptionID
+	}
+	if len(deliveries) == 0 {
+		return deliveries, nil
+	}
+
+	deliveryIDs := make([]int, 0, len(deliveries))
+	deliveriesByID := make(map[int]*models.WebhookDelivery, len(deliveries))
+	for _, d := range deliveries {
+		deliveryIDs = append(deliveryIDs, d.ID)
+		deliveriesByID[d.ID] = d
+	}
+
+	rows, err = repo.client.Query(
+		ctx,
+		`SELECT "delivery_id", "date_attempted", "status_code", "error", "duration_ms"
+FROM "webhook_delivery_attempts" WHERE "delivery_id" = ANY($1)
+ORDER BY "id"`,
+		deliveryIDs,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			deliveryID    int
+			dateAttempted time.Time
+			statusCode    *int
+			errMsg        *string
+			durationMs    int
+		)
+		if err := rows.Scan(&deliveryID, &dateAttempted, &statusCode, &errMsg, &durationMs); err != nil {
+			return nil, err
+		}
+		attempt := &models.WebhookAttempt{
+			DateAttempted: &dateAttempted,
+			Duration:      time.Duration(durationMs) * time.Millisecond,
+		}
+		if statusCode != nil {
+			attempt.StatusCode = *statusCode
+		}
+		if errMsg != nil {
+			attempt.Error = *errMsg
+		}
+		d := deliveriesByID[deliveryID]
+		d.AttemptLog = append(d.AttemptLog, attempt)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// scanWebhookDeliveries scans rows of delivery columns,
+// followed by the columns that extraFields returns destinations for
+func scanWebhookDeliveries(rows pgx.Rows, extraFields func(*models.WebhookDelivery) []any) ([]*models.WebhookDelivery, error) {
+	defer rows.Close()
+
+	deliveries := []*models.WebhookDelivery{}
+	for rows.Next() {
+		var (
+			d           models.WebhookDelivery
+			dateCreated time.Time
+		)
+		fields := []any{&d.ID, &d.EventID, &d.EventType, &d.Data, &dateCreated, &d.Status, &d.Attempts, &d.NextAttempt, &d.DateDelivered}
+		if extraFields != nil {
+			fields = append(fields, extraFields(&d)...)
+		}
+		if err := rows.Scan(fields...); err != nil {
+			return nil, err
+		}
+		d.DateCreated = &dateCreated
+		deliveries = append(deliveries, &d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// webhookClaimTimeout is how long a dispatcher has to attempt the deliveries it claimed.
+// Deliveries of a dispatcher that died are claimed again by another one after that.
+const webhookClaimTimeout = 15 * time.Minute
+
+// DispatchWebhookDeliveries claims a batch of due deliveries and attempts them
+// outside of any transaction, so that no rows stay locked during the requests.
+// The result of every attempt is stored in a transaction of its own.
+func (repo *repository) DispatchWebhookDeliveries(ctx context.Context, limit int, fn func(*models.WebhookSubscription, *models.WebhookDelivery) *models.WebhookAttempt) (int, error) {
+	// SKIP LOCKED lets concurrent dispatchers each claim their own batch
+	rows, err := repo.client.Query(
+		ctx,
+		`UPDATE "webhook_deliveries" SET "status" = $2, "next_attempt" = now() + $4 * interval '1 millisecond'
+WHERE "id" IN (
+	SELECT "id" FROM "webhook_deliveries"
+	WHERE "status" IN ($1, $2) AND "next_attempt" <= now()
+	ORDER BY "next_attempt", "id"
+	LIMIT $3
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING "id", "event_id", "event_type", "data", "date_created", "status", "attempts", "next_attempt", "date_delivered", "subscription_id"`,
+		models.WebhookDeliveryPending,
+		models.WebhookDeliveryInFlight,
+		limit,
+		webhookClaimTimeout.Milliseconds(),
+	)
+	if err != nil {
+		return 0, err
+	}
+	// subscription row id of every delivery
+	var subRowIDs []int
+	var subRowIDFields []*int
+	deliveries, err := scanWebhookDeliveries(rows, func(d *models.WebhookDelivery) []any {
+		subRowID := new(int)
+		subRowIDFields = append(subRowIDFields, subRowID)
+		return []any{subRowID}
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(deliveries) == 0 {
+		return 0, nil
+	}
+	for _, subRowID := range subRowIDFields {
+		subRowIDs = append(subRowIDs, *subRowID)
+	}
+	// RETURNING doesn't keep the order of the subquery, attempt the oldest deliveries first
+	sort.Sort(byDeliveryID{deliveries, subRowIDs})
+
+	subs := map[int]*models.WebhookSubscription{}
+	rows, err = repo.client.Query(ctx, `SELECT `+webhookSubscriptionColumns+` FROM "webhook_subscriptions" WHERE "id" = ANY($1)`, subRowIDs)
+	if err != nil {
+		return 0, err
+	}
+	for rows.Next() {
+		rec := &webhookSubscription{}
+		if err := rows.Scan(rec.scanFields()...); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		sub, err := repo.unpackWebhookSubscription(rec)
+		if err != nil {
+			rows.Close()
+			return 0, err
+		}
+		subs[rec.id] = sub
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	for i, d := range deliveries {
+		sub, ok := subs[subRowIDs[i]]
+		// the subscription was deleted, and its deliveries with it
+		if !ok {
+			continue
+		}
+		d.SubscriptionID = sub.ID
+		claimedUntil := *d.NextAttempt
+
+		attempt := fn(sub, d)
+		d.Attempts++
+
+		if err := repo.addWebhookAttempt(ctx, d, claimedUntil, attempt); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(deliveries), nil
+}
+
+// addWebhookAttempt stores the outcome of an attempt to deliver d.
+// The delivery itself is only updated when it wasn't claimed again in the meantime.
+func (repo *repository) addWebhookAttempt(ctx context.Context, d *models.WebhookDelivery, claimedUntil time.Time, attempt *models.WebhookAttempt) error {
+	var statusCode *int
+	if attempt.StatusCode != 0 {
+		statusCode = &attempt.StatusCode
+	}
+	var errMsg *string
+	if attempt.Error != "" {
+		errMsg = &attempt.Error
+	}
+
+	return pgx.BeginFunc(ctx, repo.client, func(tx pgx.Tx) error {
+		_, err := tx.Exec(
+			ctx,
+			`UPDATE "webhook_deliveries" SET "status" = $2, "attempts" = "attempts" + 1, "next_attempt" = $3, "date_delivered" = $4
+WHERE "id" = $1 AND "status" = $5 AND "next_attempt" = $6`,
+			d.ID,
+			d.Status,
+			d.NextAttempt,
+			d.DateDelivered,
+			models.WebhookDeliveryInFlight,
+			claimedUntil,
+		)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(
+			ctx,
+			`INSERT INTO "webhook_delivery_attempts" ("delivery_id", "date_attempted", "status_code", "error", "duration_ms")
+VALUES ($1, $2, $3, $4, $5)`,
+			d.ID,
+			attempt.DateAttempted,
+			statusCode,
+			errMsg,
+			attempt.Duration.Milliseconds(),
+		)
+		return err
+	})
+}
+
+// byDeliveryID sorts claimed deliveries and their subscription row ids together
+type byDeliveryID struct {
+	deliveries []*models.WebhookDelivery
+	subRowIDs  []int
+}
+
+func (s byDeliveryID) Len() int {
+	return len(s.deliveries)
+}
+
+func (s byDeliveryID) Less(i, j int) bool {
+	return s.deliveries[i].ID < s.deliveries[j].ID
+}
+
+func (s byDeliveryID) Swap(i, j int) {
+	s.deliveries[i], s.deliveries[j] = s.deliveries[j], s.deliveries[i]
+	s.subRowIDs[i], s.subRowIDs[j] = s.subRowIDs[j], s.subRowIDs[i]
+}
@@ -0,0 +1,36 @@
+package webhooks
+
+import (
+	"fmt"
+	"net/netip"
+	"syscall"
+)
+
+// checkAddress is a net.Dialer.Control hook that refuses connections to loopback, private,
+// link-local and other non public addresses, unless they are in one of allowed.
+// It runs after name resolution, so a public host name that resolves to an internal address is refused too.
+func checkAddress(allowed []netip.Prefix) func(string, string, syscall.RawConn) error {
+	return func(network, address string, _ syscall.RawConn) error {
+		addrPort, err := netip.ParseAddrPort(address)
+		if err != nil {
+			return fmt.Errorf("webhook address %s: %w", address, err)
+		}
+		addr := addrPort.Addr().Unmap()
+		for _, prefix := range allowed {
+			if prefix.Contains(addr) {
+				return nil
+			}
+		}
+		if !isPublicAddr(addr) {
+			return fmt.Errorf("webhook address %s is not public", address)
+		}
+		return nil
+	}
+}
+
+func isPublicAddr(addr netip.Addr) bool {
+	return addr.IsGlobalUnicast() && !addr.IsPrivate() && !sharedAddressSpace.Contains(addr)
+}
+
+// RFC 6598 carrier-grade NAT range, not covered by netip.Addr.IsPrivate
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
@@ -0,0 +1,99 @@
+package webhooks
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/ugent-library/people-service/models"
+)
+
+func TestCheckAddress(t *testing.T) {
+	tests := []struct {
+		address string
+		allowed []netip.Prefix
+		ok      bool
+	}{
+		{"93.184.216.34:443", nil, true},
+		{"[2606:2800:220:1::1]:443", nil, true},
+		{"127.0.0.1:80", nil, false},
+		{"[::1]:80", nil, false},
+		{"10.1.2.3:80", nil, false},
+		{"172.16.0.1:80", nil, false},
+		{"192.168.1.1:80", nil, false},
+		{"169.254.169.254:80", nil, false},
+		{"100.64.0.1:80", nil, false},
+		{"0.0.0.0:80", nil, false},
+		{"[::ffff:127.0.0.1]:80", nil, false},
+		{"[fd00::1]:80", nil, false},
+		{"10.1.2.3:80", []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}, true},
+		{"192.168.1.1:80", []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}, false},
+	}
+
+	for _, tt := range tests {
+		err := checkAddress(tt.allowed)("tcp", tt.address, nil)
+		if tt.ok && err != nil {
+			t.Errorf("%s: unexpected error: %s", tt.address, err)
+		}
+		if !tt.ok && err == nil {
+			t.Errorf("%s: expected an error", tt.address)
+		}
+	}
+}
+
+func TestPost(t *testing.T) {
+	ok := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer ok.Close()
+	redirect := httptest.NewServer(http.RedirectHandler(ok.URL, http.StatusFound))
+	defer redirect.Close()
+
+	loopback := []netip.Prefix{netip.MustParsePrefix("127.0.0.0/8"), netip.MustParsePrefix("::1/128")}
+	delivery := &models.WebhookDelivery{EventID: "1", EventType: "person.updated", Data: []byte(`{}`)}
+
+	tests := []struct {
+		name          string
+		url           string
+		allowNetworks []netip.Prefix
+		statusCode    int
+		isErr         bool
+	}{
+		{
+			name:  "refuses loopback",
+			url:   ok.URL,
+			isErr: true,
+		},
+		{
+			name:          "posts to allowed network",
+			url:           ok.URL,
+			allowNetworks: loopback,
+			statusCode:    http.StatusOK,
+		},
+		{
+			name:          "doesn't follow redirects",
+			url:           redirect.URL,
+			allowNetworks: loopback,
+			statusCode:    http.StatusFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDispatcher(nil, Config{AllowNetworks: tt.allowNetworks}, nil)
+			statusCode, err := d.post(context.Background(), &models.WebhookSubscription{URL: tt.url, Secret: "secret"}, delivery)
+			if tt.isErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if statusCode != tt.statusCode {
+				t.Errorf("got status %d, want %d", statusCode, tt.statusCode)
+			}
+		})
+	}
+}
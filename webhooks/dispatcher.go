@@ -0,0 +1,207 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"time"
+
+	"github.com/ugent-library/people-service/models"
+	"go.uber.org/zap"
+)
+
+// request headers of every delivery
+const (
+	HeaderEvent     = "X-People-Event"
+	HeaderDelivery  = "X-People-Delivery"
+	HeaderTimestamp = "X-People-Timestamp"
+	HeaderSignature = "X-People-Signature"
+)
+
+type Config struct {
+	// BatchSize is the maximum number of deliveries attempted at once
+	BatchSize int
+	// PollInterval is the time to wait when no delivery is due
+	PollInterval time.Duration
+	// Timeout of a single delivery request
+	Timeout time.Duration
+	// MaxAttempts after which a delivery is marked failed
+	MaxAttempts int
+	// MinBackoff is the delay after the first failed attempt. It doubles after every next failure,
+	// up to MaxBackoff
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// AllowNetworks lists networks that can be posted to even though they are not public,
+	// e.g. a private network with receivers. Loopback, private and link-local addresses are refused otherwise.
+	AllowNetworks []netip.Prefix
+}
+
+// Dispatcher POSTs pending webhook deliveries to the url of their subscription.
+// A delivery succeeds on a 2xx response. Redirects are not followed. Failed deliveries are retried with exponential backoff.
+type Dispatcher struct {
+	repository models.Repository
+	client     *http.Client
+	config     Config
+	logger     *zap.SugaredLogger
+}
+
+func NewDispatcher(repo models.Repository, config Config, l *zap.SugaredLogger) *Dispatcher {
+	if config.BatchSize <= 0 {
+		config.BatchSize = 20
+	}
+	if config.PollInterval <= 0 {
+		config.PollInterval = time.Second
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = 10 * time.Second
+	}
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = 10
+	}
+	if config.MinBackoff <= 0 {
+		config.MinBackoff = 30 * time.Second
+	}
+	if config.MaxBackoff <= 0 {
+		config.MaxBackoff = 6 * time.Hour
+	}
+	// subscription urls come from api clients: don't let them reach internal hosts,
+	// directly, through a redirect or through a proxy
+	dialer := &net.Dialer{Control: checkAddress(config.AllowNetworks)}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	client := &http.Client{
+		Transport: transport,
+		Timeout:   config.Timeout,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	return &Dispatcher{
+		repository: repo,
+		client:     client,
+		config:     config,
+		logger:     l,
+	}
+}
+
+// Sign returns the value of header X-People-Signature: the hex encoded HMAC-SHA256,
+// keyed with the subscription secret, of the timestamp, a dot and the request body.
+// Receivers should compute the same value, compare it in constant time,
+// and reject requests with an old timestamp.
+func Sign(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Run dispatches deliveries until ctx is cancelled
+func (d *Dispatcher) Run(ctx context.Context) error {
+	for {
+		n, err := d.repository.DispatchWebhookDeliveries(ctx, d.config.BatchSize, func(sub *models.WebhookSubscription, delivery *models.WebhookDelivery) *models.WebhookAttempt {
+			return d.deliver(ctx, sub, delivery)
+		})
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			d.logger.Errorf("failed to dispatch webhook deliveries: %s", err)
+		}
+
+		// continue immediately while more deliveries are due
+		if err == nil && n == d.config.BatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(d.config.PollInterval):
+		}
+	}
+}
+
+// deliver attempts delivery once, and updates its status for the next attempt
+func (d *Dispatcher) deliver(ctx context.Context, sub *models.WebhookSubscription, delivery *models.WebhookDelivery) *models.WebhookAttempt {
+	start := time.Now().UTC()
+	attempt := &models.WebhookAttempt{
+		DateAttempted: &start,
+	}
+
+	statusCode, err := d.post(ctx, sub, delivery)
+	attempt.Duration = time.Since(start)
+	attempt.StatusCode = statusCode
+	if err == nil && (statusCode < 200 || statusCode > 299) {
+		err = fmt.Errorf("unexpected status code %d", statusCode)
+	}
+
+	if err == nil {
+		delivery.Status = models.WebhookDeliveryDelivered
+		delivery.DateDelivered = &start
+		delivery.NextAttempt = nil
+		d.logger.Infof("webhook subscription %s: delivered event %s", sub.ID, delivery.EventID)
+		return attempt
+	}
+
+	attempt.Error = err.Error()
+	// P.S. delivery.Attempts does not include this attempt yet
+	if delivery.Attempts+1 >= d.config.MaxAttempts {
+		delivery.Status = models.WebhookDeliveryFailed
+		delivery.NextAttempt = nil
+		d.logger.Errorf("webhook subscription %s: giving up on event %s: %s", sub.ID, delivery.EventID, err)
+	} else {
+		nextAttempt := start.Add(d.backoff(delivery.Attempts + 1))
+		delivery.Status = models.WebhookDeliveryPending
+		delivery.NextAttempt = &nextAttempt
+		d.logger.Warnf("webhook subscription %s: failed to deliver event %s, retrying at %s: %s", sub.ID, delivery.EventID, nextAttempt, err)
+	}
+
+	return attempt
+}
+
+func (d *Dispatcher) post(ctx context.Context, sub *models.WebhookSubscription, delivery *models.WebhookDelivery) (int, error) {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.URL, bytes.NewReader(delivery.Data))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, delivery.EventType)
+	req.Header.Set(HeaderDelivery, delivery.EventID)
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, Sign(sub.Secret, timestamp, delivery.Data))
+
+	res, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	// drain body to reuse the connection
+	io.Copy(io.Discard, io.LimitReader(res.Body, 64*1024))
+
+	return res.StatusCode, nil
+}
+
+// backoff returns the delay after failed attempt n (starting with 1)
+func (d *Dispatcher) backoff(n int) time.Duration {
+	delay := d.config.MinBackoff
+	for i := 1; i < n; i++ {
+		delay *= 2
+		if delay >= d.config.MaxBackoff {
+			return d.config.MaxBackoff
+		}
+	}
+	return delay
+}
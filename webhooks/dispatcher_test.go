@@ -0,0 +1,63 @@
+package webhooks
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSign(t *testing.T) {
+	tests := []struct {
+		name      string
+		secret    string
+		timestamp string
+		body      string
+		want      string
+	}{
+		{
+			name:      "body",
+			secret:    "secret",
+			timestamp: "1700000000",
+			body:      `{"id":"1"}`,
+			want:      "sha256=086f6aff7bd084c98679825129c5a64dbad88c760016d6d2c0fb123f27951d54",
+		},
+		{
+			name:      "empty body",
+			secret:    "secret",
+			timestamp: "1700000000",
+			want:      "sha256=4bc5f74d868b97888288889c5d9d65df02526f94c1592a79fdf4fe8b26e311e5",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Sign(tt.secret, tt.timestamp, []byte(tt.body)); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+			if got := Sign("other", tt.timestamp, []byte(tt.body)); got == tt.want {
+				t.Error("signature doesn't depend on the secret")
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	d := NewDispatcher(nil, Config{MinBackoff: time.Minute, MaxBackoff: 10 * time.Minute}, nil)
+
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{1, time.Minute},
+		{2, 2 * time.Minute},
+		{3, 4 * time.Minute},
+		{4, 8 * time.Minute},
+		{5, 10 * time.Minute},
+		{100, 10 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		if got := d.backoff(tt.attempt); got != tt.want {
+			t.Errorf("attempt %d: got %s, want %s", tt.attempt, got, tt.want)
+		}
+	}
+}